@@ -0,0 +1,414 @@
+// Package cassette provides an http.RoundTripper which records the HTTP
+// exchanges made by the CLI into cassette files, or replays them from those
+// files without making any network requests.
+package cassette
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+// Redacted replaces the value of any sensitive data in a cassette.
+const Redacted = "REDACTED"
+
+// Version is the version of the cassette file format.
+const Version = 1
+
+// redactedHeaders are the headers whose values are redacted.
+var redactedHeaders = []string{
+	"Authorization",
+	"Cookie",
+	"Fastly-Key",
+	"Proxy-Authorization",
+	"Set-Cookie",
+}
+
+// redactedFields are the JSON and form fields whose values are redacted.
+var redactedFields = map[string]bool{
+	"access_token":  true,
+	"id_token":      true,
+	"refresh_token": true,
+	"token":         true,
+}
+
+// ignoredArgs are the flags (which take a value) that aren't considered when
+// identifying the cassette for a set of arguments.
+var ignoredArgs = map[string]bool{
+	"--record": true,
+	"--replay": true,
+	"--token":  true,
+}
+
+// boolShortFlags are the short forms of the global boolean flags, which can be
+// combined with the -t (--token) flag (e.g. -vt TOKEN).
+const boolShortFlags = "diqvy"
+
+// File is the content of a cassette file.
+type File struct {
+	Version      int           `json:"version"`
+	Args         []string      `json:"args"`
+	Interactions []Interaction `json:"interactions"`
+}
+
+// Interaction is a single HTTP request and its response.
+type Interaction struct {
+	Request  Request  `json:"request"`
+	Response Response `json:"response"`
+}
+
+// Request is a recorded HTTP request.
+type Request struct {
+	Method  string      `json:"method"`
+	URL     string      `json:"url"`
+	Headers http.Header `json:"headers,omitempty"`
+	Body
+}
+
+// Response is a recorded HTTP response.
+type Response struct {
+	StatusCode int         `json:"status_code"`
+	Headers    http.Header `json:"headers,omitempty"`
+	Body
+}
+
+// Body is a recorded HTTP body. It's stored as a string when it's valid
+// UTF-8, otherwise it's base64 encoded.
+type Body struct {
+	Body       string `json:"body,omitempty"`
+	BodyBase64 string `json:"body_base64,omitempty"`
+}
+
+// Cassette records or replays the HTTP exchanges of a single CLI invocation.
+type Cassette struct {
+	path   string
+	replay bool
+
+	mu   sync.Mutex
+	file File
+	used []bool
+}
+
+// New returns a Cassette which records the HTTP exchanges for the given
+// command and arguments into a file within dir (see Save).
+func New(dir, command string, args []string) *Cassette {
+	return &Cassette{
+		path: Path(dir, command, args),
+		file: File{Version: Version, Args: key(args), Interactions: []Interaction{}},
+	}
+}
+
+// Load returns a Cassette which replays the HTTP exchanges for the given
+// command and arguments, previously recorded into a file within dir.
+func Load(dir, command string, args []string) (*Cassette, error) {
+	path := Path(dir, command, args)
+	// gosec flagged this:
+	// G304 (CWE-22): Potential file inclusion via variable
+	// Disabling as we trust the source of the variable.
+	// #nosec
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, fmt.Errorf("no cassette recorded for these arguments (expected %s): %w", path, err)
+		}
+		return nil, fmt.Errorf("error reading cassette: %w", err)
+	}
+	c := &Cassette{path: path, replay: true}
+	if err := json.Unmarshal(data, &c.file); err != nil {
+		return nil, fmt.Errorf("error parsing cassette %s: %w", path, err)
+	}
+	if c.file.Version != Version {
+		return nil, fmt.Errorf("unsupported cassette version %d in %s", c.file.Version, path)
+	}
+	c.used = make([]bool, len(c.file.Interactions))
+	return c, nil
+}
+
+// Path returns the path of the cassette file within dir for the given command
+// and arguments.
+//
+// The file is named after the command plus a hash of the arguments (excluding
+// the --record, --replay and --token flags), so that a directory can hold the
+// cassettes for a whole session of CLI invocations.
+func Path(dir, command string, args []string) string {
+	h := sha256.Sum256([]byte(strings.Join(key(args), "\x00")))
+	name := strings.Join(strings.Fields(command), "-")
+	if name == "" {
+		name = "fastly"
+	}
+	return filepath.Join(dir, fmt.Sprintf("%s-%s.json", name, hex.EncodeToString(h[:])[:12]))
+}
+
+// Save writes the recorded HTTP exchanges to the cassette file.
+// It's a no-op when replaying.
+func (c *Cassette) Save() error {
+	if c.replay {
+		return nil
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	data, err := json.MarshalIndent(c.file, "", "  ")
+	if err != nil {
+		return fmt.Errorf("error encoding cassette: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(c.path), 0o750); err != nil {
+		return fmt.Errorf("error creating cassette directory: %w", err)
+	}
+	if err := os.WriteFile(c.path, append(data, '\n'), 0o600); err != nil {
+		return fmt.Errorf("error writing cassette: %w", err)
+	}
+	return nil
+}
+
+// Transport returns an http.RoundTripper which records the exchanges made
+// via base, or replays them (in which case base is never called).
+func (c *Cassette) Transport(base http.RoundTripper) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &transport{cassette: c, base: base}
+}
+
+type transport struct {
+	cassette *Cassette
+	base     http.RoundTripper
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	reqBody, err := readBody(req)
+	if err != nil {
+		return nil, err
+	}
+	if t.cassette.replay {
+		return t.cassette.replayResponse(req)
+	}
+
+	resp, err := t.base.RoundTrip(req)
+	if err != nil {
+		return resp, err
+	}
+	respBody, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(respBody))
+
+	t.cassette.record(Interaction{
+		Request: Request{
+			Method:  req.Method,
+			URL:     req.URL.String(),
+			Headers: redactHeaders(req.Header),
+			Body:    newBody(redactBody(reqBody, req.Header.Get("Content-Type"))),
+		},
+		Response: Response{
+			StatusCode: resp.StatusCode,
+			Headers:    redactHeaders(resp.Header),
+			Body:       newBody(redactBody(respBody, resp.Header.Get("Content-Type"))),
+		},
+	})
+	return resp, nil
+}
+
+func (c *Cassette) record(i Interaction) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.file.Interactions = append(c.file.Interactions, i)
+}
+
+// replayResponse returns the response of the first unused interaction whose
+// request has the same method, path and query as req.
+func (c *Cassette) replayResponse(req *http.Request) (*http.Response, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for n, i := range c.file.Interactions {
+		if c.used[n] || i.Request.Method != req.Method {
+			continue
+		}
+		u, err := url.Parse(i.Request.URL)
+		if err != nil || u.Path != req.URL.Path || u.Query().Encode() != req.URL.Query().Encode() {
+			continue
+		}
+		c.used[n] = true
+
+		body, err := i.Response.Body.bytes()
+		if err != nil {
+			return nil, fmt.Errorf("error decoding cassette response body: %w", err)
+		}
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", i.Response.StatusCode, http.StatusText(i.Response.StatusCode)),
+			StatusCode:    i.Response.StatusCode,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        i.Response.Headers.Clone(),
+			Body:          io.NopCloser(bytes.NewReader(body)),
+			ContentLength: int64(len(body)),
+			Request:       req,
+		}, nil
+	}
+	return nil, fmt.Errorf("no response recorded in cassette %s for %s %s", c.path, req.Method, req.URL.RequestURI())
+}
+
+// readBody reads the request body, replacing it so it can still be sent.
+func readBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil
+	}
+	body, err := io.ReadAll(req.Body)
+	_ = req.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("error reading request body: %w", err)
+	}
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	return body, nil
+}
+
+// key returns the arguments which identify a cassette.
+func key(args []string) []string {
+	k := []string{}
+	for n := 0; n < len(args); n++ {
+		a := args[n]
+		if ignoredArgs[a] {
+			n++ // skip the flag's value
+			continue
+		}
+		if name, _, ok := strings.Cut(a, "="); ok && ignoredArgs[name] {
+			continue
+		}
+		if flags, next, ok := cutShortToken(a); ok {
+			if next {
+				n++ // skip the flag's value
+			}
+			if flags != "-" {
+				k = append(k, flags)
+			}
+			continue
+		}
+		k = append(k, a)
+	}
+	return k
+}
+
+// cutShortToken removes the -t flag and its value from a set of short flags
+// (e.g. -t, -tTOKEN, -vt or -vtTOKEN), returning the remaining flags and
+// whether the flag's value is the next argument.
+func cutShortToken(a string) (flags string, next, ok bool) {
+	if len(a) < 2 || a[0] != '-' || a[1] == '-' {
+		return "", false, false
+	}
+	for i, r := range a[1:] {
+		if r == 't' {
+			return a[:i+1], i+2 == len(a), true
+		}
+		if !strings.ContainsRune(boolShortFlags, r) {
+			break // the rest of the argument is the flag's value
+		}
+	}
+	return "", false, false
+}
+
+func newBody(b []byte) Body {
+	if len(b) == 0 {
+		return Body{}
+	}
+	if utf8.Valid(b) {
+		return Body{Body: string(b)}
+	}
+	return Body{BodyBase64: base64.StdEncoding.EncodeToString(b)}
+}
+
+func (b Body) bytes() ([]byte, error) {
+	if b.BodyBase64 != "" {
+		return base64.StdEncoding.DecodeString(b.BodyBase64)
+	}
+	return []byte(b.Body), nil
+}
+
+func redactHeaders(h http.Header) http.Header {
+	h = h.Clone()
+	for _, k := range redactedHeaders {
+		if _, ok := h[http.CanonicalHeaderKey(k)]; ok {
+			h.Set(k, Redacted)
+		}
+	}
+	return h
+}
+
+// redactBody redacts the values of sensitive fields in a JSON or form body.
+func redactBody(b []byte, contentType string) []byte {
+	if len(b) == 0 {
+		return b
+	}
+	if strings.HasPrefix(contentType, "application/x-www-form-urlencoded") {
+		values, err := url.ParseQuery(string(b))
+		if err != nil {
+			return b
+		}
+		var redacted bool
+		for k := range values {
+			if redactedFields[k] {
+				values.Set(k, Redacted)
+				redacted = true
+			}
+		}
+		if !redacted {
+			return b
+		}
+		return []byte(values.Encode())
+	}
+
+	var v any
+	if err := json.Unmarshal(b, &v); err != nil {
+		return b
+	}
+	if !redactJSON(v) {
+		return b
+	}
+	out, err := json.Marshal(v)
+	if err != nil {
+		return b
+	}
+	return out
+}
+
+// redactJSON redacts sensitive string fields in place, reporting whether any
+// were found.
+func redactJSON(v any) bool {
+	var redacted bool
+	switch v := v.(type) {
+	case map[string]any:
+		for k, field := range v {
+			if _, ok := field.(string); ok && redactedFields[k] {
+				v[k] = Redacted
+				redacted = true
+				continue
+			}
+			if redactJSON(field) {
+				redacted = true
+			}
+		}
+	case []any:
+		for _, field := range v {
+			if redactJSON(field) {
+				redacted = true
+			}
+		}
+	}
+	return redacted
+}
@@ -0,0 +1,129 @@
+package cassette_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/fastly/cli/pkg/api/cassette"
+	"github.com/fastly/cli/pkg/testutil"
+)
+
+func TestRecordReplay(t *testing.T) {
+	dir := t.TempDir()
+	args := []string{"service", "list", "--token", "abc123"}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/service":
+			_, _ = io.WriteString(w, `[{"id":"123","name":"`+r.URL.Query().Get("page")+`"}]`)
+		case "/tokens":
+			_, _ = io.WriteString(w, `{"access_token":"secret","name":"example"}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	rec := cassette.New(dir, "service list", args)
+	client := &http.Client{Transport: rec.Transport(nil)}
+	for _, path := range []string{"/service?page=1", "/service?page=2", "/tokens"} {
+		req, err := http.NewRequest(http.MethodPost, ts.URL+path, strings.NewReader("refresh_token=secret&grant_type=refresh_token"))
+		testutil.AssertNoError(t, err)
+		req.Header.Set("Fastly-Key", "abc123")
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		resp, err := client.Do(req)
+		testutil.AssertNoError(t, err)
+		_ = resp.Body.Close()
+	}
+	testutil.AssertNoError(t, rec.Save())
+	ts.Close()
+
+	// The token flag shouldn't affect which cassette is used.
+	path := cassette.Path(dir, "service list", []string{"service", "list", "--token=xyz"})
+	testutil.AssertString(t, cassette.Path(dir, "service list", args), path)
+
+	data, err := os.ReadFile(path)
+	testutil.AssertNoError(t, err)
+	for _, secret := range []string{"abc123", "secret"} {
+		if strings.Contains(string(data), secret) {
+			t.Errorf("cassette contains unredacted value %q:\n%s", secret, data)
+		}
+	}
+	testutil.AssertStringContains(t, string(data), cassette.Redacted)
+
+	rep, err := cassette.Load(dir, "service list", []string{"service", "list", "-t", "xyz"})
+	testutil.AssertNoError(t, err)
+	client = &http.Client{Transport: rep.Transport(nil)}
+
+	// Responses are matched by method, path and query (rather than host).
+	for _, tc := range []struct {
+		path string
+		want string
+	}{
+		{path: "/service?page=2", want: `[{"id":"123","name":"2"}]`},
+		{path: "/service?page=1", want: `[{"id":"123","name":"1"}]`},
+		{path: "/tokens", want: `"access_token":"REDACTED"`},
+	} {
+		req, err := http.NewRequest(http.MethodPost, "https://api.example.com"+tc.path, nil)
+		testutil.AssertNoError(t, err)
+		resp, err := client.Do(req)
+		testutil.AssertNoError(t, err)
+		body, err := io.ReadAll(resp.Body)
+		_ = resp.Body.Close()
+		testutil.AssertNoError(t, err)
+		testutil.AssertEqual(t, http.StatusOK, resp.StatusCode)
+		testutil.AssertStringContains(t, string(body), tc.want)
+	}
+
+	// Each interaction is only replayed once.
+	req, err := http.NewRequest(http.MethodPost, "https://api.example.com/tokens", nil)
+	testutil.AssertNoError(t, err)
+	_, err = client.Do(req)
+	testutil.AssertErrorContains(t, err, "no response recorded in cassette")
+
+	testutil.AssertNoError(t, rep.Save())
+	matches, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	testutil.AssertNoError(t, err)
+	testutil.AssertEqual(t, 1, len(matches))
+}
+
+func TestPathIgnoresToken(t *testing.T) {
+	dir := t.TempDir()
+	want := cassette.Path(dir, "service list", []string{"service", "list", "-v"})
+	for _, args := range [][]string{
+		{"service", "list", "-v", "--token", "abc123"},
+		{"service", "list", "-v", "--token=abc123"},
+		{"service", "list", "-v", "-t", "abc123"},
+		{"service", "list", "-v", "-tabc123"},
+		{"service", "list", "-vt", "abc123"},
+		{"service", "list", "-vtabc123"},
+	} {
+		t.Run(strings.Join(args, " "), func(t *testing.T) {
+			testutil.AssertString(t, want, cassette.Path(dir, "service list", args))
+
+			recDir := t.TempDir()
+			testutil.AssertNoError(t, cassette.New(recDir, "service list", args).Save())
+			data, err := os.ReadFile(cassette.Path(recDir, "service list", args))
+			testutil.AssertNoError(t, err)
+			if strings.Contains(string(data), "abc123") {
+				t.Errorf("cassette contains unredacted token:\n%s", data)
+			}
+		})
+	}
+
+	// The -t in -ot is the value of the --profile flag.
+	profile := cassette.Path(dir, "service list", []string{"service", "list", "-ot"})
+	if profile == cassette.Path(dir, "service list", []string{"service", "list", "-o"}) {
+		t.Error("want -ot to be kept as the 't' profile, have it removed as a token flag")
+	}
+}
+
+func TestLoadMissing(t *testing.T) {
+	_, err := cassette.Load(t.TempDir(), "service list", []string{"service", "list"})
+	testutil.AssertErrorContains(t, err, "no cassette recorded for these arguments")
+}
@@ -0,0 +1,238 @@
+// Package dryrun provides an API client which prints the calls that would
+// modify resources rather than making them.
+package dryrun
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/fastly/go-fastly/v8/fastly"
+
+	"github.com/fastly/cli/pkg/api"
+	"github.com/fastly/cli/pkg/text"
+)
+
+// FakeID is the value assigned to the ID field of fake results (e.g. the ID
+// of a resource that would have been created).
+const FakeID = "dry-run"
+
+// Call is an intercepted API call.
+type Call struct {
+	// Method is the name of the api.Interface method (or the HTTP method and
+	// URL of a request intercepted via WrapHTTPClient).
+	Method string
+	// Input is the input struct passed to the method (nil if there was none).
+	Input any
+}
+
+// Client is an api.Interface implementation that lets read-only API calls
+// through to the wrapped client, but intercepts any call that would modify a
+// resource (create, update, delete, activate, purge etc). The intercepted
+// calls are printed and recorded, and a fake result is returned so that
+// commands can complete without side effects.
+//
+// NOTE: CreateClientKey is let through as the returned key is required to
+// encrypt secrets locally and it doesn't modify any existing resources.
+type Client struct {
+	api.Interface
+
+	mu    sync.Mutex
+	calls []Call
+	out   io.Writer
+}
+
+// NewClient returns a Client wrapping c which prints intercepted calls to out.
+func NewClient(c api.Interface, out io.Writer) *Client {
+	return &Client{
+		Interface: c,
+		out:       out,
+	}
+}
+
+// Calls returns the intercepted calls in the order they were made.
+func (c *Client) Calls() []Call {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]Call(nil), c.calls...)
+}
+
+// record prints and records an intercepted call.
+func (c *Client) record(method string, input any) {
+	c.mu.Lock()
+	c.calls = append(c.calls, Call{Method: method, Input: input})
+	c.mu.Unlock()
+
+	text.Output(c.out, "[dry-run] %s", method)
+	if input == nil {
+		return
+	}
+	b, err := json.MarshalIndent(input, "", "  ")
+	if err != nil {
+		fmt.Fprintf(c.out, "%+v\n", input)
+		return
+	}
+	fmt.Fprintf(c.out, "%s\n", b)
+}
+
+// result records an intercepted call and returns a fake result of type T.
+//
+// If T is a pointer to a struct, then the struct is populated from the input
+// struct's fields of the same name (e.g. ServiceID, ServiceVersion and Name)
+// so that the output of a command remains meaningful.
+func result[T any](c *Client, method string, input any) (T, error) {
+	var zero T
+	c.record(method, input)
+	t := reflect.TypeOf(zero)
+	if t == nil || t.Kind() != reflect.Ptr || t.Elem().Kind() != reflect.Struct {
+		return zero, nil
+	}
+	v := reflect.New(t.Elem())
+	populate(v.Elem(), reflect.ValueOf(input))
+	r, _ := v.Interface().(T)
+	return r, nil
+}
+
+// populate sets the fields of dst from the fields of the same name in src.
+func populate(dst, src reflect.Value) {
+	src = reflect.Indirect(src)
+	if src.Kind() != reflect.Struct {
+		return
+	}
+	for i := 0; i < dst.NumField(); i++ {
+		f := dst.Type().Field(i)
+		if !f.IsExported() {
+			continue
+		}
+		switch f.Name {
+		case "Name":
+			// Update inputs identify the resource by Name and rename it via NewName.
+			if assign(dst.Field(i), src.FieldByName("NewName")) {
+				continue
+			}
+		case "Number":
+			// Version results are numbered by the input's ServiceVersion.
+			if assign(dst.Field(i), src.FieldByName("ServiceVersion")) {
+				continue
+			}
+		}
+		assign(dst.Field(i), src.FieldByName(f.Name))
+	}
+	if id := dst.FieldByName("ID"); id.IsValid() && id.Kind() == reflect.String && id.String() == "" {
+		id.SetString(FakeID)
+	}
+}
+
+// assign sets dst to src (dereferencing and allocating pointers as required)
+// if the underlying types are compatible, and reports whether it did so.
+func assign(dst, src reflect.Value) bool {
+	for src.IsValid() && (src.Kind() == reflect.Ptr || src.Kind() == reflect.Interface) {
+		if src.IsNil() {
+			return false
+		}
+		src = src.Elem()
+	}
+	if !src.IsValid() || src.IsZero() {
+		return false
+	}
+	if dst.Kind() == reflect.Ptr {
+		v := reflect.New(dst.Type().Elem())
+		if !assign(v.Elem(), src) {
+			return false
+		}
+		dst.Set(v)
+		return true
+	}
+	switch {
+	case src.Type().AssignableTo(dst.Type()):
+		dst.Set(src)
+	case src.Kind() == dst.Kind() && src.Type().ConvertibleTo(dst.Type()):
+		dst.Set(src.Convert(dst.Type()))
+	default:
+		return false
+	}
+	return true
+}
+
+// CloneVersion implements api.Interface.
+//
+// The fake version is numbered after the latest version of the service.
+func (c *Client) CloneVersion(i *fastly.CloneVersionInput) (*fastly.Version, error) {
+	v, _ := result[*fastly.Version](c, "CloneVersion", i)
+	v.Number = i.ServiceVersion + 1
+	if latest, err := c.Interface.LatestVersion(&fastly.LatestVersionInput{ServiceID: i.ServiceID}); err == nil && latest != nil {
+		v.Number = latest.Number + 1
+	}
+	return v, nil
+}
+
+// ActivateVersion implements api.Interface.
+func (c *Client) ActivateVersion(i *fastly.ActivateVersionInput) (*fastly.Version, error) {
+	v, _ := result[*fastly.Version](c, "ActivateVersion", i)
+	v.Active = true
+	return v, nil
+}
+
+// DeactivateVersion implements api.Interface.
+func (c *Client) DeactivateVersion(i *fastly.DeactivateVersionInput) (*fastly.Version, error) {
+	return result[*fastly.Version](c, "DeactivateVersion", i)
+}
+
+// LockVersion implements api.Interface.
+func (c *Client) LockVersion(i *fastly.LockVersionInput) (*fastly.Version, error) {
+	v, _ := result[*fastly.Version](c, "LockVersion", i)
+	v.Locked = true
+	return v, nil
+}
+
+// PurgeKeys implements api.Interface.
+func (c *Client) PurgeKeys(i *fastly.PurgeKeysInput) (map[string]string, error) {
+	c.record("PurgeKeys", i)
+	m := make(map[string]string, len(i.Keys))
+	for _, k := range i.Keys {
+		m[k] = FakeID
+	}
+	return m, nil
+}
+
+// DeleteTokenSelf implements api.Interface.
+func (c *Client) DeleteTokenSelf() error {
+	c.record("DeleteTokenSelf", nil)
+	return nil
+}
+
+// httpClient is an api.HTTPClient that intercepts requests which would modify
+// resources (see WrapHTTPClient).
+type httpClient struct {
+	api.HTTPClient
+
+	c *Client
+}
+
+// WrapHTTPClient returns an api.HTTPClient that lets requests using a safe
+// method (GET, HEAD or OPTIONS) through to hc, but intercepts any other
+// request (e.g. to an undocumented API endpoint) and returns an empty 200 OK
+// response. Intercepted requests are recorded alongside the API calls.
+func (c *Client) WrapHTTPClient(hc api.HTTPClient) api.HTTPClient {
+	return &httpClient{HTTPClient: hc, c: c}
+}
+
+// Do implements api.HTTPClient.
+func (h *httpClient) Do(req *http.Request) (*http.Response, error) {
+	switch req.Method {
+	case "", http.MethodGet, http.MethodHead, http.MethodOptions:
+		return h.HTTPClient.Do(req)
+	}
+	h.c.record(fmt.Sprintf("%s %s", req.Method, req.URL), nil)
+	return &http.Response{
+		Body:       io.NopCloser(strings.NewReader("{}")),
+		Header:     make(http.Header),
+		Request:    req,
+		Status:     "200 OK",
+		StatusCode: http.StatusOK,
+	}, nil
+}
@@ -0,0 +1,142 @@
+package dryrun_test
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/fastly/go-fastly/v8/fastly"
+
+	"github.com/fastly/cli/pkg/api/dryrun"
+	"github.com/fastly/cli/pkg/app"
+	"github.com/fastly/cli/pkg/global"
+	"github.com/fastly/cli/pkg/mock"
+	"github.com/fastly/cli/pkg/testutil"
+)
+
+func TestClient(t *testing.T) {
+	var stdout bytes.Buffer
+	c := dryrun.NewClient(mock.API{
+		GetBackendFn: func(i *fastly.GetBackendInput) (*fastly.Backend, error) {
+			return &fastly.Backend{ServiceID: i.ServiceID, ServiceVersion: i.ServiceVersion, Name: i.Name, Port: 443}, nil
+		},
+	}, &stdout)
+
+	// Read-only calls are made via the wrapped client.
+	b, err := c.GetBackend(&fastly.GetBackendInput{ServiceID: "123", ServiceVersion: 1, Name: "origin"})
+	testutil.AssertNoError(t, err)
+	testutil.AssertEqual(t, 443, b.Port)
+
+	// Mutating calls are intercepted and return a result built from the input.
+	// NOTE: The mock doesn't define CreateBackendFn and so would panic if called.
+	b, err = c.CreateBackend(&fastly.CreateBackendInput{
+		ServiceID:      "123",
+		ServiceVersion: 2,
+		Name:           fastly.String("origin"),
+		Address:        fastly.String("example.com"),
+		Port:           fastly.Int(8080),
+		UseSSL:         fastly.CBool(true),
+	})
+	testutil.AssertNoError(t, err)
+	testutil.AssertEqual(t, "123", b.ServiceID)
+	testutil.AssertEqual(t, 2, b.ServiceVersion)
+	testutil.AssertEqual(t, "origin", b.Name)
+	testutil.AssertEqual(t, "example.com", b.Address)
+	testutil.AssertEqual(t, 8080, b.Port)
+	testutil.AssertEqual(t, true, b.UseSSL)
+
+	u, err := c.UpdateBackend(&fastly.UpdateBackendInput{ServiceID: "123", ServiceVersion: 2, Name: "origin", NewName: fastly.String("primary")})
+	testutil.AssertNoError(t, err)
+	testutil.AssertEqual(t, "primary", u.Name)
+
+	testutil.AssertNoError(t, c.DeleteBackend(&fastly.DeleteBackendInput{ServiceID: "123", ServiceVersion: 2, Name: "primary"}))
+
+	calls := c.Calls()
+	testutil.AssertEqual(t, 3, len(calls))
+	for i, want := range []string{"CreateBackend", "UpdateBackend", "DeleteBackend"} {
+		testutil.AssertEqual(t, want, calls[i].Method)
+	}
+	testutil.AssertStringContains(t, stdout.String(), "[dry-run] CreateBackend")
+	testutil.AssertStringContains(t, stdout.String(), `"Address": "example.com"`)
+	testutil.AssertStringDoesntContain(t, stdout.String(), "GetBackend")
+}
+
+func TestWrapHTTPClient(t *testing.T) {
+	var stdout bytes.Buffer
+	c := dryrun.NewClient(mock.API{}, &stdout)
+
+	var made []string
+	hc := c.WrapHTTPClient(httpClientFunc(func(req *http.Request) (*http.Response, error) {
+		made = append(made, req.Method)
+		return &http.Response{StatusCode: http.StatusTeapot, Body: http.NoBody}, nil
+	}))
+
+	// Safe requests are made via the wrapped client.
+	req, _ := http.NewRequest(http.MethodGet, "https://api.example.com/verify", nil)
+	resp, err := hc.Do(req)
+	testutil.AssertNoError(t, err)
+	testutil.AssertEqual(t, http.StatusTeapot, resp.StatusCode)
+
+	// Other requests are intercepted.
+	req, _ = http.NewRequest(http.MethodPost, "https://api.example.com/customer/abc/edge-compute-trial", nil)
+	resp, err = hc.Do(req)
+	testutil.AssertNoError(t, err)
+	testutil.AssertEqual(t, http.StatusOK, resp.StatusCode)
+
+	testutil.AssertEqual(t, []string{http.MethodGet}, made)
+	calls := c.Calls()
+	testutil.AssertEqual(t, 1, len(calls))
+	testutil.AssertEqual(t, "POST https://api.example.com/customer/abc/edge-compute-trial", calls[0].Method)
+	testutil.AssertStringContains(t, stdout.String(), "[dry-run] POST https://api.example.com/customer/abc/edge-compute-trial")
+}
+
+type httpClientFunc func(*http.Request) (*http.Response, error)
+
+func (f httpClientFunc) Do(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestDryRunFlag(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Args: args("backend create --service-id 123 --version 3 --address example.com --name www.test.com --dry-run"),
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+			},
+			WantOutputs: []string{
+				"[dry-run] CreateBackend",
+				`"Name": "www.test.com"`,
+				"Created backend www.test.com (service 123 version 3)",
+			},
+		},
+		{
+			Args: args("--dry-run service-version activate --service-id 123 --version 3"),
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+			},
+			WantOutputs: []string{
+				"[dry-run] ActivateVersion",
+				"Activated service 123 version 3",
+			},
+		},
+	}
+
+	for testcaseIdx := range scenarios {
+		testcase := &scenarios[testcaseIdx]
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			app.Init = func(_ []string, _ io.Reader) (*global.Data, error) {
+				opts := testutil.MockGlobalData(testcase.Args, &stdout)
+				opts.APIClientFactory = mock.APIClient(testcase.API)
+				return opts, nil
+			}
+			err := app.Run(testcase.Args, nil)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			for _, s := range testcase.WantOutputs {
+				testutil.AssertStringContains(t, stdout.String(), s)
+			}
+		})
+	}
+}
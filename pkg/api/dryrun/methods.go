@@ -0,0 +1,1060 @@
+package dryrun
+
+import (
+	"github.com/fastly/go-fastly/v8/fastly"
+)
+
+// CreateService implements api.Interface.
+func (c *Client) CreateService(i *fastly.CreateServiceInput) (*fastly.Service, error) {
+	return result[*fastly.Service](c, "CreateService", i)
+}
+
+// UpdateService implements api.Interface.
+func (c *Client) UpdateService(i *fastly.UpdateServiceInput) (*fastly.Service, error) {
+	return result[*fastly.Service](c, "UpdateService", i)
+}
+
+// DeleteService implements api.Interface.
+func (c *Client) DeleteService(i *fastly.DeleteServiceInput) error {
+	c.record("DeleteService", i)
+	return nil
+}
+
+// UpdateVersion implements api.Interface.
+func (c *Client) UpdateVersion(i *fastly.UpdateVersionInput) (*fastly.Version, error) {
+	return result[*fastly.Version](c, "UpdateVersion", i)
+}
+
+// CreateDomain implements api.Interface.
+func (c *Client) CreateDomain(i *fastly.CreateDomainInput) (*fastly.Domain, error) {
+	return result[*fastly.Domain](c, "CreateDomain", i)
+}
+
+// UpdateDomain implements api.Interface.
+func (c *Client) UpdateDomain(i *fastly.UpdateDomainInput) (*fastly.Domain, error) {
+	return result[*fastly.Domain](c, "UpdateDomain", i)
+}
+
+// DeleteDomain implements api.Interface.
+func (c *Client) DeleteDomain(i *fastly.DeleteDomainInput) error {
+	c.record("DeleteDomain", i)
+	return nil
+}
+
+// CreateBackend implements api.Interface.
+func (c *Client) CreateBackend(i *fastly.CreateBackendInput) (*fastly.Backend, error) {
+	return result[*fastly.Backend](c, "CreateBackend", i)
+}
+
+// UpdateBackend implements api.Interface.
+func (c *Client) UpdateBackend(i *fastly.UpdateBackendInput) (*fastly.Backend, error) {
+	return result[*fastly.Backend](c, "UpdateBackend", i)
+}
+
+// DeleteBackend implements api.Interface.
+func (c *Client) DeleteBackend(i *fastly.DeleteBackendInput) error {
+	c.record("DeleteBackend", i)
+	return nil
+}
+
+// CreateHealthCheck implements api.Interface.
+func (c *Client) CreateHealthCheck(i *fastly.CreateHealthCheckInput) (*fastly.HealthCheck, error) {
+	return result[*fastly.HealthCheck](c, "CreateHealthCheck", i)
+}
+
+// UpdateHealthCheck implements api.Interface.
+func (c *Client) UpdateHealthCheck(i *fastly.UpdateHealthCheckInput) (*fastly.HealthCheck, error) {
+	return result[*fastly.HealthCheck](c, "UpdateHealthCheck", i)
+}
+
+// DeleteHealthCheck implements api.Interface.
+func (c *Client) DeleteHealthCheck(i *fastly.DeleteHealthCheckInput) error {
+	c.record("DeleteHealthCheck", i)
+	return nil
+}
+
+// CreateDirector implements api.Interface.
+func (c *Client) CreateDirector(i *fastly.CreateDirectorInput) (*fastly.Director, error) {
+	return result[*fastly.Director](c, "CreateDirector", i)
+}
+
+// UpdateDirector implements api.Interface.
+func (c *Client) UpdateDirector(i *fastly.UpdateDirectorInput) (*fastly.Director, error) {
+	return result[*fastly.Director](c, "UpdateDirector", i)
+}
+
+// DeleteDirector implements api.Interface.
+func (c *Client) DeleteDirector(i *fastly.DeleteDirectorInput) error {
+	c.record("DeleteDirector", i)
+	return nil
+}
+
+// CreateDirectorBackend implements api.Interface.
+func (c *Client) CreateDirectorBackend(i *fastly.CreateDirectorBackendInput) (*fastly.DirectorBackend, error) {
+	return result[*fastly.DirectorBackend](c, "CreateDirectorBackend", i)
+}
+
+// DeleteDirectorBackend implements api.Interface.
+func (c *Client) DeleteDirectorBackend(i *fastly.DeleteDirectorBackendInput) error {
+	c.record("DeleteDirectorBackend", i)
+	return nil
+}
+
+// CreatePool implements api.Interface.
+func (c *Client) CreatePool(i *fastly.CreatePoolInput) (*fastly.Pool, error) {
+	return result[*fastly.Pool](c, "CreatePool", i)
+}
+
+// UpdatePool implements api.Interface.
+func (c *Client) UpdatePool(i *fastly.UpdatePoolInput) (*fastly.Pool, error) {
+	return result[*fastly.Pool](c, "UpdatePool", i)
+}
+
+// DeletePool implements api.Interface.
+func (c *Client) DeletePool(i *fastly.DeletePoolInput) error {
+	c.record("DeletePool", i)
+	return nil
+}
+
+// CreateServer implements api.Interface.
+func (c *Client) CreateServer(i *fastly.CreateServerInput) (*fastly.Server, error) {
+	return result[*fastly.Server](c, "CreateServer", i)
+}
+
+// UpdateServer implements api.Interface.
+func (c *Client) UpdateServer(i *fastly.UpdateServerInput) (*fastly.Server, error) {
+	return result[*fastly.Server](c, "UpdateServer", i)
+}
+
+// DeleteServer implements api.Interface.
+func (c *Client) DeleteServer(i *fastly.DeleteServerInput) error {
+	c.record("DeleteServer", i)
+	return nil
+}
+
+// CreateHeader implements api.Interface.
+func (c *Client) CreateHeader(i *fastly.CreateHeaderInput) (*fastly.Header, error) {
+	return result[*fastly.Header](c, "CreateHeader", i)
+}
+
+// UpdateHeader implements api.Interface.
+func (c *Client) UpdateHeader(i *fastly.UpdateHeaderInput) (*fastly.Header, error) {
+	return result[*fastly.Header](c, "UpdateHeader", i)
+}
+
+// DeleteHeader implements api.Interface.
+func (c *Client) DeleteHeader(i *fastly.DeleteHeaderInput) error {
+	c.record("DeleteHeader", i)
+	return nil
+}
+
+// CreateGzip implements api.Interface.
+func (c *Client) CreateGzip(i *fastly.CreateGzipInput) (*fastly.Gzip, error) {
+	return result[*fastly.Gzip](c, "CreateGzip", i)
+}
+
+// UpdateGzip implements api.Interface.
+func (c *Client) UpdateGzip(i *fastly.UpdateGzipInput) (*fastly.Gzip, error) {
+	return result[*fastly.Gzip](c, "UpdateGzip", i)
+}
+
+// DeleteGzip implements api.Interface.
+func (c *Client) DeleteGzip(i *fastly.DeleteGzipInput) error {
+	c.record("DeleteGzip", i)
+	return nil
+}
+
+// CreateCacheSetting implements api.Interface.
+func (c *Client) CreateCacheSetting(i *fastly.CreateCacheSettingInput) (*fastly.CacheSetting, error) {
+	return result[*fastly.CacheSetting](c, "CreateCacheSetting", i)
+}
+
+// UpdateCacheSetting implements api.Interface.
+func (c *Client) UpdateCacheSetting(i *fastly.UpdateCacheSettingInput) (*fastly.CacheSetting, error) {
+	return result[*fastly.CacheSetting](c, "UpdateCacheSetting", i)
+}
+
+// DeleteCacheSetting implements api.Interface.
+func (c *Client) DeleteCacheSetting(i *fastly.DeleteCacheSettingInput) error {
+	c.record("DeleteCacheSetting", i)
+	return nil
+}
+
+// CreateRequestSetting implements api.Interface.
+func (c *Client) CreateRequestSetting(i *fastly.CreateRequestSettingInput) (*fastly.RequestSetting, error) {
+	return result[*fastly.RequestSetting](c, "CreateRequestSetting", i)
+}
+
+// UpdateRequestSetting implements api.Interface.
+func (c *Client) UpdateRequestSetting(i *fastly.UpdateRequestSettingInput) (*fastly.RequestSetting, error) {
+	return result[*fastly.RequestSetting](c, "UpdateRequestSetting", i)
+}
+
+// DeleteRequestSetting implements api.Interface.
+func (c *Client) DeleteRequestSetting(i *fastly.DeleteRequestSettingInput) error {
+	c.record("DeleteRequestSetting", i)
+	return nil
+}
+
+// CreateResponseObject implements api.Interface.
+func (c *Client) CreateResponseObject(i *fastly.CreateResponseObjectInput) (*fastly.ResponseObject, error) {
+	return result[*fastly.ResponseObject](c, "CreateResponseObject", i)
+}
+
+// UpdateResponseObject implements api.Interface.
+func (c *Client) UpdateResponseObject(i *fastly.UpdateResponseObjectInput) (*fastly.ResponseObject, error) {
+	return result[*fastly.ResponseObject](c, "UpdateResponseObject", i)
+}
+
+// DeleteResponseObject implements api.Interface.
+func (c *Client) DeleteResponseObject(i *fastly.DeleteResponseObjectInput) error {
+	c.record("DeleteResponseObject", i)
+	return nil
+}
+
+// UpdatePackage implements api.Interface.
+func (c *Client) UpdatePackage(i *fastly.UpdatePackageInput) (*fastly.Package, error) {
+	return result[*fastly.Package](c, "UpdatePackage", i)
+}
+
+// CreateDictionary implements api.Interface.
+func (c *Client) CreateDictionary(i *fastly.CreateDictionaryInput) (*fastly.Dictionary, error) {
+	return result[*fastly.Dictionary](c, "CreateDictionary", i)
+}
+
+// DeleteDictionary implements api.Interface.
+func (c *Client) DeleteDictionary(i *fastly.DeleteDictionaryInput) error {
+	c.record("DeleteDictionary", i)
+	return nil
+}
+
+// UpdateDictionary implements api.Interface.
+func (c *Client) UpdateDictionary(i *fastly.UpdateDictionaryInput) (*fastly.Dictionary, error) {
+	return result[*fastly.Dictionary](c, "UpdateDictionary", i)
+}
+
+// CreateDictionaryItem implements api.Interface.
+func (c *Client) CreateDictionaryItem(i *fastly.CreateDictionaryItemInput) (*fastly.DictionaryItem, error) {
+	return result[*fastly.DictionaryItem](c, "CreateDictionaryItem", i)
+}
+
+// UpdateDictionaryItem implements api.Interface.
+func (c *Client) UpdateDictionaryItem(i *fastly.UpdateDictionaryItemInput) (*fastly.DictionaryItem, error) {
+	return result[*fastly.DictionaryItem](c, "UpdateDictionaryItem", i)
+}
+
+// DeleteDictionaryItem implements api.Interface.
+func (c *Client) DeleteDictionaryItem(i *fastly.DeleteDictionaryItemInput) error {
+	c.record("DeleteDictionaryItem", i)
+	return nil
+}
+
+// BatchModifyDictionaryItems implements api.Interface.
+func (c *Client) BatchModifyDictionaryItems(i *fastly.BatchModifyDictionaryItemsInput) error {
+	c.record("BatchModifyDictionaryItems", i)
+	return nil
+}
+
+// CreateBigQuery implements api.Interface.
+func (c *Client) CreateBigQuery(i *fastly.CreateBigQueryInput) (*fastly.BigQuery, error) {
+	return result[*fastly.BigQuery](c, "CreateBigQuery", i)
+}
+
+// UpdateBigQuery implements api.Interface.
+func (c *Client) UpdateBigQuery(i *fastly.UpdateBigQueryInput) (*fastly.BigQuery, error) {
+	return result[*fastly.BigQuery](c, "UpdateBigQuery", i)
+}
+
+// DeleteBigQuery implements api.Interface.
+func (c *Client) DeleteBigQuery(i *fastly.DeleteBigQueryInput) error {
+	c.record("DeleteBigQuery", i)
+	return nil
+}
+
+// CreateS3 implements api.Interface.
+func (c *Client) CreateS3(i *fastly.CreateS3Input) (*fastly.S3, error) {
+	return result[*fastly.S3](c, "CreateS3", i)
+}
+
+// UpdateS3 implements api.Interface.
+func (c *Client) UpdateS3(i *fastly.UpdateS3Input) (*fastly.S3, error) {
+	return result[*fastly.S3](c, "UpdateS3", i)
+}
+
+// DeleteS3 implements api.Interface.
+func (c *Client) DeleteS3(i *fastly.DeleteS3Input) error {
+	c.record("DeleteS3", i)
+	return nil
+}
+
+// CreateKinesis implements api.Interface.
+func (c *Client) CreateKinesis(i *fastly.CreateKinesisInput) (*fastly.Kinesis, error) {
+	return result[*fastly.Kinesis](c, "CreateKinesis", i)
+}
+
+// UpdateKinesis implements api.Interface.
+func (c *Client) UpdateKinesis(i *fastly.UpdateKinesisInput) (*fastly.Kinesis, error) {
+	return result[*fastly.Kinesis](c, "UpdateKinesis", i)
+}
+
+// DeleteKinesis implements api.Interface.
+func (c *Client) DeleteKinesis(i *fastly.DeleteKinesisInput) error {
+	c.record("DeleteKinesis", i)
+	return nil
+}
+
+// CreateSyslog implements api.Interface.
+func (c *Client) CreateSyslog(i *fastly.CreateSyslogInput) (*fastly.Syslog, error) {
+	return result[*fastly.Syslog](c, "CreateSyslog", i)
+}
+
+// UpdateSyslog implements api.Interface.
+func (c *Client) UpdateSyslog(i *fastly.UpdateSyslogInput) (*fastly.Syslog, error) {
+	return result[*fastly.Syslog](c, "UpdateSyslog", i)
+}
+
+// DeleteSyslog implements api.Interface.
+func (c *Client) DeleteSyslog(i *fastly.DeleteSyslogInput) error {
+	c.record("DeleteSyslog", i)
+	return nil
+}
+
+// CreateLogentries implements api.Interface.
+func (c *Client) CreateLogentries(i *fastly.CreateLogentriesInput) (*fastly.Logentries, error) {
+	return result[*fastly.Logentries](c, "CreateLogentries", i)
+}
+
+// UpdateLogentries implements api.Interface.
+func (c *Client) UpdateLogentries(i *fastly.UpdateLogentriesInput) (*fastly.Logentries, error) {
+	return result[*fastly.Logentries](c, "UpdateLogentries", i)
+}
+
+// DeleteLogentries implements api.Interface.
+func (c *Client) DeleteLogentries(i *fastly.DeleteLogentriesInput) error {
+	c.record("DeleteLogentries", i)
+	return nil
+}
+
+// CreatePapertrail implements api.Interface.
+func (c *Client) CreatePapertrail(i *fastly.CreatePapertrailInput) (*fastly.Papertrail, error) {
+	return result[*fastly.Papertrail](c, "CreatePapertrail", i)
+}
+
+// UpdatePapertrail implements api.Interface.
+func (c *Client) UpdatePapertrail(i *fastly.UpdatePapertrailInput) (*fastly.Papertrail, error) {
+	return result[*fastly.Papertrail](c, "UpdatePapertrail", i)
+}
+
+// DeletePapertrail implements api.Interface.
+func (c *Client) DeletePapertrail(i *fastly.DeletePapertrailInput) error {
+	c.record("DeletePapertrail", i)
+	return nil
+}
+
+// CreateSumologic implements api.Interface.
+func (c *Client) CreateSumologic(i *fastly.CreateSumologicInput) (*fastly.Sumologic, error) {
+	return result[*fastly.Sumologic](c, "CreateSumologic", i)
+}
+
+// UpdateSumologic implements api.Interface.
+func (c *Client) UpdateSumologic(i *fastly.UpdateSumologicInput) (*fastly.Sumologic, error) {
+	return result[*fastly.Sumologic](c, "UpdateSumologic", i)
+}
+
+// DeleteSumologic implements api.Interface.
+func (c *Client) DeleteSumologic(i *fastly.DeleteSumologicInput) error {
+	c.record("DeleteSumologic", i)
+	return nil
+}
+
+// CreateGCS implements api.Interface.
+func (c *Client) CreateGCS(i *fastly.CreateGCSInput) (*fastly.GCS, error) {
+	return result[*fastly.GCS](c, "CreateGCS", i)
+}
+
+// UpdateGCS implements api.Interface.
+func (c *Client) UpdateGCS(i *fastly.UpdateGCSInput) (*fastly.GCS, error) {
+	return result[*fastly.GCS](c, "UpdateGCS", i)
+}
+
+// DeleteGCS implements api.Interface.
+func (c *Client) DeleteGCS(i *fastly.DeleteGCSInput) error {
+	c.record("DeleteGCS", i)
+	return nil
+}
+
+// CreateFTP implements api.Interface.
+func (c *Client) CreateFTP(i *fastly.CreateFTPInput) (*fastly.FTP, error) {
+	return result[*fastly.FTP](c, "CreateFTP", i)
+}
+
+// UpdateFTP implements api.Interface.
+func (c *Client) UpdateFTP(i *fastly.UpdateFTPInput) (*fastly.FTP, error) {
+	return result[*fastly.FTP](c, "UpdateFTP", i)
+}
+
+// DeleteFTP implements api.Interface.
+func (c *Client) DeleteFTP(i *fastly.DeleteFTPInput) error {
+	c.record("DeleteFTP", i)
+	return nil
+}
+
+// CreateSplunk implements api.Interface.
+func (c *Client) CreateSplunk(i *fastly.CreateSplunkInput) (*fastly.Splunk, error) {
+	return result[*fastly.Splunk](c, "CreateSplunk", i)
+}
+
+// UpdateSplunk implements api.Interface.
+func (c *Client) UpdateSplunk(i *fastly.UpdateSplunkInput) (*fastly.Splunk, error) {
+	return result[*fastly.Splunk](c, "UpdateSplunk", i)
+}
+
+// DeleteSplunk implements api.Interface.
+func (c *Client) DeleteSplunk(i *fastly.DeleteSplunkInput) error {
+	c.record("DeleteSplunk", i)
+	return nil
+}
+
+// CreateScalyr implements api.Interface.
+func (c *Client) CreateScalyr(i *fastly.CreateScalyrInput) (*fastly.Scalyr, error) {
+	return result[*fastly.Scalyr](c, "CreateScalyr", i)
+}
+
+// UpdateScalyr implements api.Interface.
+func (c *Client) UpdateScalyr(i *fastly.UpdateScalyrInput) (*fastly.Scalyr, error) {
+	return result[*fastly.Scalyr](c, "UpdateScalyr", i)
+}
+
+// DeleteScalyr implements api.Interface.
+func (c *Client) DeleteScalyr(i *fastly.DeleteScalyrInput) error {
+	c.record("DeleteScalyr", i)
+	return nil
+}
+
+// CreateLoggly implements api.Interface.
+func (c *Client) CreateLoggly(i *fastly.CreateLogglyInput) (*fastly.Loggly, error) {
+	return result[*fastly.Loggly](c, "CreateLoggly", i)
+}
+
+// UpdateLoggly implements api.Interface.
+func (c *Client) UpdateLoggly(i *fastly.UpdateLogglyInput) (*fastly.Loggly, error) {
+	return result[*fastly.Loggly](c, "UpdateLoggly", i)
+}
+
+// DeleteLoggly implements api.Interface.
+func (c *Client) DeleteLoggly(i *fastly.DeleteLogglyInput) error {
+	c.record("DeleteLoggly", i)
+	return nil
+}
+
+// CreateHoneycomb implements api.Interface.
+func (c *Client) CreateHoneycomb(i *fastly.CreateHoneycombInput) (*fastly.Honeycomb, error) {
+	return result[*fastly.Honeycomb](c, "CreateHoneycomb", i)
+}
+
+// UpdateHoneycomb implements api.Interface.
+func (c *Client) UpdateHoneycomb(i *fastly.UpdateHoneycombInput) (*fastly.Honeycomb, error) {
+	return result[*fastly.Honeycomb](c, "UpdateHoneycomb", i)
+}
+
+// DeleteHoneycomb implements api.Interface.
+func (c *Client) DeleteHoneycomb(i *fastly.DeleteHoneycombInput) error {
+	c.record("DeleteHoneycomb", i)
+	return nil
+}
+
+// CreateHeroku implements api.Interface.
+func (c *Client) CreateHeroku(i *fastly.CreateHerokuInput) (*fastly.Heroku, error) {
+	return result[*fastly.Heroku](c, "CreateHeroku", i)
+}
+
+// UpdateHeroku implements api.Interface.
+func (c *Client) UpdateHeroku(i *fastly.UpdateHerokuInput) (*fastly.Heroku, error) {
+	return result[*fastly.Heroku](c, "UpdateHeroku", i)
+}
+
+// DeleteHeroku implements api.Interface.
+func (c *Client) DeleteHeroku(i *fastly.DeleteHerokuInput) error {
+	c.record("DeleteHeroku", i)
+	return nil
+}
+
+// CreateSFTP implements api.Interface.
+func (c *Client) CreateSFTP(i *fastly.CreateSFTPInput) (*fastly.SFTP, error) {
+	return result[*fastly.SFTP](c, "CreateSFTP", i)
+}
+
+// UpdateSFTP implements api.Interface.
+func (c *Client) UpdateSFTP(i *fastly.UpdateSFTPInput) (*fastly.SFTP, error) {
+	return result[*fastly.SFTP](c, "UpdateSFTP", i)
+}
+
+// DeleteSFTP implements api.Interface.
+func (c *Client) DeleteSFTP(i *fastly.DeleteSFTPInput) error {
+	c.record("DeleteSFTP", i)
+	return nil
+}
+
+// CreateLogshuttle implements api.Interface.
+func (c *Client) CreateLogshuttle(i *fastly.CreateLogshuttleInput) (*fastly.Logshuttle, error) {
+	return result[*fastly.Logshuttle](c, "CreateLogshuttle", i)
+}
+
+// UpdateLogshuttle implements api.Interface.
+func (c *Client) UpdateLogshuttle(i *fastly.UpdateLogshuttleInput) (*fastly.Logshuttle, error) {
+	return result[*fastly.Logshuttle](c, "UpdateLogshuttle", i)
+}
+
+// DeleteLogshuttle implements api.Interface.
+func (c *Client) DeleteLogshuttle(i *fastly.DeleteLogshuttleInput) error {
+	c.record("DeleteLogshuttle", i)
+	return nil
+}
+
+// CreateCloudfiles implements api.Interface.
+func (c *Client) CreateCloudfiles(i *fastly.CreateCloudfilesInput) (*fastly.Cloudfiles, error) {
+	return result[*fastly.Cloudfiles](c, "CreateCloudfiles", i)
+}
+
+// UpdateCloudfiles implements api.Interface.
+func (c *Client) UpdateCloudfiles(i *fastly.UpdateCloudfilesInput) (*fastly.Cloudfiles, error) {
+	return result[*fastly.Cloudfiles](c, "UpdateCloudfiles", i)
+}
+
+// DeleteCloudfiles implements api.Interface.
+func (c *Client) DeleteCloudfiles(i *fastly.DeleteCloudfilesInput) error {
+	c.record("DeleteCloudfiles", i)
+	return nil
+}
+
+// CreateDigitalOcean implements api.Interface.
+func (c *Client) CreateDigitalOcean(i *fastly.CreateDigitalOceanInput) (*fastly.DigitalOcean, error) {
+	return result[*fastly.DigitalOcean](c, "CreateDigitalOcean", i)
+}
+
+// UpdateDigitalOcean implements api.Interface.
+func (c *Client) UpdateDigitalOcean(i *fastly.UpdateDigitalOceanInput) (*fastly.DigitalOcean, error) {
+	return result[*fastly.DigitalOcean](c, "UpdateDigitalOcean", i)
+}
+
+// DeleteDigitalOcean implements api.Interface.
+func (c *Client) DeleteDigitalOcean(i *fastly.DeleteDigitalOceanInput) error {
+	c.record("DeleteDigitalOcean", i)
+	return nil
+}
+
+// CreateElasticsearch implements api.Interface.
+func (c *Client) CreateElasticsearch(i *fastly.CreateElasticsearchInput) (*fastly.Elasticsearch, error) {
+	return result[*fastly.Elasticsearch](c, "CreateElasticsearch", i)
+}
+
+// UpdateElasticsearch implements api.Interface.
+func (c *Client) UpdateElasticsearch(i *fastly.UpdateElasticsearchInput) (*fastly.Elasticsearch, error) {
+	return result[*fastly.Elasticsearch](c, "UpdateElasticsearch", i)
+}
+
+// DeleteElasticsearch implements api.Interface.
+func (c *Client) DeleteElasticsearch(i *fastly.DeleteElasticsearchInput) error {
+	c.record("DeleteElasticsearch", i)
+	return nil
+}
+
+// CreateBlobStorage implements api.Interface.
+func (c *Client) CreateBlobStorage(i *fastly.CreateBlobStorageInput) (*fastly.BlobStorage, error) {
+	return result[*fastly.BlobStorage](c, "CreateBlobStorage", i)
+}
+
+// UpdateBlobStorage implements api.Interface.
+func (c *Client) UpdateBlobStorage(i *fastly.UpdateBlobStorageInput) (*fastly.BlobStorage, error) {
+	return result[*fastly.BlobStorage](c, "UpdateBlobStorage", i)
+}
+
+// DeleteBlobStorage implements api.Interface.
+func (c *Client) DeleteBlobStorage(i *fastly.DeleteBlobStorageInput) error {
+	c.record("DeleteBlobStorage", i)
+	return nil
+}
+
+// CreateDatadog implements api.Interface.
+func (c *Client) CreateDatadog(i *fastly.CreateDatadogInput) (*fastly.Datadog, error) {
+	return result[*fastly.Datadog](c, "CreateDatadog", i)
+}
+
+// UpdateDatadog implements api.Interface.
+func (c *Client) UpdateDatadog(i *fastly.UpdateDatadogInput) (*fastly.Datadog, error) {
+	return result[*fastly.Datadog](c, "UpdateDatadog", i)
+}
+
+// DeleteDatadog implements api.Interface.
+func (c *Client) DeleteDatadog(i *fastly.DeleteDatadogInput) error {
+	c.record("DeleteDatadog", i)
+	return nil
+}
+
+// CreateHTTPS implements api.Interface.
+func (c *Client) CreateHTTPS(i *fastly.CreateHTTPSInput) (*fastly.HTTPS, error) {
+	return result[*fastly.HTTPS](c, "CreateHTTPS", i)
+}
+
+// UpdateHTTPS implements api.Interface.
+func (c *Client) UpdateHTTPS(i *fastly.UpdateHTTPSInput) (*fastly.HTTPS, error) {
+	return result[*fastly.HTTPS](c, "UpdateHTTPS", i)
+}
+
+// DeleteHTTPS implements api.Interface.
+func (c *Client) DeleteHTTPS(i *fastly.DeleteHTTPSInput) error {
+	c.record("DeleteHTTPS", i)
+	return nil
+}
+
+// CreateKafka implements api.Interface.
+func (c *Client) CreateKafka(i *fastly.CreateKafkaInput) (*fastly.Kafka, error) {
+	return result[*fastly.Kafka](c, "CreateKafka", i)
+}
+
+// UpdateKafka implements api.Interface.
+func (c *Client) UpdateKafka(i *fastly.UpdateKafkaInput) (*fastly.Kafka, error) {
+	return result[*fastly.Kafka](c, "UpdateKafka", i)
+}
+
+// DeleteKafka implements api.Interface.
+func (c *Client) DeleteKafka(i *fastly.DeleteKafkaInput) error {
+	c.record("DeleteKafka", i)
+	return nil
+}
+
+// CreatePubsub implements api.Interface.
+func (c *Client) CreatePubsub(i *fastly.CreatePubsubInput) (*fastly.Pubsub, error) {
+	return result[*fastly.Pubsub](c, "CreatePubsub", i)
+}
+
+// UpdatePubsub implements api.Interface.
+func (c *Client) UpdatePubsub(i *fastly.UpdatePubsubInput) (*fastly.Pubsub, error) {
+	return result[*fastly.Pubsub](c, "UpdatePubsub", i)
+}
+
+// DeletePubsub implements api.Interface.
+func (c *Client) DeletePubsub(i *fastly.DeletePubsubInput) error {
+	c.record("DeletePubsub", i)
+	return nil
+}
+
+// CreateOpenstack implements api.Interface.
+func (c *Client) CreateOpenstack(i *fastly.CreateOpenstackInput) (*fastly.Openstack, error) {
+	return result[*fastly.Openstack](c, "CreateOpenstack", i)
+}
+
+// UpdateOpenstack implements api.Interface.
+func (c *Client) UpdateOpenstack(i *fastly.UpdateOpenstackInput) (*fastly.Openstack, error) {
+	return result[*fastly.Openstack](c, "UpdateOpenstack", i)
+}
+
+// DeleteOpenstack implements api.Interface.
+func (c *Client) DeleteOpenstack(i *fastly.DeleteOpenstackInput) error {
+	c.record("DeleteOpenstack", i)
+	return nil
+}
+
+// CreateManagedLogging implements api.Interface.
+func (c *Client) CreateManagedLogging(i *fastly.CreateManagedLoggingInput) (*fastly.ManagedLogging, error) {
+	return result[*fastly.ManagedLogging](c, "CreateManagedLogging", i)
+}
+
+// CreateVCL implements api.Interface.
+func (c *Client) CreateVCL(i *fastly.CreateVCLInput) (*fastly.VCL, error) {
+	return result[*fastly.VCL](c, "CreateVCL", i)
+}
+
+// UpdateVCL implements api.Interface.
+func (c *Client) UpdateVCL(i *fastly.UpdateVCLInput) (*fastly.VCL, error) {
+	return result[*fastly.VCL](c, "UpdateVCL", i)
+}
+
+// DeleteVCL implements api.Interface.
+func (c *Client) DeleteVCL(i *fastly.DeleteVCLInput) error {
+	c.record("DeleteVCL", i)
+	return nil
+}
+
+// CreateSnippet implements api.Interface.
+func (c *Client) CreateSnippet(i *fastly.CreateSnippetInput) (*fastly.Snippet, error) {
+	return result[*fastly.Snippet](c, "CreateSnippet", i)
+}
+
+// UpdateSnippet implements api.Interface.
+func (c *Client) UpdateSnippet(i *fastly.UpdateSnippetInput) (*fastly.Snippet, error) {
+	return result[*fastly.Snippet](c, "UpdateSnippet", i)
+}
+
+// UpdateDynamicSnippet implements api.Interface.
+func (c *Client) UpdateDynamicSnippet(i *fastly.UpdateDynamicSnippetInput) (*fastly.DynamicSnippet, error) {
+	return result[*fastly.DynamicSnippet](c, "UpdateDynamicSnippet", i)
+}
+
+// DeleteSnippet implements api.Interface.
+func (c *Client) DeleteSnippet(i *fastly.DeleteSnippetInput) error {
+	c.record("DeleteSnippet", i)
+	return nil
+}
+
+// Purge implements api.Interface.
+func (c *Client) Purge(i *fastly.PurgeInput) (*fastly.Purge, error) {
+	return result[*fastly.Purge](c, "Purge", i)
+}
+
+// PurgeKey implements api.Interface.
+func (c *Client) PurgeKey(i *fastly.PurgeKeyInput) (*fastly.Purge, error) {
+	return result[*fastly.Purge](c, "PurgeKey", i)
+}
+
+// PurgeAll implements api.Interface.
+func (c *Client) PurgeAll(i *fastly.PurgeAllInput) (*fastly.Purge, error) {
+	return result[*fastly.Purge](c, "PurgeAll", i)
+}
+
+// CreateACL implements api.Interface.
+func (c *Client) CreateACL(i *fastly.CreateACLInput) (*fastly.ACL, error) {
+	return result[*fastly.ACL](c, "CreateACL", i)
+}
+
+// DeleteACL implements api.Interface.
+func (c *Client) DeleteACL(i *fastly.DeleteACLInput) error {
+	c.record("DeleteACL", i)
+	return nil
+}
+
+// UpdateACL implements api.Interface.
+func (c *Client) UpdateACL(i *fastly.UpdateACLInput) (*fastly.ACL, error) {
+	return result[*fastly.ACL](c, "UpdateACL", i)
+}
+
+// CreateACLEntry implements api.Interface.
+func (c *Client) CreateACLEntry(i *fastly.CreateACLEntryInput) (*fastly.ACLEntry, error) {
+	return result[*fastly.ACLEntry](c, "CreateACLEntry", i)
+}
+
+// DeleteACLEntry implements api.Interface.
+func (c *Client) DeleteACLEntry(i *fastly.DeleteACLEntryInput) error {
+	c.record("DeleteACLEntry", i)
+	return nil
+}
+
+// UpdateACLEntry implements api.Interface.
+func (c *Client) UpdateACLEntry(i *fastly.UpdateACLEntryInput) (*fastly.ACLEntry, error) {
+	return result[*fastly.ACLEntry](c, "UpdateACLEntry", i)
+}
+
+// BatchModifyACLEntries implements api.Interface.
+func (c *Client) BatchModifyACLEntries(i *fastly.BatchModifyACLEntriesInput) error {
+	c.record("BatchModifyACLEntries", i)
+	return nil
+}
+
+// CreateNewRelic implements api.Interface.
+func (c *Client) CreateNewRelic(i *fastly.CreateNewRelicInput) (*fastly.NewRelic, error) {
+	return result[*fastly.NewRelic](c, "CreateNewRelic", i)
+}
+
+// DeleteNewRelic implements api.Interface.
+func (c *Client) DeleteNewRelic(i *fastly.DeleteNewRelicInput) error {
+	c.record("DeleteNewRelic", i)
+	return nil
+}
+
+// UpdateNewRelic implements api.Interface.
+func (c *Client) UpdateNewRelic(i *fastly.UpdateNewRelicInput) (*fastly.NewRelic, error) {
+	return result[*fastly.NewRelic](c, "UpdateNewRelic", i)
+}
+
+// CreateNewRelicOTLP implements api.Interface.
+func (c *Client) CreateNewRelicOTLP(i *fastly.CreateNewRelicOTLPInput) (*fastly.NewRelicOTLP, error) {
+	return result[*fastly.NewRelicOTLP](c, "CreateNewRelicOTLP", i)
+}
+
+// DeleteNewRelicOTLP implements api.Interface.
+func (c *Client) DeleteNewRelicOTLP(i *fastly.DeleteNewRelicOTLPInput) error {
+	c.record("DeleteNewRelicOTLP", i)
+	return nil
+}
+
+// UpdateNewRelicOTLP implements api.Interface.
+func (c *Client) UpdateNewRelicOTLP(i *fastly.UpdateNewRelicOTLPInput) (*fastly.NewRelicOTLP, error) {
+	return result[*fastly.NewRelicOTLP](c, "UpdateNewRelicOTLP", i)
+}
+
+// CreateUser implements api.Interface.
+func (c *Client) CreateUser(i *fastly.CreateUserInput) (*fastly.User, error) {
+	return result[*fastly.User](c, "CreateUser", i)
+}
+
+// DeleteUser implements api.Interface.
+func (c *Client) DeleteUser(i *fastly.DeleteUserInput) error {
+	c.record("DeleteUser", i)
+	return nil
+}
+
+// UpdateUser implements api.Interface.
+func (c *Client) UpdateUser(i *fastly.UpdateUserInput) (*fastly.User, error) {
+	return result[*fastly.User](c, "UpdateUser", i)
+}
+
+// ResetUserPassword implements api.Interface.
+func (c *Client) ResetUserPassword(i *fastly.ResetUserPasswordInput) error {
+	c.record("ResetUserPassword", i)
+	return nil
+}
+
+// BatchDeleteTokens implements api.Interface.
+func (c *Client) BatchDeleteTokens(i *fastly.BatchDeleteTokensInput) error {
+	c.record("BatchDeleteTokens", i)
+	return nil
+}
+
+// CreateToken implements api.Interface.
+func (c *Client) CreateToken(i *fastly.CreateTokenInput) (*fastly.Token, error) {
+	return result[*fastly.Token](c, "CreateToken", i)
+}
+
+// DeleteToken implements api.Interface.
+func (c *Client) DeleteToken(i *fastly.DeleteTokenInput) error {
+	c.record("DeleteToken", i)
+	return nil
+}
+
+// UpdateCustomTLSConfiguration implements api.Interface.
+func (c *Client) UpdateCustomTLSConfiguration(i *fastly.UpdateCustomTLSConfigurationInput) (*fastly.CustomTLSConfiguration, error) {
+	return result[*fastly.CustomTLSConfiguration](c, "UpdateCustomTLSConfiguration", i)
+}
+
+// UpdateTLSActivation implements api.Interface.
+func (c *Client) UpdateTLSActivation(i *fastly.UpdateTLSActivationInput) (*fastly.TLSActivation, error) {
+	return result[*fastly.TLSActivation](c, "UpdateTLSActivation", i)
+}
+
+// CreateTLSActivation implements api.Interface.
+func (c *Client) CreateTLSActivation(i *fastly.CreateTLSActivationInput) (*fastly.TLSActivation, error) {
+	return result[*fastly.TLSActivation](c, "CreateTLSActivation", i)
+}
+
+// DeleteTLSActivation implements api.Interface.
+func (c *Client) DeleteTLSActivation(i *fastly.DeleteTLSActivationInput) error {
+	c.record("DeleteTLSActivation", i)
+	return nil
+}
+
+// CreateCustomTLSCertificate implements api.Interface.
+func (c *Client) CreateCustomTLSCertificate(i *fastly.CreateCustomTLSCertificateInput) (*fastly.CustomTLSCertificate, error) {
+	return result[*fastly.CustomTLSCertificate](c, "CreateCustomTLSCertificate", i)
+}
+
+// DeleteCustomTLSCertificate implements api.Interface.
+func (c *Client) DeleteCustomTLSCertificate(i *fastly.DeleteCustomTLSCertificateInput) error {
+	c.record("DeleteCustomTLSCertificate", i)
+	return nil
+}
+
+// UpdateCustomTLSCertificate implements api.Interface.
+func (c *Client) UpdateCustomTLSCertificate(i *fastly.UpdateCustomTLSCertificateInput) (*fastly.CustomTLSCertificate, error) {
+	return result[*fastly.CustomTLSCertificate](c, "UpdateCustomTLSCertificate", i)
+}
+
+// CreatePrivateKey implements api.Interface.
+func (c *Client) CreatePrivateKey(i *fastly.CreatePrivateKeyInput) (*fastly.PrivateKey, error) {
+	return result[*fastly.PrivateKey](c, "CreatePrivateKey", i)
+}
+
+// DeletePrivateKey implements api.Interface.
+func (c *Client) DeletePrivateKey(i *fastly.DeletePrivateKeyInput) error {
+	c.record("DeletePrivateKey", i)
+	return nil
+}
+
+// CreateBulkCertificate implements api.Interface.
+func (c *Client) CreateBulkCertificate(i *fastly.CreateBulkCertificateInput) (*fastly.BulkCertificate, error) {
+	return result[*fastly.BulkCertificate](c, "CreateBulkCertificate", i)
+}
+
+// DeleteBulkCertificate implements api.Interface.
+func (c *Client) DeleteBulkCertificate(i *fastly.DeleteBulkCertificateInput) error {
+	c.record("DeleteBulkCertificate", i)
+	return nil
+}
+
+// UpdateBulkCertificate implements api.Interface.
+func (c *Client) UpdateBulkCertificate(i *fastly.UpdateBulkCertificateInput) (*fastly.BulkCertificate, error) {
+	return result[*fastly.BulkCertificate](c, "UpdateBulkCertificate", i)
+}
+
+// CreateTLSSubscription implements api.Interface.
+func (c *Client) CreateTLSSubscription(i *fastly.CreateTLSSubscriptionInput) (*fastly.TLSSubscription, error) {
+	return result[*fastly.TLSSubscription](c, "CreateTLSSubscription", i)
+}
+
+// DeleteTLSSubscription implements api.Interface.
+func (c *Client) DeleteTLSSubscription(i *fastly.DeleteTLSSubscriptionInput) error {
+	c.record("DeleteTLSSubscription", i)
+	return nil
+}
+
+// UpdateTLSSubscription implements api.Interface.
+func (c *Client) UpdateTLSSubscription(i *fastly.UpdateTLSSubscriptionInput) (*fastly.TLSSubscription, error) {
+	return result[*fastly.TLSSubscription](c, "UpdateTLSSubscription", i)
+}
+
+// CreateServiceAuthorization implements api.Interface.
+func (c *Client) CreateServiceAuthorization(i *fastly.CreateServiceAuthorizationInput) (*fastly.ServiceAuthorization, error) {
+	return result[*fastly.ServiceAuthorization](c, "CreateServiceAuthorization", i)
+}
+
+// UpdateServiceAuthorization implements api.Interface.
+func (c *Client) UpdateServiceAuthorization(i *fastly.UpdateServiceAuthorizationInput) (*fastly.ServiceAuthorization, error) {
+	return result[*fastly.ServiceAuthorization](c, "UpdateServiceAuthorization", i)
+}
+
+// DeleteServiceAuthorization implements api.Interface.
+func (c *Client) DeleteServiceAuthorization(i *fastly.DeleteServiceAuthorizationInput) error {
+	c.record("DeleteServiceAuthorization", i)
+	return nil
+}
+
+// CreateConfigStore implements api.Interface.
+func (c *Client) CreateConfigStore(i *fastly.CreateConfigStoreInput) (*fastly.ConfigStore, error) {
+	return result[*fastly.ConfigStore](c, "CreateConfigStore", i)
+}
+
+// DeleteConfigStore implements api.Interface.
+func (c *Client) DeleteConfigStore(i *fastly.DeleteConfigStoreInput) error {
+	c.record("DeleteConfigStore", i)
+	return nil
+}
+
+// UpdateConfigStore implements api.Interface.
+func (c *Client) UpdateConfigStore(i *fastly.UpdateConfigStoreInput) (*fastly.ConfigStore, error) {
+	return result[*fastly.ConfigStore](c, "UpdateConfigStore", i)
+}
+
+// CreateConfigStoreItem implements api.Interface.
+func (c *Client) CreateConfigStoreItem(i *fastly.CreateConfigStoreItemInput) (*fastly.ConfigStoreItem, error) {
+	return result[*fastly.ConfigStoreItem](c, "CreateConfigStoreItem", i)
+}
+
+// DeleteConfigStoreItem implements api.Interface.
+func (c *Client) DeleteConfigStoreItem(i *fastly.DeleteConfigStoreItemInput) error {
+	c.record("DeleteConfigStoreItem", i)
+	return nil
+}
+
+// UpdateConfigStoreItem implements api.Interface.
+func (c *Client) UpdateConfigStoreItem(i *fastly.UpdateConfigStoreItemInput) (*fastly.ConfigStoreItem, error) {
+	return result[*fastly.ConfigStoreItem](c, "UpdateConfigStoreItem", i)
+}
+
+// CreateKVStore implements api.Interface.
+func (c *Client) CreateKVStore(i *fastly.CreateKVStoreInput) (*fastly.KVStore, error) {
+	return result[*fastly.KVStore](c, "CreateKVStore", i)
+}
+
+// DeleteKVStore implements api.Interface.
+func (c *Client) DeleteKVStore(i *fastly.DeleteKVStoreInput) error {
+	c.record("DeleteKVStore", i)
+	return nil
+}
+
+// DeleteKVStoreKey implements api.Interface.
+func (c *Client) DeleteKVStoreKey(i *fastly.DeleteKVStoreKeyInput) error {
+	c.record("DeleteKVStoreKey", i)
+	return nil
+}
+
+// InsertKVStoreKey implements api.Interface.
+func (c *Client) InsertKVStoreKey(i *fastly.InsertKVStoreKeyInput) error {
+	c.record("InsertKVStoreKey", i)
+	return nil
+}
+
+// BatchModifyKVStoreKey implements api.Interface.
+func (c *Client) BatchModifyKVStoreKey(i *fastly.BatchModifyKVStoreKeyInput) error {
+	c.record("BatchModifyKVStoreKey", i)
+	return nil
+}
+
+// CreateSecretStore implements api.Interface.
+func (c *Client) CreateSecretStore(i *fastly.CreateSecretStoreInput) (*fastly.SecretStore, error) {
+	return result[*fastly.SecretStore](c, "CreateSecretStore", i)
+}
+
+// DeleteSecretStore implements api.Interface.
+func (c *Client) DeleteSecretStore(i *fastly.DeleteSecretStoreInput) error {
+	c.record("DeleteSecretStore", i)
+	return nil
+}
+
+// CreateSecret implements api.Interface.
+func (c *Client) CreateSecret(i *fastly.CreateSecretInput) (*fastly.Secret, error) {
+	return result[*fastly.Secret](c, "CreateSecret", i)
+}
+
+// DeleteSecret implements api.Interface.
+func (c *Client) DeleteSecret(i *fastly.DeleteSecretInput) error {
+	c.record("DeleteSecret", i)
+	return nil
+}
+
+// CreateResource implements api.Interface.
+func (c *Client) CreateResource(i *fastly.CreateResourceInput) (*fastly.Resource, error) {
+	return result[*fastly.Resource](c, "CreateResource", i)
+}
+
+// DeleteResource implements api.Interface.
+func (c *Client) DeleteResource(i *fastly.DeleteResourceInput) error {
+	c.record("DeleteResource", i)
+	return nil
+}
+
+// UpdateResource implements api.Interface.
+func (c *Client) UpdateResource(i *fastly.UpdateResourceInput) (*fastly.Resource, error) {
+	return result[*fastly.Resource](c, "UpdateResource", i)
+}
+
+// CreateERL implements api.Interface.
+func (c *Client) CreateERL(i *fastly.CreateERLInput) (*fastly.ERL, error) {
+	return result[*fastly.ERL](c, "CreateERL", i)
+}
+
+// DeleteERL implements api.Interface.
+func (c *Client) DeleteERL(i *fastly.DeleteERLInput) error {
+	c.record("DeleteERL", i)
+	return nil
+}
+
+// UpdateERL implements api.Interface.
+func (c *Client) UpdateERL(i *fastly.UpdateERLInput) (*fastly.ERL, error) {
+	return result[*fastly.ERL](c, "UpdateERL", i)
+}
+
+// CreateCondition implements api.Interface.
+func (c *Client) CreateCondition(i *fastly.CreateConditionInput) (*fastly.Condition, error) {
+	return result[*fastly.Condition](c, "CreateCondition", i)
+}
+
+// DeleteCondition implements api.Interface.
+func (c *Client) DeleteCondition(i *fastly.DeleteConditionInput) error {
+	c.record("DeleteCondition", i)
+	return nil
+}
+
+// UpdateCondition implements api.Interface.
+func (c *Client) UpdateCondition(i *fastly.UpdateConditionInput) (*fastly.Condition, error) {
+	return result[*fastly.Condition](c, "UpdateCondition", i)
+}
+
+// EnableProduct implements api.Interface.
+func (c *Client) EnableProduct(i *fastly.ProductEnablementInput) (*fastly.ProductEnablement, error) {
+	return result[*fastly.ProductEnablement](c, "EnableProduct", i)
+}
+
+// DisableProduct implements api.Interface.
+func (c *Client) DisableProduct(i *fastly.ProductEnablementInput) error {
+	c.record("DisableProduct", i)
+	return nil
+}
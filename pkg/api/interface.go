@@ -37,6 +37,7 @@ type Interface interface {
 	DeactivateVersion(*fastly.DeactivateVersionInput) (*fastly.Version, error)
 	LockVersion(*fastly.LockVersionInput) (*fastly.Version, error)
 	LatestVersion(*fastly.LatestVersionInput) (*fastly.Version, error)
+	ValidateVersion(*fastly.ValidateVersionInput) (bool, string, error)
 
 	CreateDomain(*fastly.CreateDomainInput) (*fastly.Domain, error)
 	ListDomains(*fastly.ListDomainsInput) ([]*fastly.Domain, error)
@@ -58,6 +59,58 @@ type Interface interface {
 	UpdateHealthCheck(*fastly.UpdateHealthCheckInput) (*fastly.HealthCheck, error)
 	DeleteHealthCheck(*fastly.DeleteHealthCheckInput) error
 
+	CreateDirector(*fastly.CreateDirectorInput) (*fastly.Director, error)
+	ListDirectors(*fastly.ListDirectorsInput) ([]*fastly.Director, error)
+	GetDirector(*fastly.GetDirectorInput) (*fastly.Director, error)
+	UpdateDirector(*fastly.UpdateDirectorInput) (*fastly.Director, error)
+	DeleteDirector(*fastly.DeleteDirectorInput) error
+
+	CreateDirectorBackend(*fastly.CreateDirectorBackendInput) (*fastly.DirectorBackend, error)
+	GetDirectorBackend(*fastly.GetDirectorBackendInput) (*fastly.DirectorBackend, error)
+	DeleteDirectorBackend(*fastly.DeleteDirectorBackendInput) error
+
+	CreatePool(*fastly.CreatePoolInput) (*fastly.Pool, error)
+	ListPools(*fastly.ListPoolsInput) ([]*fastly.Pool, error)
+	GetPool(*fastly.GetPoolInput) (*fastly.Pool, error)
+	UpdatePool(*fastly.UpdatePoolInput) (*fastly.Pool, error)
+	DeletePool(*fastly.DeletePoolInput) error
+
+	CreateServer(*fastly.CreateServerInput) (*fastly.Server, error)
+	ListServers(*fastly.ListServersInput) ([]*fastly.Server, error)
+	GetServer(*fastly.GetServerInput) (*fastly.Server, error)
+	UpdateServer(*fastly.UpdateServerInput) (*fastly.Server, error)
+	DeleteServer(*fastly.DeleteServerInput) error
+
+	CreateHeader(*fastly.CreateHeaderInput) (*fastly.Header, error)
+	ListHeaders(*fastly.ListHeadersInput) ([]*fastly.Header, error)
+	GetHeader(*fastly.GetHeaderInput) (*fastly.Header, error)
+	UpdateHeader(*fastly.UpdateHeaderInput) (*fastly.Header, error)
+	DeleteHeader(*fastly.DeleteHeaderInput) error
+
+	CreateGzip(*fastly.CreateGzipInput) (*fastly.Gzip, error)
+	ListGzips(*fastly.ListGzipsInput) ([]*fastly.Gzip, error)
+	GetGzip(*fastly.GetGzipInput) (*fastly.Gzip, error)
+	UpdateGzip(*fastly.UpdateGzipInput) (*fastly.Gzip, error)
+	DeleteGzip(*fastly.DeleteGzipInput) error
+
+	CreateCacheSetting(*fastly.CreateCacheSettingInput) (*fastly.CacheSetting, error)
+	ListCacheSettings(*fastly.ListCacheSettingsInput) ([]*fastly.CacheSetting, error)
+	GetCacheSetting(*fastly.GetCacheSettingInput) (*fastly.CacheSetting, error)
+	UpdateCacheSetting(*fastly.UpdateCacheSettingInput) (*fastly.CacheSetting, error)
+	DeleteCacheSetting(*fastly.DeleteCacheSettingInput) error
+
+	CreateRequestSetting(*fastly.CreateRequestSettingInput) (*fastly.RequestSetting, error)
+	ListRequestSettings(*fastly.ListRequestSettingsInput) ([]*fastly.RequestSetting, error)
+	GetRequestSetting(*fastly.GetRequestSettingInput) (*fastly.RequestSetting, error)
+	UpdateRequestSetting(*fastly.UpdateRequestSettingInput) (*fastly.RequestSetting, error)
+	DeleteRequestSetting(*fastly.DeleteRequestSettingInput) error
+
+	CreateResponseObject(*fastly.CreateResponseObjectInput) (*fastly.ResponseObject, error)
+	ListResponseObjects(*fastly.ListResponseObjectsInput) ([]*fastly.ResponseObject, error)
+	GetResponseObject(*fastly.GetResponseObjectInput) (*fastly.ResponseObject, error)
+	UpdateResponseObject(*fastly.UpdateResponseObjectInput) (*fastly.ResponseObject, error)
+	DeleteResponseObject(*fastly.DeleteResponseObjectInput) error
+
 	GetPackage(*fastly.GetPackageInput) (*fastly.Package, error)
 	UpdatePackage(*fastly.UpdatePackageInput) (*fastly.Package, error)
 
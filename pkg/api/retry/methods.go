@@ -0,0 +1,2464 @@
+package retry
+
+import (
+	"crypto/ed25519"
+
+	"github.com/fastly/go-fastly/v8/fastly"
+)
+
+// AllDatacenters implements api.Interface.
+func (c *Client) AllDatacenters() ([]fastly.Datacenter, error) {
+	return call(c, "AllDatacenters", true, func() ([]fastly.Datacenter, error) {
+		return c.Interface.AllDatacenters()
+	})
+}
+
+// CreateService implements api.Interface.
+func (c *Client) CreateService(i *fastly.CreateServiceInput) (*fastly.Service, error) {
+	return call(c, "CreateService", false, func() (*fastly.Service, error) {
+		return c.Interface.CreateService(i)
+	})
+}
+
+// ListServices implements api.Interface.
+func (c *Client) ListServices(i *fastly.ListServicesInput) ([]*fastly.Service, error) {
+	return call(c, "ListServices", true, func() ([]*fastly.Service, error) {
+		return c.Interface.ListServices(i)
+	})
+}
+
+// GetService implements api.Interface.
+func (c *Client) GetService(i *fastly.GetServiceInput) (*fastly.Service, error) {
+	return call(c, "GetService", true, func() (*fastly.Service, error) {
+		return c.Interface.GetService(i)
+	})
+}
+
+// GetServiceDetails implements api.Interface.
+func (c *Client) GetServiceDetails(i *fastly.GetServiceInput) (*fastly.ServiceDetail, error) {
+	return call(c, "GetServiceDetails", true, func() (*fastly.ServiceDetail, error) {
+		return c.Interface.GetServiceDetails(i)
+	})
+}
+
+// UpdateService implements api.Interface.
+func (c *Client) UpdateService(i *fastly.UpdateServiceInput) (*fastly.Service, error) {
+	return call(c, "UpdateService", false, func() (*fastly.Service, error) {
+		return c.Interface.UpdateService(i)
+	})
+}
+
+// DeleteService implements api.Interface.
+func (c *Client) DeleteService(i *fastly.DeleteServiceInput) error {
+	return c.do("DeleteService", false, func() error {
+		return c.Interface.DeleteService(i)
+	})
+}
+
+// SearchService implements api.Interface.
+func (c *Client) SearchService(i *fastly.SearchServiceInput) (*fastly.Service, error) {
+	return call(c, "SearchService", true, func() (*fastly.Service, error) {
+		return c.Interface.SearchService(i)
+	})
+}
+
+// CloneVersion implements api.Interface.
+func (c *Client) CloneVersion(i *fastly.CloneVersionInput) (*fastly.Version, error) {
+	return call(c, "CloneVersion", false, func() (*fastly.Version, error) {
+		return c.Interface.CloneVersion(i)
+	})
+}
+
+// ListVersions implements api.Interface.
+func (c *Client) ListVersions(i *fastly.ListVersionsInput) ([]*fastly.Version, error) {
+	return call(c, "ListVersions", true, func() ([]*fastly.Version, error) {
+		return c.Interface.ListVersions(i)
+	})
+}
+
+// GetVersion implements api.Interface.
+func (c *Client) GetVersion(i *fastly.GetVersionInput) (*fastly.Version, error) {
+	return call(c, "GetVersion", true, func() (*fastly.Version, error) {
+		return c.Interface.GetVersion(i)
+	})
+}
+
+// UpdateVersion implements api.Interface.
+func (c *Client) UpdateVersion(i *fastly.UpdateVersionInput) (*fastly.Version, error) {
+	return call(c, "UpdateVersion", false, func() (*fastly.Version, error) {
+		return c.Interface.UpdateVersion(i)
+	})
+}
+
+// ActivateVersion implements api.Interface.
+func (c *Client) ActivateVersion(i *fastly.ActivateVersionInput) (*fastly.Version, error) {
+	return call(c, "ActivateVersion", false, func() (*fastly.Version, error) {
+		return c.Interface.ActivateVersion(i)
+	})
+}
+
+// DeactivateVersion implements api.Interface.
+func (c *Client) DeactivateVersion(i *fastly.DeactivateVersionInput) (*fastly.Version, error) {
+	return call(c, "DeactivateVersion", false, func() (*fastly.Version, error) {
+		return c.Interface.DeactivateVersion(i)
+	})
+}
+
+// LockVersion implements api.Interface.
+func (c *Client) LockVersion(i *fastly.LockVersionInput) (*fastly.Version, error) {
+	return call(c, "LockVersion", false, func() (*fastly.Version, error) {
+		return c.Interface.LockVersion(i)
+	})
+}
+
+// LatestVersion implements api.Interface.
+func (c *Client) LatestVersion(i *fastly.LatestVersionInput) (*fastly.Version, error) {
+	return call(c, "LatestVersion", true, func() (*fastly.Version, error) {
+		return c.Interface.LatestVersion(i)
+	})
+}
+
+// CreateDomain implements api.Interface.
+func (c *Client) CreateDomain(i *fastly.CreateDomainInput) (*fastly.Domain, error) {
+	return call(c, "CreateDomain", false, func() (*fastly.Domain, error) {
+		return c.Interface.CreateDomain(i)
+	})
+}
+
+// ListDomains implements api.Interface.
+func (c *Client) ListDomains(i *fastly.ListDomainsInput) ([]*fastly.Domain, error) {
+	return call(c, "ListDomains", true, func() ([]*fastly.Domain, error) {
+		return c.Interface.ListDomains(i)
+	})
+}
+
+// GetDomain implements api.Interface.
+func (c *Client) GetDomain(i *fastly.GetDomainInput) (*fastly.Domain, error) {
+	return call(c, "GetDomain", true, func() (*fastly.Domain, error) {
+		return c.Interface.GetDomain(i)
+	})
+}
+
+// UpdateDomain implements api.Interface.
+func (c *Client) UpdateDomain(i *fastly.UpdateDomainInput) (*fastly.Domain, error) {
+	return call(c, "UpdateDomain", false, func() (*fastly.Domain, error) {
+		return c.Interface.UpdateDomain(i)
+	})
+}
+
+// DeleteDomain implements api.Interface.
+func (c *Client) DeleteDomain(i *fastly.DeleteDomainInput) error {
+	return c.do("DeleteDomain", false, func() error {
+		return c.Interface.DeleteDomain(i)
+	})
+}
+
+// ValidateDomain implements api.Interface.
+func (c *Client) ValidateDomain(i *fastly.ValidateDomainInput) (*fastly.DomainValidationResult, error) {
+	return call(c, "ValidateDomain", true, func() (*fastly.DomainValidationResult, error) {
+		return c.Interface.ValidateDomain(i)
+	})
+}
+
+// ValidateAllDomains implements api.Interface.
+func (c *Client) ValidateAllDomains(i *fastly.ValidateAllDomainsInput) ([]*fastly.DomainValidationResult, error) {
+	return call(c, "ValidateAllDomains", true, func() ([]*fastly.DomainValidationResult, error) {
+		return c.Interface.ValidateAllDomains(i)
+	})
+}
+
+// CreateBackend implements api.Interface.
+func (c *Client) CreateBackend(i *fastly.CreateBackendInput) (*fastly.Backend, error) {
+	return call(c, "CreateBackend", false, func() (*fastly.Backend, error) {
+		return c.Interface.CreateBackend(i)
+	})
+}
+
+// ListBackends implements api.Interface.
+func (c *Client) ListBackends(i *fastly.ListBackendsInput) ([]*fastly.Backend, error) {
+	return call(c, "ListBackends", true, func() ([]*fastly.Backend, error) {
+		return c.Interface.ListBackends(i)
+	})
+}
+
+// GetBackend implements api.Interface.
+func (c *Client) GetBackend(i *fastly.GetBackendInput) (*fastly.Backend, error) {
+	return call(c, "GetBackend", true, func() (*fastly.Backend, error) {
+		return c.Interface.GetBackend(i)
+	})
+}
+
+// UpdateBackend implements api.Interface.
+func (c *Client) UpdateBackend(i *fastly.UpdateBackendInput) (*fastly.Backend, error) {
+	return call(c, "UpdateBackend", false, func() (*fastly.Backend, error) {
+		return c.Interface.UpdateBackend(i)
+	})
+}
+
+// DeleteBackend implements api.Interface.
+func (c *Client) DeleteBackend(i *fastly.DeleteBackendInput) error {
+	return c.do("DeleteBackend", false, func() error {
+		return c.Interface.DeleteBackend(i)
+	})
+}
+
+// CreateHealthCheck implements api.Interface.
+func (c *Client) CreateHealthCheck(i *fastly.CreateHealthCheckInput) (*fastly.HealthCheck, error) {
+	return call(c, "CreateHealthCheck", false, func() (*fastly.HealthCheck, error) {
+		return c.Interface.CreateHealthCheck(i)
+	})
+}
+
+// ListHealthChecks implements api.Interface.
+func (c *Client) ListHealthChecks(i *fastly.ListHealthChecksInput) ([]*fastly.HealthCheck, error) {
+	return call(c, "ListHealthChecks", true, func() ([]*fastly.HealthCheck, error) {
+		return c.Interface.ListHealthChecks(i)
+	})
+}
+
+// GetHealthCheck implements api.Interface.
+func (c *Client) GetHealthCheck(i *fastly.GetHealthCheckInput) (*fastly.HealthCheck, error) {
+	return call(c, "GetHealthCheck", true, func() (*fastly.HealthCheck, error) {
+		return c.Interface.GetHealthCheck(i)
+	})
+}
+
+// UpdateHealthCheck implements api.Interface.
+func (c *Client) UpdateHealthCheck(i *fastly.UpdateHealthCheckInput) (*fastly.HealthCheck, error) {
+	return call(c, "UpdateHealthCheck", false, func() (*fastly.HealthCheck, error) {
+		return c.Interface.UpdateHealthCheck(i)
+	})
+}
+
+// DeleteHealthCheck implements api.Interface.
+func (c *Client) DeleteHealthCheck(i *fastly.DeleteHealthCheckInput) error {
+	return c.do("DeleteHealthCheck", false, func() error {
+		return c.Interface.DeleteHealthCheck(i)
+	})
+}
+
+// CreateDirector implements api.Interface.
+func (c *Client) CreateDirector(i *fastly.CreateDirectorInput) (*fastly.Director, error) {
+	return call(c, "CreateDirector", false, func() (*fastly.Director, error) {
+		return c.Interface.CreateDirector(i)
+	})
+}
+
+// ListDirectors implements api.Interface.
+func (c *Client) ListDirectors(i *fastly.ListDirectorsInput) ([]*fastly.Director, error) {
+	return call(c, "ListDirectors", true, func() ([]*fastly.Director, error) {
+		return c.Interface.ListDirectors(i)
+	})
+}
+
+// GetDirector implements api.Interface.
+func (c *Client) GetDirector(i *fastly.GetDirectorInput) (*fastly.Director, error) {
+	return call(c, "GetDirector", true, func() (*fastly.Director, error) {
+		return c.Interface.GetDirector(i)
+	})
+}
+
+// UpdateDirector implements api.Interface.
+func (c *Client) UpdateDirector(i *fastly.UpdateDirectorInput) (*fastly.Director, error) {
+	return call(c, "UpdateDirector", false, func() (*fastly.Director, error) {
+		return c.Interface.UpdateDirector(i)
+	})
+}
+
+// DeleteDirector implements api.Interface.
+func (c *Client) DeleteDirector(i *fastly.DeleteDirectorInput) error {
+	return c.do("DeleteDirector", false, func() error {
+		return c.Interface.DeleteDirector(i)
+	})
+}
+
+// CreateDirectorBackend implements api.Interface.
+func (c *Client) CreateDirectorBackend(i *fastly.CreateDirectorBackendInput) (*fastly.DirectorBackend, error) {
+	return call(c, "CreateDirectorBackend", false, func() (*fastly.DirectorBackend, error) {
+		return c.Interface.CreateDirectorBackend(i)
+	})
+}
+
+// GetDirectorBackend implements api.Interface.
+func (c *Client) GetDirectorBackend(i *fastly.GetDirectorBackendInput) (*fastly.DirectorBackend, error) {
+	return call(c, "GetDirectorBackend", true, func() (*fastly.DirectorBackend, error) {
+		return c.Interface.GetDirectorBackend(i)
+	})
+}
+
+// DeleteDirectorBackend implements api.Interface.
+func (c *Client) DeleteDirectorBackend(i *fastly.DeleteDirectorBackendInput) error {
+	return c.do("DeleteDirectorBackend", false, func() error {
+		return c.Interface.DeleteDirectorBackend(i)
+	})
+}
+
+// CreatePool implements api.Interface.
+func (c *Client) CreatePool(i *fastly.CreatePoolInput) (*fastly.Pool, error) {
+	return call(c, "CreatePool", false, func() (*fastly.Pool, error) {
+		return c.Interface.CreatePool(i)
+	})
+}
+
+// ListPools implements api.Interface.
+func (c *Client) ListPools(i *fastly.ListPoolsInput) ([]*fastly.Pool, error) {
+	return call(c, "ListPools", true, func() ([]*fastly.Pool, error) {
+		return c.Interface.ListPools(i)
+	})
+}
+
+// GetPool implements api.Interface.
+func (c *Client) GetPool(i *fastly.GetPoolInput) (*fastly.Pool, error) {
+	return call(c, "GetPool", true, func() (*fastly.Pool, error) {
+		return c.Interface.GetPool(i)
+	})
+}
+
+// UpdatePool implements api.Interface.
+func (c *Client) UpdatePool(i *fastly.UpdatePoolInput) (*fastly.Pool, error) {
+	return call(c, "UpdatePool", false, func() (*fastly.Pool, error) {
+		return c.Interface.UpdatePool(i)
+	})
+}
+
+// DeletePool implements api.Interface.
+func (c *Client) DeletePool(i *fastly.DeletePoolInput) error {
+	return c.do("DeletePool", false, func() error {
+		return c.Interface.DeletePool(i)
+	})
+}
+
+// CreateServer implements api.Interface.
+func (c *Client) CreateServer(i *fastly.CreateServerInput) (*fastly.Server, error) {
+	return call(c, "CreateServer", false, func() (*fastly.Server, error) {
+		return c.Interface.CreateServer(i)
+	})
+}
+
+// ListServers implements api.Interface.
+func (c *Client) ListServers(i *fastly.ListServersInput) ([]*fastly.Server, error) {
+	return call(c, "ListServers", true, func() ([]*fastly.Server, error) {
+		return c.Interface.ListServers(i)
+	})
+}
+
+// GetServer implements api.Interface.
+func (c *Client) GetServer(i *fastly.GetServerInput) (*fastly.Server, error) {
+	return call(c, "GetServer", true, func() (*fastly.Server, error) {
+		return c.Interface.GetServer(i)
+	})
+}
+
+// UpdateServer implements api.Interface.
+func (c *Client) UpdateServer(i *fastly.UpdateServerInput) (*fastly.Server, error) {
+	return call(c, "UpdateServer", false, func() (*fastly.Server, error) {
+		return c.Interface.UpdateServer(i)
+	})
+}
+
+// DeleteServer implements api.Interface.
+func (c *Client) DeleteServer(i *fastly.DeleteServerInput) error {
+	return c.do("DeleteServer", false, func() error {
+		return c.Interface.DeleteServer(i)
+	})
+}
+
+// CreateHeader implements api.Interface.
+func (c *Client) CreateHeader(i *fastly.CreateHeaderInput) (*fastly.Header, error) {
+	return call(c, "CreateHeader", false, func() (*fastly.Header, error) {
+		return c.Interface.CreateHeader(i)
+	})
+}
+
+// ListHeaders implements api.Interface.
+func (c *Client) ListHeaders(i *fastly.ListHeadersInput) ([]*fastly.Header, error) {
+	return call(c, "ListHeaders", true, func() ([]*fastly.Header, error) {
+		return c.Interface.ListHeaders(i)
+	})
+}
+
+// GetHeader implements api.Interface.
+func (c *Client) GetHeader(i *fastly.GetHeaderInput) (*fastly.Header, error) {
+	return call(c, "GetHeader", true, func() (*fastly.Header, error) {
+		return c.Interface.GetHeader(i)
+	})
+}
+
+// UpdateHeader implements api.Interface.
+func (c *Client) UpdateHeader(i *fastly.UpdateHeaderInput) (*fastly.Header, error) {
+	return call(c, "UpdateHeader", false, func() (*fastly.Header, error) {
+		return c.Interface.UpdateHeader(i)
+	})
+}
+
+// DeleteHeader implements api.Interface.
+func (c *Client) DeleteHeader(i *fastly.DeleteHeaderInput) error {
+	return c.do("DeleteHeader", false, func() error {
+		return c.Interface.DeleteHeader(i)
+	})
+}
+
+// CreateGzip implements api.Interface.
+func (c *Client) CreateGzip(i *fastly.CreateGzipInput) (*fastly.Gzip, error) {
+	return call(c, "CreateGzip", false, func() (*fastly.Gzip, error) {
+		return c.Interface.CreateGzip(i)
+	})
+}
+
+// ListGzips implements api.Interface.
+func (c *Client) ListGzips(i *fastly.ListGzipsInput) ([]*fastly.Gzip, error) {
+	return call(c, "ListGzips", true, func() ([]*fastly.Gzip, error) {
+		return c.Interface.ListGzips(i)
+	})
+}
+
+// GetGzip implements api.Interface.
+func (c *Client) GetGzip(i *fastly.GetGzipInput) (*fastly.Gzip, error) {
+	return call(c, "GetGzip", true, func() (*fastly.Gzip, error) {
+		return c.Interface.GetGzip(i)
+	})
+}
+
+// UpdateGzip implements api.Interface.
+func (c *Client) UpdateGzip(i *fastly.UpdateGzipInput) (*fastly.Gzip, error) {
+	return call(c, "UpdateGzip", false, func() (*fastly.Gzip, error) {
+		return c.Interface.UpdateGzip(i)
+	})
+}
+
+// DeleteGzip implements api.Interface.
+func (c *Client) DeleteGzip(i *fastly.DeleteGzipInput) error {
+	return c.do("DeleteGzip", false, func() error {
+		return c.Interface.DeleteGzip(i)
+	})
+}
+
+// CreateCacheSetting implements api.Interface.
+func (c *Client) CreateCacheSetting(i *fastly.CreateCacheSettingInput) (*fastly.CacheSetting, error) {
+	return call(c, "CreateCacheSetting", false, func() (*fastly.CacheSetting, error) {
+		return c.Interface.CreateCacheSetting(i)
+	})
+}
+
+// ListCacheSettings implements api.Interface.
+func (c *Client) ListCacheSettings(i *fastly.ListCacheSettingsInput) ([]*fastly.CacheSetting, error) {
+	return call(c, "ListCacheSettings", true, func() ([]*fastly.CacheSetting, error) {
+		return c.Interface.ListCacheSettings(i)
+	})
+}
+
+// GetCacheSetting implements api.Interface.
+func (c *Client) GetCacheSetting(i *fastly.GetCacheSettingInput) (*fastly.CacheSetting, error) {
+	return call(c, "GetCacheSetting", true, func() (*fastly.CacheSetting, error) {
+		return c.Interface.GetCacheSetting(i)
+	})
+}
+
+// UpdateCacheSetting implements api.Interface.
+func (c *Client) UpdateCacheSetting(i *fastly.UpdateCacheSettingInput) (*fastly.CacheSetting, error) {
+	return call(c, "UpdateCacheSetting", false, func() (*fastly.CacheSetting, error) {
+		return c.Interface.UpdateCacheSetting(i)
+	})
+}
+
+// DeleteCacheSetting implements api.Interface.
+func (c *Client) DeleteCacheSetting(i *fastly.DeleteCacheSettingInput) error {
+	return c.do("DeleteCacheSetting", false, func() error {
+		return c.Interface.DeleteCacheSetting(i)
+	})
+}
+
+// CreateRequestSetting implements api.Interface.
+func (c *Client) CreateRequestSetting(i *fastly.CreateRequestSettingInput) (*fastly.RequestSetting, error) {
+	return call(c, "CreateRequestSetting", false, func() (*fastly.RequestSetting, error) {
+		return c.Interface.CreateRequestSetting(i)
+	})
+}
+
+// ListRequestSettings implements api.Interface.
+func (c *Client) ListRequestSettings(i *fastly.ListRequestSettingsInput) ([]*fastly.RequestSetting, error) {
+	return call(c, "ListRequestSettings", true, func() ([]*fastly.RequestSetting, error) {
+		return c.Interface.ListRequestSettings(i)
+	})
+}
+
+// GetRequestSetting implements api.Interface.
+func (c *Client) GetRequestSetting(i *fastly.GetRequestSettingInput) (*fastly.RequestSetting, error) {
+	return call(c, "GetRequestSetting", true, func() (*fastly.RequestSetting, error) {
+		return c.Interface.GetRequestSetting(i)
+	})
+}
+
+// UpdateRequestSetting implements api.Interface.
+func (c *Client) UpdateRequestSetting(i *fastly.UpdateRequestSettingInput) (*fastly.RequestSetting, error) {
+	return call(c, "UpdateRequestSetting", false, func() (*fastly.RequestSetting, error) {
+		return c.Interface.UpdateRequestSetting(i)
+	})
+}
+
+// DeleteRequestSetting implements api.Interface.
+func (c *Client) DeleteRequestSetting(i *fastly.DeleteRequestSettingInput) error {
+	return c.do("DeleteRequestSetting", false, func() error {
+		return c.Interface.DeleteRequestSetting(i)
+	})
+}
+
+// CreateResponseObject implements api.Interface.
+func (c *Client) CreateResponseObject(i *fastly.CreateResponseObjectInput) (*fastly.ResponseObject, error) {
+	return call(c, "CreateResponseObject", false, func() (*fastly.ResponseObject, error) {
+		return c.Interface.CreateResponseObject(i)
+	})
+}
+
+// ListResponseObjects implements api.Interface.
+func (c *Client) ListResponseObjects(i *fastly.ListResponseObjectsInput) ([]*fastly.ResponseObject, error) {
+	return call(c, "ListResponseObjects", true, func() ([]*fastly.ResponseObject, error) {
+		return c.Interface.ListResponseObjects(i)
+	})
+}
+
+// GetResponseObject implements api.Interface.
+func (c *Client) GetResponseObject(i *fastly.GetResponseObjectInput) (*fastly.ResponseObject, error) {
+	return call(c, "GetResponseObject", true, func() (*fastly.ResponseObject, error) {
+		return c.Interface.GetResponseObject(i)
+	})
+}
+
+// UpdateResponseObject implements api.Interface.
+func (c *Client) UpdateResponseObject(i *fastly.UpdateResponseObjectInput) (*fastly.ResponseObject, error) {
+	return call(c, "UpdateResponseObject", false, func() (*fastly.ResponseObject, error) {
+		return c.Interface.UpdateResponseObject(i)
+	})
+}
+
+// DeleteResponseObject implements api.Interface.
+func (c *Client) DeleteResponseObject(i *fastly.DeleteResponseObjectInput) error {
+	return c.do("DeleteResponseObject", false, func() error {
+		return c.Interface.DeleteResponseObject(i)
+	})
+}
+
+// GetPackage implements api.Interface.
+func (c *Client) GetPackage(i *fastly.GetPackageInput) (*fastly.Package, error) {
+	return call(c, "GetPackage", true, func() (*fastly.Package, error) {
+		return c.Interface.GetPackage(i)
+	})
+}
+
+// UpdatePackage implements api.Interface.
+func (c *Client) UpdatePackage(i *fastly.UpdatePackageInput) (*fastly.Package, error) {
+	return call(c, "UpdatePackage", false, func() (*fastly.Package, error) {
+		return c.Interface.UpdatePackage(i)
+	})
+}
+
+// CreateDictionary implements api.Interface.
+func (c *Client) CreateDictionary(i *fastly.CreateDictionaryInput) (*fastly.Dictionary, error) {
+	return call(c, "CreateDictionary", false, func() (*fastly.Dictionary, error) {
+		return c.Interface.CreateDictionary(i)
+	})
+}
+
+// GetDictionary implements api.Interface.
+func (c *Client) GetDictionary(i *fastly.GetDictionaryInput) (*fastly.Dictionary, error) {
+	return call(c, "GetDictionary", true, func() (*fastly.Dictionary, error) {
+		return c.Interface.GetDictionary(i)
+	})
+}
+
+// DeleteDictionary implements api.Interface.
+func (c *Client) DeleteDictionary(i *fastly.DeleteDictionaryInput) error {
+	return c.do("DeleteDictionary", false, func() error {
+		return c.Interface.DeleteDictionary(i)
+	})
+}
+
+// ListDictionaries implements api.Interface.
+func (c *Client) ListDictionaries(i *fastly.ListDictionariesInput) ([]*fastly.Dictionary, error) {
+	return call(c, "ListDictionaries", true, func() ([]*fastly.Dictionary, error) {
+		return c.Interface.ListDictionaries(i)
+	})
+}
+
+// UpdateDictionary implements api.Interface.
+func (c *Client) UpdateDictionary(i *fastly.UpdateDictionaryInput) (*fastly.Dictionary, error) {
+	return call(c, "UpdateDictionary", false, func() (*fastly.Dictionary, error) {
+		return c.Interface.UpdateDictionary(i)
+	})
+}
+
+// ListDictionaryItems implements api.Interface.
+func (c *Client) ListDictionaryItems(i *fastly.ListDictionaryItemsInput) ([]*fastly.DictionaryItem, error) {
+	return call(c, "ListDictionaryItems", true, func() ([]*fastly.DictionaryItem, error) {
+		return c.Interface.ListDictionaryItems(i)
+	})
+}
+
+// GetDictionaryItem implements api.Interface.
+func (c *Client) GetDictionaryItem(i *fastly.GetDictionaryItemInput) (*fastly.DictionaryItem, error) {
+	return call(c, "GetDictionaryItem", true, func() (*fastly.DictionaryItem, error) {
+		return c.Interface.GetDictionaryItem(i)
+	})
+}
+
+// CreateDictionaryItem implements api.Interface.
+func (c *Client) CreateDictionaryItem(i *fastly.CreateDictionaryItemInput) (*fastly.DictionaryItem, error) {
+	return call(c, "CreateDictionaryItem", false, func() (*fastly.DictionaryItem, error) {
+		return c.Interface.CreateDictionaryItem(i)
+	})
+}
+
+// UpdateDictionaryItem implements api.Interface.
+func (c *Client) UpdateDictionaryItem(i *fastly.UpdateDictionaryItemInput) (*fastly.DictionaryItem, error) {
+	return call(c, "UpdateDictionaryItem", false, func() (*fastly.DictionaryItem, error) {
+		return c.Interface.UpdateDictionaryItem(i)
+	})
+}
+
+// DeleteDictionaryItem implements api.Interface.
+func (c *Client) DeleteDictionaryItem(i *fastly.DeleteDictionaryItemInput) error {
+	return c.do("DeleteDictionaryItem", false, func() error {
+		return c.Interface.DeleteDictionaryItem(i)
+	})
+}
+
+// BatchModifyDictionaryItems implements api.Interface.
+func (c *Client) BatchModifyDictionaryItems(i *fastly.BatchModifyDictionaryItemsInput) error {
+	return c.do("BatchModifyDictionaryItems", false, func() error {
+		return c.Interface.BatchModifyDictionaryItems(i)
+	})
+}
+
+// GetDictionaryInfo implements api.Interface.
+func (c *Client) GetDictionaryInfo(i *fastly.GetDictionaryInfoInput) (*fastly.DictionaryInfo, error) {
+	return call(c, "GetDictionaryInfo", true, func() (*fastly.DictionaryInfo, error) {
+		return c.Interface.GetDictionaryInfo(i)
+	})
+}
+
+// CreateBigQuery implements api.Interface.
+func (c *Client) CreateBigQuery(i *fastly.CreateBigQueryInput) (*fastly.BigQuery, error) {
+	return call(c, "CreateBigQuery", false, func() (*fastly.BigQuery, error) {
+		return c.Interface.CreateBigQuery(i)
+	})
+}
+
+// ListBigQueries implements api.Interface.
+func (c *Client) ListBigQueries(i *fastly.ListBigQueriesInput) ([]*fastly.BigQuery, error) {
+	return call(c, "ListBigQueries", true, func() ([]*fastly.BigQuery, error) {
+		return c.Interface.ListBigQueries(i)
+	})
+}
+
+// GetBigQuery implements api.Interface.
+func (c *Client) GetBigQuery(i *fastly.GetBigQueryInput) (*fastly.BigQuery, error) {
+	return call(c, "GetBigQuery", true, func() (*fastly.BigQuery, error) {
+		return c.Interface.GetBigQuery(i)
+	})
+}
+
+// UpdateBigQuery implements api.Interface.
+func (c *Client) UpdateBigQuery(i *fastly.UpdateBigQueryInput) (*fastly.BigQuery, error) {
+	return call(c, "UpdateBigQuery", false, func() (*fastly.BigQuery, error) {
+		return c.Interface.UpdateBigQuery(i)
+	})
+}
+
+// DeleteBigQuery implements api.Interface.
+func (c *Client) DeleteBigQuery(i *fastly.DeleteBigQueryInput) error {
+	return c.do("DeleteBigQuery", false, func() error {
+		return c.Interface.DeleteBigQuery(i)
+	})
+}
+
+// CreateS3 implements api.Interface.
+func (c *Client) CreateS3(i *fastly.CreateS3Input) (*fastly.S3, error) {
+	return call(c, "CreateS3", false, func() (*fastly.S3, error) {
+		return c.Interface.CreateS3(i)
+	})
+}
+
+// ListS3s implements api.Interface.
+func (c *Client) ListS3s(i *fastly.ListS3sInput) ([]*fastly.S3, error) {
+	return call(c, "ListS3s", true, func() ([]*fastly.S3, error) {
+		return c.Interface.ListS3s(i)
+	})
+}
+
+// GetS3 implements api.Interface.
+func (c *Client) GetS3(i *fastly.GetS3Input) (*fastly.S3, error) {
+	return call(c, "GetS3", true, func() (*fastly.S3, error) {
+		return c.Interface.GetS3(i)
+	})
+}
+
+// UpdateS3 implements api.Interface.
+func (c *Client) UpdateS3(i *fastly.UpdateS3Input) (*fastly.S3, error) {
+	return call(c, "UpdateS3", false, func() (*fastly.S3, error) {
+		return c.Interface.UpdateS3(i)
+	})
+}
+
+// DeleteS3 implements api.Interface.
+func (c *Client) DeleteS3(i *fastly.DeleteS3Input) error {
+	return c.do("DeleteS3", false, func() error {
+		return c.Interface.DeleteS3(i)
+	})
+}
+
+// CreateKinesis implements api.Interface.
+func (c *Client) CreateKinesis(i *fastly.CreateKinesisInput) (*fastly.Kinesis, error) {
+	return call(c, "CreateKinesis", false, func() (*fastly.Kinesis, error) {
+		return c.Interface.CreateKinesis(i)
+	})
+}
+
+// ListKinesis implements api.Interface.
+func (c *Client) ListKinesis(i *fastly.ListKinesisInput) ([]*fastly.Kinesis, error) {
+	return call(c, "ListKinesis", true, func() ([]*fastly.Kinesis, error) {
+		return c.Interface.ListKinesis(i)
+	})
+}
+
+// GetKinesis implements api.Interface.
+func (c *Client) GetKinesis(i *fastly.GetKinesisInput) (*fastly.Kinesis, error) {
+	return call(c, "GetKinesis", true, func() (*fastly.Kinesis, error) {
+		return c.Interface.GetKinesis(i)
+	})
+}
+
+// UpdateKinesis implements api.Interface.
+func (c *Client) UpdateKinesis(i *fastly.UpdateKinesisInput) (*fastly.Kinesis, error) {
+	return call(c, "UpdateKinesis", false, func() (*fastly.Kinesis, error) {
+		return c.Interface.UpdateKinesis(i)
+	})
+}
+
+// DeleteKinesis implements api.Interface.
+func (c *Client) DeleteKinesis(i *fastly.DeleteKinesisInput) error {
+	return c.do("DeleteKinesis", false, func() error {
+		return c.Interface.DeleteKinesis(i)
+	})
+}
+
+// CreateSyslog implements api.Interface.
+func (c *Client) CreateSyslog(i *fastly.CreateSyslogInput) (*fastly.Syslog, error) {
+	return call(c, "CreateSyslog", false, func() (*fastly.Syslog, error) {
+		return c.Interface.CreateSyslog(i)
+	})
+}
+
+// ListSyslogs implements api.Interface.
+func (c *Client) ListSyslogs(i *fastly.ListSyslogsInput) ([]*fastly.Syslog, error) {
+	return call(c, "ListSyslogs", true, func() ([]*fastly.Syslog, error) {
+		return c.Interface.ListSyslogs(i)
+	})
+}
+
+// GetSyslog implements api.Interface.
+func (c *Client) GetSyslog(i *fastly.GetSyslogInput) (*fastly.Syslog, error) {
+	return call(c, "GetSyslog", true, func() (*fastly.Syslog, error) {
+		return c.Interface.GetSyslog(i)
+	})
+}
+
+// UpdateSyslog implements api.Interface.
+func (c *Client) UpdateSyslog(i *fastly.UpdateSyslogInput) (*fastly.Syslog, error) {
+	return call(c, "UpdateSyslog", false, func() (*fastly.Syslog, error) {
+		return c.Interface.UpdateSyslog(i)
+	})
+}
+
+// DeleteSyslog implements api.Interface.
+func (c *Client) DeleteSyslog(i *fastly.DeleteSyslogInput) error {
+	return c.do("DeleteSyslog", false, func() error {
+		return c.Interface.DeleteSyslog(i)
+	})
+}
+
+// CreateLogentries implements api.Interface.
+func (c *Client) CreateLogentries(i *fastly.CreateLogentriesInput) (*fastly.Logentries, error) {
+	return call(c, "CreateLogentries", false, func() (*fastly.Logentries, error) {
+		return c.Interface.CreateLogentries(i)
+	})
+}
+
+// ListLogentries implements api.Interface.
+func (c *Client) ListLogentries(i *fastly.ListLogentriesInput) ([]*fastly.Logentries, error) {
+	return call(c, "ListLogentries", true, func() ([]*fastly.Logentries, error) {
+		return c.Interface.ListLogentries(i)
+	})
+}
+
+// GetLogentries implements api.Interface.
+func (c *Client) GetLogentries(i *fastly.GetLogentriesInput) (*fastly.Logentries, error) {
+	return call(c, "GetLogentries", true, func() (*fastly.Logentries, error) {
+		return c.Interface.GetLogentries(i)
+	})
+}
+
+// UpdateLogentries implements api.Interface.
+func (c *Client) UpdateLogentries(i *fastly.UpdateLogentriesInput) (*fastly.Logentries, error) {
+	return call(c, "UpdateLogentries", false, func() (*fastly.Logentries, error) {
+		return c.Interface.UpdateLogentries(i)
+	})
+}
+
+// DeleteLogentries implements api.Interface.
+func (c *Client) DeleteLogentries(i *fastly.DeleteLogentriesInput) error {
+	return c.do("DeleteLogentries", false, func() error {
+		return c.Interface.DeleteLogentries(i)
+	})
+}
+
+// CreatePapertrail implements api.Interface.
+func (c *Client) CreatePapertrail(i *fastly.CreatePapertrailInput) (*fastly.Papertrail, error) {
+	return call(c, "CreatePapertrail", false, func() (*fastly.Papertrail, error) {
+		return c.Interface.CreatePapertrail(i)
+	})
+}
+
+// ListPapertrails implements api.Interface.
+func (c *Client) ListPapertrails(i *fastly.ListPapertrailsInput) ([]*fastly.Papertrail, error) {
+	return call(c, "ListPapertrails", true, func() ([]*fastly.Papertrail, error) {
+		return c.Interface.ListPapertrails(i)
+	})
+}
+
+// GetPapertrail implements api.Interface.
+func (c *Client) GetPapertrail(i *fastly.GetPapertrailInput) (*fastly.Papertrail, error) {
+	return call(c, "GetPapertrail", true, func() (*fastly.Papertrail, error) {
+		return c.Interface.GetPapertrail(i)
+	})
+}
+
+// UpdatePapertrail implements api.Interface.
+func (c *Client) UpdatePapertrail(i *fastly.UpdatePapertrailInput) (*fastly.Papertrail, error) {
+	return call(c, "UpdatePapertrail", false, func() (*fastly.Papertrail, error) {
+		return c.Interface.UpdatePapertrail(i)
+	})
+}
+
+// DeletePapertrail implements api.Interface.
+func (c *Client) DeletePapertrail(i *fastly.DeletePapertrailInput) error {
+	return c.do("DeletePapertrail", false, func() error {
+		return c.Interface.DeletePapertrail(i)
+	})
+}
+
+// CreateSumologic implements api.Interface.
+func (c *Client) CreateSumologic(i *fastly.CreateSumologicInput) (*fastly.Sumologic, error) {
+	return call(c, "CreateSumologic", false, func() (*fastly.Sumologic, error) {
+		return c.Interface.CreateSumologic(i)
+	})
+}
+
+// ListSumologics implements api.Interface.
+func (c *Client) ListSumologics(i *fastly.ListSumologicsInput) ([]*fastly.Sumologic, error) {
+	return call(c, "ListSumologics", true, func() ([]*fastly.Sumologic, error) {
+		return c.Interface.ListSumologics(i)
+	})
+}
+
+// GetSumologic implements api.Interface.
+func (c *Client) GetSumologic(i *fastly.GetSumologicInput) (*fastly.Sumologic, error) {
+	return call(c, "GetSumologic", true, func() (*fastly.Sumologic, error) {
+		return c.Interface.GetSumologic(i)
+	})
+}
+
+// UpdateSumologic implements api.Interface.
+func (c *Client) UpdateSumologic(i *fastly.UpdateSumologicInput) (*fastly.Sumologic, error) {
+	return call(c, "UpdateSumologic", false, func() (*fastly.Sumologic, error) {
+		return c.Interface.UpdateSumologic(i)
+	})
+}
+
+// DeleteSumologic implements api.Interface.
+func (c *Client) DeleteSumologic(i *fastly.DeleteSumologicInput) error {
+	return c.do("DeleteSumologic", false, func() error {
+		return c.Interface.DeleteSumologic(i)
+	})
+}
+
+// CreateGCS implements api.Interface.
+func (c *Client) CreateGCS(i *fastly.CreateGCSInput) (*fastly.GCS, error) {
+	return call(c, "CreateGCS", false, func() (*fastly.GCS, error) {
+		return c.Interface.CreateGCS(i)
+	})
+}
+
+// ListGCSs implements api.Interface.
+func (c *Client) ListGCSs(i *fastly.ListGCSsInput) ([]*fastly.GCS, error) {
+	return call(c, "ListGCSs", true, func() ([]*fastly.GCS, error) {
+		return c.Interface.ListGCSs(i)
+	})
+}
+
+// GetGCS implements api.Interface.
+func (c *Client) GetGCS(i *fastly.GetGCSInput) (*fastly.GCS, error) {
+	return call(c, "GetGCS", true, func() (*fastly.GCS, error) {
+		return c.Interface.GetGCS(i)
+	})
+}
+
+// UpdateGCS implements api.Interface.
+func (c *Client) UpdateGCS(i *fastly.UpdateGCSInput) (*fastly.GCS, error) {
+	return call(c, "UpdateGCS", false, func() (*fastly.GCS, error) {
+		return c.Interface.UpdateGCS(i)
+	})
+}
+
+// DeleteGCS implements api.Interface.
+func (c *Client) DeleteGCS(i *fastly.DeleteGCSInput) error {
+	return c.do("DeleteGCS", false, func() error {
+		return c.Interface.DeleteGCS(i)
+	})
+}
+
+// CreateFTP implements api.Interface.
+func (c *Client) CreateFTP(i *fastly.CreateFTPInput) (*fastly.FTP, error) {
+	return call(c, "CreateFTP", false, func() (*fastly.FTP, error) {
+		return c.Interface.CreateFTP(i)
+	})
+}
+
+// ListFTPs implements api.Interface.
+func (c *Client) ListFTPs(i *fastly.ListFTPsInput) ([]*fastly.FTP, error) {
+	return call(c, "ListFTPs", true, func() ([]*fastly.FTP, error) {
+		return c.Interface.ListFTPs(i)
+	})
+}
+
+// GetFTP implements api.Interface.
+func (c *Client) GetFTP(i *fastly.GetFTPInput) (*fastly.FTP, error) {
+	return call(c, "GetFTP", true, func() (*fastly.FTP, error) {
+		return c.Interface.GetFTP(i)
+	})
+}
+
+// UpdateFTP implements api.Interface.
+func (c *Client) UpdateFTP(i *fastly.UpdateFTPInput) (*fastly.FTP, error) {
+	return call(c, "UpdateFTP", false, func() (*fastly.FTP, error) {
+		return c.Interface.UpdateFTP(i)
+	})
+}
+
+// DeleteFTP implements api.Interface.
+func (c *Client) DeleteFTP(i *fastly.DeleteFTPInput) error {
+	return c.do("DeleteFTP", false, func() error {
+		return c.Interface.DeleteFTP(i)
+	})
+}
+
+// CreateSplunk implements api.Interface.
+func (c *Client) CreateSplunk(i *fastly.CreateSplunkInput) (*fastly.Splunk, error) {
+	return call(c, "CreateSplunk", false, func() (*fastly.Splunk, error) {
+		return c.Interface.CreateSplunk(i)
+	})
+}
+
+// ListSplunks implements api.Interface.
+func (c *Client) ListSplunks(i *fastly.ListSplunksInput) ([]*fastly.Splunk, error) {
+	return call(c, "ListSplunks", true, func() ([]*fastly.Splunk, error) {
+		return c.Interface.ListSplunks(i)
+	})
+}
+
+// GetSplunk implements api.Interface.
+func (c *Client) GetSplunk(i *fastly.GetSplunkInput) (*fastly.Splunk, error) {
+	return call(c, "GetSplunk", true, func() (*fastly.Splunk, error) {
+		return c.Interface.GetSplunk(i)
+	})
+}
+
+// UpdateSplunk implements api.Interface.
+func (c *Client) UpdateSplunk(i *fastly.UpdateSplunkInput) (*fastly.Splunk, error) {
+	return call(c, "UpdateSplunk", false, func() (*fastly.Splunk, error) {
+		return c.Interface.UpdateSplunk(i)
+	})
+}
+
+// DeleteSplunk implements api.Interface.
+func (c *Client) DeleteSplunk(i *fastly.DeleteSplunkInput) error {
+	return c.do("DeleteSplunk", false, func() error {
+		return c.Interface.DeleteSplunk(i)
+	})
+}
+
+// CreateScalyr implements api.Interface.
+func (c *Client) CreateScalyr(i *fastly.CreateScalyrInput) (*fastly.Scalyr, error) {
+	return call(c, "CreateScalyr", false, func() (*fastly.Scalyr, error) {
+		return c.Interface.CreateScalyr(i)
+	})
+}
+
+// ListScalyrs implements api.Interface.
+func (c *Client) ListScalyrs(i *fastly.ListScalyrsInput) ([]*fastly.Scalyr, error) {
+	return call(c, "ListScalyrs", true, func() ([]*fastly.Scalyr, error) {
+		return c.Interface.ListScalyrs(i)
+	})
+}
+
+// GetScalyr implements api.Interface.
+func (c *Client) GetScalyr(i *fastly.GetScalyrInput) (*fastly.Scalyr, error) {
+	return call(c, "GetScalyr", true, func() (*fastly.Scalyr, error) {
+		return c.Interface.GetScalyr(i)
+	})
+}
+
+// UpdateScalyr implements api.Interface.
+func (c *Client) UpdateScalyr(i *fastly.UpdateScalyrInput) (*fastly.Scalyr, error) {
+	return call(c, "UpdateScalyr", false, func() (*fastly.Scalyr, error) {
+		return c.Interface.UpdateScalyr(i)
+	})
+}
+
+// DeleteScalyr implements api.Interface.
+func (c *Client) DeleteScalyr(i *fastly.DeleteScalyrInput) error {
+	return c.do("DeleteScalyr", false, func() error {
+		return c.Interface.DeleteScalyr(i)
+	})
+}
+
+// CreateLoggly implements api.Interface.
+func (c *Client) CreateLoggly(i *fastly.CreateLogglyInput) (*fastly.Loggly, error) {
+	return call(c, "CreateLoggly", false, func() (*fastly.Loggly, error) {
+		return c.Interface.CreateLoggly(i)
+	})
+}
+
+// ListLoggly implements api.Interface.
+func (c *Client) ListLoggly(i *fastly.ListLogglyInput) ([]*fastly.Loggly, error) {
+	return call(c, "ListLoggly", true, func() ([]*fastly.Loggly, error) {
+		return c.Interface.ListLoggly(i)
+	})
+}
+
+// GetLoggly implements api.Interface.
+func (c *Client) GetLoggly(i *fastly.GetLogglyInput) (*fastly.Loggly, error) {
+	return call(c, "GetLoggly", true, func() (*fastly.Loggly, error) {
+		return c.Interface.GetLoggly(i)
+	})
+}
+
+// UpdateLoggly implements api.Interface.
+func (c *Client) UpdateLoggly(i *fastly.UpdateLogglyInput) (*fastly.Loggly, error) {
+	return call(c, "UpdateLoggly", false, func() (*fastly.Loggly, error) {
+		return c.Interface.UpdateLoggly(i)
+	})
+}
+
+// DeleteLoggly implements api.Interface.
+func (c *Client) DeleteLoggly(i *fastly.DeleteLogglyInput) error {
+	return c.do("DeleteLoggly", false, func() error {
+		return c.Interface.DeleteLoggly(i)
+	})
+}
+
+// CreateHoneycomb implements api.Interface.
+func (c *Client) CreateHoneycomb(i *fastly.CreateHoneycombInput) (*fastly.Honeycomb, error) {
+	return call(c, "CreateHoneycomb", false, func() (*fastly.Honeycomb, error) {
+		return c.Interface.CreateHoneycomb(i)
+	})
+}
+
+// ListHoneycombs implements api.Interface.
+func (c *Client) ListHoneycombs(i *fastly.ListHoneycombsInput) ([]*fastly.Honeycomb, error) {
+	return call(c, "ListHoneycombs", true, func() ([]*fastly.Honeycomb, error) {
+		return c.Interface.ListHoneycombs(i)
+	})
+}
+
+// GetHoneycomb implements api.Interface.
+func (c *Client) GetHoneycomb(i *fastly.GetHoneycombInput) (*fastly.Honeycomb, error) {
+	return call(c, "GetHoneycomb", true, func() (*fastly.Honeycomb, error) {
+		return c.Interface.GetHoneycomb(i)
+	})
+}
+
+// UpdateHoneycomb implements api.Interface.
+func (c *Client) UpdateHoneycomb(i *fastly.UpdateHoneycombInput) (*fastly.Honeycomb, error) {
+	return call(c, "UpdateHoneycomb", false, func() (*fastly.Honeycomb, error) {
+		return c.Interface.UpdateHoneycomb(i)
+	})
+}
+
+// DeleteHoneycomb implements api.Interface.
+func (c *Client) DeleteHoneycomb(i *fastly.DeleteHoneycombInput) error {
+	return c.do("DeleteHoneycomb", false, func() error {
+		return c.Interface.DeleteHoneycomb(i)
+	})
+}
+
+// CreateHeroku implements api.Interface.
+func (c *Client) CreateHeroku(i *fastly.CreateHerokuInput) (*fastly.Heroku, error) {
+	return call(c, "CreateHeroku", false, func() (*fastly.Heroku, error) {
+		return c.Interface.CreateHeroku(i)
+	})
+}
+
+// ListHerokus implements api.Interface.
+func (c *Client) ListHerokus(i *fastly.ListHerokusInput) ([]*fastly.Heroku, error) {
+	return call(c, "ListHerokus", true, func() ([]*fastly.Heroku, error) {
+		return c.Interface.ListHerokus(i)
+	})
+}
+
+// GetHeroku implements api.Interface.
+func (c *Client) GetHeroku(i *fastly.GetHerokuInput) (*fastly.Heroku, error) {
+	return call(c, "GetHeroku", true, func() (*fastly.Heroku, error) {
+		return c.Interface.GetHeroku(i)
+	})
+}
+
+// UpdateHeroku implements api.Interface.
+func (c *Client) UpdateHeroku(i *fastly.UpdateHerokuInput) (*fastly.Heroku, error) {
+	return call(c, "UpdateHeroku", false, func() (*fastly.Heroku, error) {
+		return c.Interface.UpdateHeroku(i)
+	})
+}
+
+// DeleteHeroku implements api.Interface.
+func (c *Client) DeleteHeroku(i *fastly.DeleteHerokuInput) error {
+	return c.do("DeleteHeroku", false, func() error {
+		return c.Interface.DeleteHeroku(i)
+	})
+}
+
+// CreateSFTP implements api.Interface.
+func (c *Client) CreateSFTP(i *fastly.CreateSFTPInput) (*fastly.SFTP, error) {
+	return call(c, "CreateSFTP", false, func() (*fastly.SFTP, error) {
+		return c.Interface.CreateSFTP(i)
+	})
+}
+
+// ListSFTPs implements api.Interface.
+func (c *Client) ListSFTPs(i *fastly.ListSFTPsInput) ([]*fastly.SFTP, error) {
+	return call(c, "ListSFTPs", true, func() ([]*fastly.SFTP, error) {
+		return c.Interface.ListSFTPs(i)
+	})
+}
+
+// GetSFTP implements api.Interface.
+func (c *Client) GetSFTP(i *fastly.GetSFTPInput) (*fastly.SFTP, error) {
+	return call(c, "GetSFTP", true, func() (*fastly.SFTP, error) {
+		return c.Interface.GetSFTP(i)
+	})
+}
+
+// UpdateSFTP implements api.Interface.
+func (c *Client) UpdateSFTP(i *fastly.UpdateSFTPInput) (*fastly.SFTP, error) {
+	return call(c, "UpdateSFTP", false, func() (*fastly.SFTP, error) {
+		return c.Interface.UpdateSFTP(i)
+	})
+}
+
+// DeleteSFTP implements api.Interface.
+func (c *Client) DeleteSFTP(i *fastly.DeleteSFTPInput) error {
+	return c.do("DeleteSFTP", false, func() error {
+		return c.Interface.DeleteSFTP(i)
+	})
+}
+
+// CreateLogshuttle implements api.Interface.
+func (c *Client) CreateLogshuttle(i *fastly.CreateLogshuttleInput) (*fastly.Logshuttle, error) {
+	return call(c, "CreateLogshuttle", false, func() (*fastly.Logshuttle, error) {
+		return c.Interface.CreateLogshuttle(i)
+	})
+}
+
+// ListLogshuttles implements api.Interface.
+func (c *Client) ListLogshuttles(i *fastly.ListLogshuttlesInput) ([]*fastly.Logshuttle, error) {
+	return call(c, "ListLogshuttles", true, func() ([]*fastly.Logshuttle, error) {
+		return c.Interface.ListLogshuttles(i)
+	})
+}
+
+// GetLogshuttle implements api.Interface.
+func (c *Client) GetLogshuttle(i *fastly.GetLogshuttleInput) (*fastly.Logshuttle, error) {
+	return call(c, "GetLogshuttle", true, func() (*fastly.Logshuttle, error) {
+		return c.Interface.GetLogshuttle(i)
+	})
+}
+
+// UpdateLogshuttle implements api.Interface.
+func (c *Client) UpdateLogshuttle(i *fastly.UpdateLogshuttleInput) (*fastly.Logshuttle, error) {
+	return call(c, "UpdateLogshuttle", false, func() (*fastly.Logshuttle, error) {
+		return c.Interface.UpdateLogshuttle(i)
+	})
+}
+
+// DeleteLogshuttle implements api.Interface.
+func (c *Client) DeleteLogshuttle(i *fastly.DeleteLogshuttleInput) error {
+	return c.do("DeleteLogshuttle", false, func() error {
+		return c.Interface.DeleteLogshuttle(i)
+	})
+}
+
+// CreateCloudfiles implements api.Interface.
+func (c *Client) CreateCloudfiles(i *fastly.CreateCloudfilesInput) (*fastly.Cloudfiles, error) {
+	return call(c, "CreateCloudfiles", false, func() (*fastly.Cloudfiles, error) {
+		return c.Interface.CreateCloudfiles(i)
+	})
+}
+
+// ListCloudfiles implements api.Interface.
+func (c *Client) ListCloudfiles(i *fastly.ListCloudfilesInput) ([]*fastly.Cloudfiles, error) {
+	return call(c, "ListCloudfiles", true, func() ([]*fastly.Cloudfiles, error) {
+		return c.Interface.ListCloudfiles(i)
+	})
+}
+
+// GetCloudfiles implements api.Interface.
+func (c *Client) GetCloudfiles(i *fastly.GetCloudfilesInput) (*fastly.Cloudfiles, error) {
+	return call(c, "GetCloudfiles", true, func() (*fastly.Cloudfiles, error) {
+		return c.Interface.GetCloudfiles(i)
+	})
+}
+
+// UpdateCloudfiles implements api.Interface.
+func (c *Client) UpdateCloudfiles(i *fastly.UpdateCloudfilesInput) (*fastly.Cloudfiles, error) {
+	return call(c, "UpdateCloudfiles", false, func() (*fastly.Cloudfiles, error) {
+		return c.Interface.UpdateCloudfiles(i)
+	})
+}
+
+// DeleteCloudfiles implements api.Interface.
+func (c *Client) DeleteCloudfiles(i *fastly.DeleteCloudfilesInput) error {
+	return c.do("DeleteCloudfiles", false, func() error {
+		return c.Interface.DeleteCloudfiles(i)
+	})
+}
+
+// CreateDigitalOcean implements api.Interface.
+func (c *Client) CreateDigitalOcean(i *fastly.CreateDigitalOceanInput) (*fastly.DigitalOcean, error) {
+	return call(c, "CreateDigitalOcean", false, func() (*fastly.DigitalOcean, error) {
+		return c.Interface.CreateDigitalOcean(i)
+	})
+}
+
+// ListDigitalOceans implements api.Interface.
+func (c *Client) ListDigitalOceans(i *fastly.ListDigitalOceansInput) ([]*fastly.DigitalOcean, error) {
+	return call(c, "ListDigitalOceans", true, func() ([]*fastly.DigitalOcean, error) {
+		return c.Interface.ListDigitalOceans(i)
+	})
+}
+
+// GetDigitalOcean implements api.Interface.
+func (c *Client) GetDigitalOcean(i *fastly.GetDigitalOceanInput) (*fastly.DigitalOcean, error) {
+	return call(c, "GetDigitalOcean", true, func() (*fastly.DigitalOcean, error) {
+		return c.Interface.GetDigitalOcean(i)
+	})
+}
+
+// UpdateDigitalOcean implements api.Interface.
+func (c *Client) UpdateDigitalOcean(i *fastly.UpdateDigitalOceanInput) (*fastly.DigitalOcean, error) {
+	return call(c, "UpdateDigitalOcean", false, func() (*fastly.DigitalOcean, error) {
+		return c.Interface.UpdateDigitalOcean(i)
+	})
+}
+
+// DeleteDigitalOcean implements api.Interface.
+func (c *Client) DeleteDigitalOcean(i *fastly.DeleteDigitalOceanInput) error {
+	return c.do("DeleteDigitalOcean", false, func() error {
+		return c.Interface.DeleteDigitalOcean(i)
+	})
+}
+
+// CreateElasticsearch implements api.Interface.
+func (c *Client) CreateElasticsearch(i *fastly.CreateElasticsearchInput) (*fastly.Elasticsearch, error) {
+	return call(c, "CreateElasticsearch", false, func() (*fastly.Elasticsearch, error) {
+		return c.Interface.CreateElasticsearch(i)
+	})
+}
+
+// ListElasticsearch implements api.Interface.
+func (c *Client) ListElasticsearch(i *fastly.ListElasticsearchInput) ([]*fastly.Elasticsearch, error) {
+	return call(c, "ListElasticsearch", true, func() ([]*fastly.Elasticsearch, error) {
+		return c.Interface.ListElasticsearch(i)
+	})
+}
+
+// GetElasticsearch implements api.Interface.
+func (c *Client) GetElasticsearch(i *fastly.GetElasticsearchInput) (*fastly.Elasticsearch, error) {
+	return call(c, "GetElasticsearch", true, func() (*fastly.Elasticsearch, error) {
+		return c.Interface.GetElasticsearch(i)
+	})
+}
+
+// UpdateElasticsearch implements api.Interface.
+func (c *Client) UpdateElasticsearch(i *fastly.UpdateElasticsearchInput) (*fastly.Elasticsearch, error) {
+	return call(c, "UpdateElasticsearch", false, func() (*fastly.Elasticsearch, error) {
+		return c.Interface.UpdateElasticsearch(i)
+	})
+}
+
+// DeleteElasticsearch implements api.Interface.
+func (c *Client) DeleteElasticsearch(i *fastly.DeleteElasticsearchInput) error {
+	return c.do("DeleteElasticsearch", false, func() error {
+		return c.Interface.DeleteElasticsearch(i)
+	})
+}
+
+// CreateBlobStorage implements api.Interface.
+func (c *Client) CreateBlobStorage(i *fastly.CreateBlobStorageInput) (*fastly.BlobStorage, error) {
+	return call(c, "CreateBlobStorage", false, func() (*fastly.BlobStorage, error) {
+		return c.Interface.CreateBlobStorage(i)
+	})
+}
+
+// ListBlobStorages implements api.Interface.
+func (c *Client) ListBlobStorages(i *fastly.ListBlobStoragesInput) ([]*fastly.BlobStorage, error) {
+	return call(c, "ListBlobStorages", true, func() ([]*fastly.BlobStorage, error) {
+		return c.Interface.ListBlobStorages(i)
+	})
+}
+
+// GetBlobStorage implements api.Interface.
+func (c *Client) GetBlobStorage(i *fastly.GetBlobStorageInput) (*fastly.BlobStorage, error) {
+	return call(c, "GetBlobStorage", true, func() (*fastly.BlobStorage, error) {
+		return c.Interface.GetBlobStorage(i)
+	})
+}
+
+// UpdateBlobStorage implements api.Interface.
+func (c *Client) UpdateBlobStorage(i *fastly.UpdateBlobStorageInput) (*fastly.BlobStorage, error) {
+	return call(c, "UpdateBlobStorage", false, func() (*fastly.BlobStorage, error) {
+		return c.Interface.UpdateBlobStorage(i)
+	})
+}
+
+// DeleteBlobStorage implements api.Interface.
+func (c *Client) DeleteBlobStorage(i *fastly.DeleteBlobStorageInput) error {
+	return c.do("DeleteBlobStorage", false, func() error {
+		return c.Interface.DeleteBlobStorage(i)
+	})
+}
+
+// CreateDatadog implements api.Interface.
+func (c *Client) CreateDatadog(i *fastly.CreateDatadogInput) (*fastly.Datadog, error) {
+	return call(c, "CreateDatadog", false, func() (*fastly.Datadog, error) {
+		return c.Interface.CreateDatadog(i)
+	})
+}
+
+// ListDatadog implements api.Interface.
+func (c *Client) ListDatadog(i *fastly.ListDatadogInput) ([]*fastly.Datadog, error) {
+	return call(c, "ListDatadog", true, func() ([]*fastly.Datadog, error) {
+		return c.Interface.ListDatadog(i)
+	})
+}
+
+// GetDatadog implements api.Interface.
+func (c *Client) GetDatadog(i *fastly.GetDatadogInput) (*fastly.Datadog, error) {
+	return call(c, "GetDatadog", true, func() (*fastly.Datadog, error) {
+		return c.Interface.GetDatadog(i)
+	})
+}
+
+// UpdateDatadog implements api.Interface.
+func (c *Client) UpdateDatadog(i *fastly.UpdateDatadogInput) (*fastly.Datadog, error) {
+	return call(c, "UpdateDatadog", false, func() (*fastly.Datadog, error) {
+		return c.Interface.UpdateDatadog(i)
+	})
+}
+
+// DeleteDatadog implements api.Interface.
+func (c *Client) DeleteDatadog(i *fastly.DeleteDatadogInput) error {
+	return c.do("DeleteDatadog", false, func() error {
+		return c.Interface.DeleteDatadog(i)
+	})
+}
+
+// CreateHTTPS implements api.Interface.
+func (c *Client) CreateHTTPS(i *fastly.CreateHTTPSInput) (*fastly.HTTPS, error) {
+	return call(c, "CreateHTTPS", false, func() (*fastly.HTTPS, error) {
+		return c.Interface.CreateHTTPS(i)
+	})
+}
+
+// ListHTTPS implements api.Interface.
+func (c *Client) ListHTTPS(i *fastly.ListHTTPSInput) ([]*fastly.HTTPS, error) {
+	return call(c, "ListHTTPS", true, func() ([]*fastly.HTTPS, error) {
+		return c.Interface.ListHTTPS(i)
+	})
+}
+
+// GetHTTPS implements api.Interface.
+func (c *Client) GetHTTPS(i *fastly.GetHTTPSInput) (*fastly.HTTPS, error) {
+	return call(c, "GetHTTPS", true, func() (*fastly.HTTPS, error) {
+		return c.Interface.GetHTTPS(i)
+	})
+}
+
+// UpdateHTTPS implements api.Interface.
+func (c *Client) UpdateHTTPS(i *fastly.UpdateHTTPSInput) (*fastly.HTTPS, error) {
+	return call(c, "UpdateHTTPS", false, func() (*fastly.HTTPS, error) {
+		return c.Interface.UpdateHTTPS(i)
+	})
+}
+
+// DeleteHTTPS implements api.Interface.
+func (c *Client) DeleteHTTPS(i *fastly.DeleteHTTPSInput) error {
+	return c.do("DeleteHTTPS", false, func() error {
+		return c.Interface.DeleteHTTPS(i)
+	})
+}
+
+// CreateKafka implements api.Interface.
+func (c *Client) CreateKafka(i *fastly.CreateKafkaInput) (*fastly.Kafka, error) {
+	return call(c, "CreateKafka", false, func() (*fastly.Kafka, error) {
+		return c.Interface.CreateKafka(i)
+	})
+}
+
+// ListKafkas implements api.Interface.
+func (c *Client) ListKafkas(i *fastly.ListKafkasInput) ([]*fastly.Kafka, error) {
+	return call(c, "ListKafkas", true, func() ([]*fastly.Kafka, error) {
+		return c.Interface.ListKafkas(i)
+	})
+}
+
+// GetKafka implements api.Interface.
+func (c *Client) GetKafka(i *fastly.GetKafkaInput) (*fastly.Kafka, error) {
+	return call(c, "GetKafka", true, func() (*fastly.Kafka, error) {
+		return c.Interface.GetKafka(i)
+	})
+}
+
+// UpdateKafka implements api.Interface.
+func (c *Client) UpdateKafka(i *fastly.UpdateKafkaInput) (*fastly.Kafka, error) {
+	return call(c, "UpdateKafka", false, func() (*fastly.Kafka, error) {
+		return c.Interface.UpdateKafka(i)
+	})
+}
+
+// DeleteKafka implements api.Interface.
+func (c *Client) DeleteKafka(i *fastly.DeleteKafkaInput) error {
+	return c.do("DeleteKafka", false, func() error {
+		return c.Interface.DeleteKafka(i)
+	})
+}
+
+// CreatePubsub implements api.Interface.
+func (c *Client) CreatePubsub(i *fastly.CreatePubsubInput) (*fastly.Pubsub, error) {
+	return call(c, "CreatePubsub", false, func() (*fastly.Pubsub, error) {
+		return c.Interface.CreatePubsub(i)
+	})
+}
+
+// ListPubsubs implements api.Interface.
+func (c *Client) ListPubsubs(i *fastly.ListPubsubsInput) ([]*fastly.Pubsub, error) {
+	return call(c, "ListPubsubs", true, func() ([]*fastly.Pubsub, error) {
+		return c.Interface.ListPubsubs(i)
+	})
+}
+
+// GetPubsub implements api.Interface.
+func (c *Client) GetPubsub(i *fastly.GetPubsubInput) (*fastly.Pubsub, error) {
+	return call(c, "GetPubsub", true, func() (*fastly.Pubsub, error) {
+		return c.Interface.GetPubsub(i)
+	})
+}
+
+// UpdatePubsub implements api.Interface.
+func (c *Client) UpdatePubsub(i *fastly.UpdatePubsubInput) (*fastly.Pubsub, error) {
+	return call(c, "UpdatePubsub", false, func() (*fastly.Pubsub, error) {
+		return c.Interface.UpdatePubsub(i)
+	})
+}
+
+// DeletePubsub implements api.Interface.
+func (c *Client) DeletePubsub(i *fastly.DeletePubsubInput) error {
+	return c.do("DeletePubsub", false, func() error {
+		return c.Interface.DeletePubsub(i)
+	})
+}
+
+// CreateOpenstack implements api.Interface.
+func (c *Client) CreateOpenstack(i *fastly.CreateOpenstackInput) (*fastly.Openstack, error) {
+	return call(c, "CreateOpenstack", false, func() (*fastly.Openstack, error) {
+		return c.Interface.CreateOpenstack(i)
+	})
+}
+
+// ListOpenstack implements api.Interface.
+func (c *Client) ListOpenstack(i *fastly.ListOpenstackInput) ([]*fastly.Openstack, error) {
+	return call(c, "ListOpenstack", true, func() ([]*fastly.Openstack, error) {
+		return c.Interface.ListOpenstack(i)
+	})
+}
+
+// GetOpenstack implements api.Interface.
+func (c *Client) GetOpenstack(i *fastly.GetOpenstackInput) (*fastly.Openstack, error) {
+	return call(c, "GetOpenstack", true, func() (*fastly.Openstack, error) {
+		return c.Interface.GetOpenstack(i)
+	})
+}
+
+// UpdateOpenstack implements api.Interface.
+func (c *Client) UpdateOpenstack(i *fastly.UpdateOpenstackInput) (*fastly.Openstack, error) {
+	return call(c, "UpdateOpenstack", false, func() (*fastly.Openstack, error) {
+		return c.Interface.UpdateOpenstack(i)
+	})
+}
+
+// DeleteOpenstack implements api.Interface.
+func (c *Client) DeleteOpenstack(i *fastly.DeleteOpenstackInput) error {
+	return c.do("DeleteOpenstack", false, func() error {
+		return c.Interface.DeleteOpenstack(i)
+	})
+}
+
+// GetRegions implements api.Interface.
+func (c *Client) GetRegions() (*fastly.RegionsResponse, error) {
+	return call(c, "GetRegions", true, func() (*fastly.RegionsResponse, error) {
+		return c.Interface.GetRegions()
+	})
+}
+
+// GetStatsJSON implements api.Interface.
+func (c *Client) GetStatsJSON(i *fastly.GetStatsInput, dst any) error {
+	return c.do("GetStatsJSON", true, func() error {
+		return c.Interface.GetStatsJSON(i, dst)
+	})
+}
+
+// CreateManagedLogging implements api.Interface.
+func (c *Client) CreateManagedLogging(i *fastly.CreateManagedLoggingInput) (*fastly.ManagedLogging, error) {
+	return call(c, "CreateManagedLogging", false, func() (*fastly.ManagedLogging, error) {
+		return c.Interface.CreateManagedLogging(i)
+	})
+}
+
+// CreateVCL implements api.Interface.
+func (c *Client) CreateVCL(i *fastly.CreateVCLInput) (*fastly.VCL, error) {
+	return call(c, "CreateVCL", false, func() (*fastly.VCL, error) {
+		return c.Interface.CreateVCL(i)
+	})
+}
+
+// ListVCLs implements api.Interface.
+func (c *Client) ListVCLs(i *fastly.ListVCLsInput) ([]*fastly.VCL, error) {
+	return call(c, "ListVCLs", true, func() ([]*fastly.VCL, error) {
+		return c.Interface.ListVCLs(i)
+	})
+}
+
+// GetVCL implements api.Interface.
+func (c *Client) GetVCL(i *fastly.GetVCLInput) (*fastly.VCL, error) {
+	return call(c, "GetVCL", true, func() (*fastly.VCL, error) {
+		return c.Interface.GetVCL(i)
+	})
+}
+
+// UpdateVCL implements api.Interface.
+func (c *Client) UpdateVCL(i *fastly.UpdateVCLInput) (*fastly.VCL, error) {
+	return call(c, "UpdateVCL", false, func() (*fastly.VCL, error) {
+		return c.Interface.UpdateVCL(i)
+	})
+}
+
+// DeleteVCL implements api.Interface.
+func (c *Client) DeleteVCL(i *fastly.DeleteVCLInput) error {
+	return c.do("DeleteVCL", false, func() error {
+		return c.Interface.DeleteVCL(i)
+	})
+}
+
+// CreateSnippet implements api.Interface.
+func (c *Client) CreateSnippet(i *fastly.CreateSnippetInput) (*fastly.Snippet, error) {
+	return call(c, "CreateSnippet", false, func() (*fastly.Snippet, error) {
+		return c.Interface.CreateSnippet(i)
+	})
+}
+
+// ListSnippets implements api.Interface.
+func (c *Client) ListSnippets(i *fastly.ListSnippetsInput) ([]*fastly.Snippet, error) {
+	return call(c, "ListSnippets", true, func() ([]*fastly.Snippet, error) {
+		return c.Interface.ListSnippets(i)
+	})
+}
+
+// GetSnippet implements api.Interface.
+func (c *Client) GetSnippet(i *fastly.GetSnippetInput) (*fastly.Snippet, error) {
+	return call(c, "GetSnippet", true, func() (*fastly.Snippet, error) {
+		return c.Interface.GetSnippet(i)
+	})
+}
+
+// GetDynamicSnippet implements api.Interface.
+func (c *Client) GetDynamicSnippet(i *fastly.GetDynamicSnippetInput) (*fastly.DynamicSnippet, error) {
+	return call(c, "GetDynamicSnippet", true, func() (*fastly.DynamicSnippet, error) {
+		return c.Interface.GetDynamicSnippet(i)
+	})
+}
+
+// UpdateSnippet implements api.Interface.
+func (c *Client) UpdateSnippet(i *fastly.UpdateSnippetInput) (*fastly.Snippet, error) {
+	return call(c, "UpdateSnippet", false, func() (*fastly.Snippet, error) {
+		return c.Interface.UpdateSnippet(i)
+	})
+}
+
+// UpdateDynamicSnippet implements api.Interface.
+func (c *Client) UpdateDynamicSnippet(i *fastly.UpdateDynamicSnippetInput) (*fastly.DynamicSnippet, error) {
+	return call(c, "UpdateDynamicSnippet", false, func() (*fastly.DynamicSnippet, error) {
+		return c.Interface.UpdateDynamicSnippet(i)
+	})
+}
+
+// DeleteSnippet implements api.Interface.
+func (c *Client) DeleteSnippet(i *fastly.DeleteSnippetInput) error {
+	return c.do("DeleteSnippet", false, func() error {
+		return c.Interface.DeleteSnippet(i)
+	})
+}
+
+// Purge implements api.Interface.
+func (c *Client) Purge(i *fastly.PurgeInput) (*fastly.Purge, error) {
+	return call(c, "Purge", false, func() (*fastly.Purge, error) {
+		return c.Interface.Purge(i)
+	})
+}
+
+// PurgeKey implements api.Interface.
+func (c *Client) PurgeKey(i *fastly.PurgeKeyInput) (*fastly.Purge, error) {
+	return call(c, "PurgeKey", false, func() (*fastly.Purge, error) {
+		return c.Interface.PurgeKey(i)
+	})
+}
+
+// PurgeKeys implements api.Interface.
+func (c *Client) PurgeKeys(i *fastly.PurgeKeysInput) (map[string]string, error) {
+	return call(c, "PurgeKeys", false, func() (map[string]string, error) {
+		return c.Interface.PurgeKeys(i)
+	})
+}
+
+// PurgeAll implements api.Interface.
+func (c *Client) PurgeAll(i *fastly.PurgeAllInput) (*fastly.Purge, error) {
+	return call(c, "PurgeAll", false, func() (*fastly.Purge, error) {
+		return c.Interface.PurgeAll(i)
+	})
+}
+
+// CreateACL implements api.Interface.
+func (c *Client) CreateACL(i *fastly.CreateACLInput) (*fastly.ACL, error) {
+	return call(c, "CreateACL", false, func() (*fastly.ACL, error) {
+		return c.Interface.CreateACL(i)
+	})
+}
+
+// DeleteACL implements api.Interface.
+func (c *Client) DeleteACL(i *fastly.DeleteACLInput) error {
+	return c.do("DeleteACL", false, func() error {
+		return c.Interface.DeleteACL(i)
+	})
+}
+
+// GetACL implements api.Interface.
+func (c *Client) GetACL(i *fastly.GetACLInput) (*fastly.ACL, error) {
+	return call(c, "GetACL", true, func() (*fastly.ACL, error) {
+		return c.Interface.GetACL(i)
+	})
+}
+
+// ListACLs implements api.Interface.
+func (c *Client) ListACLs(i *fastly.ListACLsInput) ([]*fastly.ACL, error) {
+	return call(c, "ListACLs", true, func() ([]*fastly.ACL, error) {
+		return c.Interface.ListACLs(i)
+	})
+}
+
+// UpdateACL implements api.Interface.
+func (c *Client) UpdateACL(i *fastly.UpdateACLInput) (*fastly.ACL, error) {
+	return call(c, "UpdateACL", false, func() (*fastly.ACL, error) {
+		return c.Interface.UpdateACL(i)
+	})
+}
+
+// CreateACLEntry implements api.Interface.
+func (c *Client) CreateACLEntry(i *fastly.CreateACLEntryInput) (*fastly.ACLEntry, error) {
+	return call(c, "CreateACLEntry", false, func() (*fastly.ACLEntry, error) {
+		return c.Interface.CreateACLEntry(i)
+	})
+}
+
+// DeleteACLEntry implements api.Interface.
+func (c *Client) DeleteACLEntry(i *fastly.DeleteACLEntryInput) error {
+	return c.do("DeleteACLEntry", false, func() error {
+		return c.Interface.DeleteACLEntry(i)
+	})
+}
+
+// GetACLEntry implements api.Interface.
+func (c *Client) GetACLEntry(i *fastly.GetACLEntryInput) (*fastly.ACLEntry, error) {
+	return call(c, "GetACLEntry", true, func() (*fastly.ACLEntry, error) {
+		return c.Interface.GetACLEntry(i)
+	})
+}
+
+// ListACLEntries implements api.Interface.
+func (c *Client) ListACLEntries(i *fastly.ListACLEntriesInput) ([]*fastly.ACLEntry, error) {
+	return call(c, "ListACLEntries", true, func() ([]*fastly.ACLEntry, error) {
+		return c.Interface.ListACLEntries(i)
+	})
+}
+
+// UpdateACLEntry implements api.Interface.
+func (c *Client) UpdateACLEntry(i *fastly.UpdateACLEntryInput) (*fastly.ACLEntry, error) {
+	return call(c, "UpdateACLEntry", false, func() (*fastly.ACLEntry, error) {
+		return c.Interface.UpdateACLEntry(i)
+	})
+}
+
+// BatchModifyACLEntries implements api.Interface.
+func (c *Client) BatchModifyACLEntries(i *fastly.BatchModifyACLEntriesInput) error {
+	return c.do("BatchModifyACLEntries", false, func() error {
+		return c.Interface.BatchModifyACLEntries(i)
+	})
+}
+
+// CreateNewRelic implements api.Interface.
+func (c *Client) CreateNewRelic(i *fastly.CreateNewRelicInput) (*fastly.NewRelic, error) {
+	return call(c, "CreateNewRelic", false, func() (*fastly.NewRelic, error) {
+		return c.Interface.CreateNewRelic(i)
+	})
+}
+
+// DeleteNewRelic implements api.Interface.
+func (c *Client) DeleteNewRelic(i *fastly.DeleteNewRelicInput) error {
+	return c.do("DeleteNewRelic", false, func() error {
+		return c.Interface.DeleteNewRelic(i)
+	})
+}
+
+// GetNewRelic implements api.Interface.
+func (c *Client) GetNewRelic(i *fastly.GetNewRelicInput) (*fastly.NewRelic, error) {
+	return call(c, "GetNewRelic", true, func() (*fastly.NewRelic, error) {
+		return c.Interface.GetNewRelic(i)
+	})
+}
+
+// ListNewRelic implements api.Interface.
+func (c *Client) ListNewRelic(i *fastly.ListNewRelicInput) ([]*fastly.NewRelic, error) {
+	return call(c, "ListNewRelic", true, func() ([]*fastly.NewRelic, error) {
+		return c.Interface.ListNewRelic(i)
+	})
+}
+
+// UpdateNewRelic implements api.Interface.
+func (c *Client) UpdateNewRelic(i *fastly.UpdateNewRelicInput) (*fastly.NewRelic, error) {
+	return call(c, "UpdateNewRelic", false, func() (*fastly.NewRelic, error) {
+		return c.Interface.UpdateNewRelic(i)
+	})
+}
+
+// CreateNewRelicOTLP implements api.Interface.
+func (c *Client) CreateNewRelicOTLP(i *fastly.CreateNewRelicOTLPInput) (*fastly.NewRelicOTLP, error) {
+	return call(c, "CreateNewRelicOTLP", false, func() (*fastly.NewRelicOTLP, error) {
+		return c.Interface.CreateNewRelicOTLP(i)
+	})
+}
+
+// DeleteNewRelicOTLP implements api.Interface.
+func (c *Client) DeleteNewRelicOTLP(i *fastly.DeleteNewRelicOTLPInput) error {
+	return c.do("DeleteNewRelicOTLP", false, func() error {
+		return c.Interface.DeleteNewRelicOTLP(i)
+	})
+}
+
+// GetNewRelicOTLP implements api.Interface.
+func (c *Client) GetNewRelicOTLP(i *fastly.GetNewRelicOTLPInput) (*fastly.NewRelicOTLP, error) {
+	return call(c, "GetNewRelicOTLP", true, func() (*fastly.NewRelicOTLP, error) {
+		return c.Interface.GetNewRelicOTLP(i)
+	})
+}
+
+// ListNewRelicOTLP implements api.Interface.
+func (c *Client) ListNewRelicOTLP(i *fastly.ListNewRelicOTLPInput) ([]*fastly.NewRelicOTLP, error) {
+	return call(c, "ListNewRelicOTLP", true, func() ([]*fastly.NewRelicOTLP, error) {
+		return c.Interface.ListNewRelicOTLP(i)
+	})
+}
+
+// UpdateNewRelicOTLP implements api.Interface.
+func (c *Client) UpdateNewRelicOTLP(i *fastly.UpdateNewRelicOTLPInput) (*fastly.NewRelicOTLP, error) {
+	return call(c, "UpdateNewRelicOTLP", false, func() (*fastly.NewRelicOTLP, error) {
+		return c.Interface.UpdateNewRelicOTLP(i)
+	})
+}
+
+// CreateUser implements api.Interface.
+func (c *Client) CreateUser(i *fastly.CreateUserInput) (*fastly.User, error) {
+	return call(c, "CreateUser", false, func() (*fastly.User, error) {
+		return c.Interface.CreateUser(i)
+	})
+}
+
+// DeleteUser implements api.Interface.
+func (c *Client) DeleteUser(i *fastly.DeleteUserInput) error {
+	return c.do("DeleteUser", false, func() error {
+		return c.Interface.DeleteUser(i)
+	})
+}
+
+// GetCurrentUser implements api.Interface.
+func (c *Client) GetCurrentUser() (*fastly.User, error) {
+	return call(c, "GetCurrentUser", true, func() (*fastly.User, error) {
+		return c.Interface.GetCurrentUser()
+	})
+}
+
+// GetUser implements api.Interface.
+func (c *Client) GetUser(i *fastly.GetUserInput) (*fastly.User, error) {
+	return call(c, "GetUser", true, func() (*fastly.User, error) {
+		return c.Interface.GetUser(i)
+	})
+}
+
+// ListCustomerUsers implements api.Interface.
+func (c *Client) ListCustomerUsers(i *fastly.ListCustomerUsersInput) ([]*fastly.User, error) {
+	return call(c, "ListCustomerUsers", true, func() ([]*fastly.User, error) {
+		return c.Interface.ListCustomerUsers(i)
+	})
+}
+
+// UpdateUser implements api.Interface.
+func (c *Client) UpdateUser(i *fastly.UpdateUserInput) (*fastly.User, error) {
+	return call(c, "UpdateUser", false, func() (*fastly.User, error) {
+		return c.Interface.UpdateUser(i)
+	})
+}
+
+// ResetUserPassword implements api.Interface.
+func (c *Client) ResetUserPassword(i *fastly.ResetUserPasswordInput) error {
+	return c.do("ResetUserPassword", false, func() error {
+		return c.Interface.ResetUserPassword(i)
+	})
+}
+
+// BatchDeleteTokens implements api.Interface.
+func (c *Client) BatchDeleteTokens(i *fastly.BatchDeleteTokensInput) error {
+	return c.do("BatchDeleteTokens", false, func() error {
+		return c.Interface.BatchDeleteTokens(i)
+	})
+}
+
+// CreateToken implements api.Interface.
+func (c *Client) CreateToken(i *fastly.CreateTokenInput) (*fastly.Token, error) {
+	return call(c, "CreateToken", false, func() (*fastly.Token, error) {
+		return c.Interface.CreateToken(i)
+	})
+}
+
+// DeleteToken implements api.Interface.
+func (c *Client) DeleteToken(i *fastly.DeleteTokenInput) error {
+	return c.do("DeleteToken", false, func() error {
+		return c.Interface.DeleteToken(i)
+	})
+}
+
+// DeleteTokenSelf implements api.Interface.
+func (c *Client) DeleteTokenSelf() error {
+	return c.do("DeleteTokenSelf", false, func() error {
+		return c.Interface.DeleteTokenSelf()
+	})
+}
+
+// GetTokenSelf implements api.Interface.
+func (c *Client) GetTokenSelf() (*fastly.Token, error) {
+	return call(c, "GetTokenSelf", true, func() (*fastly.Token, error) {
+		return c.Interface.GetTokenSelf()
+	})
+}
+
+// ListCustomerTokens implements api.Interface.
+func (c *Client) ListCustomerTokens(i *fastly.ListCustomerTokensInput) ([]*fastly.Token, error) {
+	return call(c, "ListCustomerTokens", true, func() ([]*fastly.Token, error) {
+		return c.Interface.ListCustomerTokens(i)
+	})
+}
+
+// ListTokens implements api.Interface.
+func (c *Client) ListTokens() ([]*fastly.Token, error) {
+	return call(c, "ListTokens", true, func() ([]*fastly.Token, error) {
+		return c.Interface.ListTokens()
+	})
+}
+
+// GetCustomTLSConfiguration implements api.Interface.
+func (c *Client) GetCustomTLSConfiguration(i *fastly.GetCustomTLSConfigurationInput) (*fastly.CustomTLSConfiguration, error) {
+	return call(c, "GetCustomTLSConfiguration", true, func() (*fastly.CustomTLSConfiguration, error) {
+		return c.Interface.GetCustomTLSConfiguration(i)
+	})
+}
+
+// ListCustomTLSConfigurations implements api.Interface.
+func (c *Client) ListCustomTLSConfigurations(i *fastly.ListCustomTLSConfigurationsInput) ([]*fastly.CustomTLSConfiguration, error) {
+	return call(c, "ListCustomTLSConfigurations", true, func() ([]*fastly.CustomTLSConfiguration, error) {
+		return c.Interface.ListCustomTLSConfigurations(i)
+	})
+}
+
+// UpdateCustomTLSConfiguration implements api.Interface.
+func (c *Client) UpdateCustomTLSConfiguration(i *fastly.UpdateCustomTLSConfigurationInput) (*fastly.CustomTLSConfiguration, error) {
+	return call(c, "UpdateCustomTLSConfiguration", false, func() (*fastly.CustomTLSConfiguration, error) {
+		return c.Interface.UpdateCustomTLSConfiguration(i)
+	})
+}
+
+// GetTLSActivation implements api.Interface.
+func (c *Client) GetTLSActivation(i *fastly.GetTLSActivationInput) (*fastly.TLSActivation, error) {
+	return call(c, "GetTLSActivation", true, func() (*fastly.TLSActivation, error) {
+		return c.Interface.GetTLSActivation(i)
+	})
+}
+
+// ListTLSActivations implements api.Interface.
+func (c *Client) ListTLSActivations(i *fastly.ListTLSActivationsInput) ([]*fastly.TLSActivation, error) {
+	return call(c, "ListTLSActivations", true, func() ([]*fastly.TLSActivation, error) {
+		return c.Interface.ListTLSActivations(i)
+	})
+}
+
+// UpdateTLSActivation implements api.Interface.
+func (c *Client) UpdateTLSActivation(i *fastly.UpdateTLSActivationInput) (*fastly.TLSActivation, error) {
+	return call(c, "UpdateTLSActivation", false, func() (*fastly.TLSActivation, error) {
+		return c.Interface.UpdateTLSActivation(i)
+	})
+}
+
+// CreateTLSActivation implements api.Interface.
+func (c *Client) CreateTLSActivation(i *fastly.CreateTLSActivationInput) (*fastly.TLSActivation, error) {
+	return call(c, "CreateTLSActivation", false, func() (*fastly.TLSActivation, error) {
+		return c.Interface.CreateTLSActivation(i)
+	})
+}
+
+// DeleteTLSActivation implements api.Interface.
+func (c *Client) DeleteTLSActivation(i *fastly.DeleteTLSActivationInput) error {
+	return c.do("DeleteTLSActivation", false, func() error {
+		return c.Interface.DeleteTLSActivation(i)
+	})
+}
+
+// CreateCustomTLSCertificate implements api.Interface.
+func (c *Client) CreateCustomTLSCertificate(i *fastly.CreateCustomTLSCertificateInput) (*fastly.CustomTLSCertificate, error) {
+	return call(c, "CreateCustomTLSCertificate", false, func() (*fastly.CustomTLSCertificate, error) {
+		return c.Interface.CreateCustomTLSCertificate(i)
+	})
+}
+
+// DeleteCustomTLSCertificate implements api.Interface.
+func (c *Client) DeleteCustomTLSCertificate(i *fastly.DeleteCustomTLSCertificateInput) error {
+	return c.do("DeleteCustomTLSCertificate", false, func() error {
+		return c.Interface.DeleteCustomTLSCertificate(i)
+	})
+}
+
+// GetCustomTLSCertificate implements api.Interface.
+func (c *Client) GetCustomTLSCertificate(i *fastly.GetCustomTLSCertificateInput) (*fastly.CustomTLSCertificate, error) {
+	return call(c, "GetCustomTLSCertificate", true, func() (*fastly.CustomTLSCertificate, error) {
+		return c.Interface.GetCustomTLSCertificate(i)
+	})
+}
+
+// ListCustomTLSCertificates implements api.Interface.
+func (c *Client) ListCustomTLSCertificates(i *fastly.ListCustomTLSCertificatesInput) ([]*fastly.CustomTLSCertificate, error) {
+	return call(c, "ListCustomTLSCertificates", true, func() ([]*fastly.CustomTLSCertificate, error) {
+		return c.Interface.ListCustomTLSCertificates(i)
+	})
+}
+
+// UpdateCustomTLSCertificate implements api.Interface.
+func (c *Client) UpdateCustomTLSCertificate(i *fastly.UpdateCustomTLSCertificateInput) (*fastly.CustomTLSCertificate, error) {
+	return call(c, "UpdateCustomTLSCertificate", false, func() (*fastly.CustomTLSCertificate, error) {
+		return c.Interface.UpdateCustomTLSCertificate(i)
+	})
+}
+
+// ListTLSDomains implements api.Interface.
+func (c *Client) ListTLSDomains(i *fastly.ListTLSDomainsInput) ([]*fastly.TLSDomain, error) {
+	return call(c, "ListTLSDomains", true, func() ([]*fastly.TLSDomain, error) {
+		return c.Interface.ListTLSDomains(i)
+	})
+}
+
+// CreatePrivateKey implements api.Interface.
+func (c *Client) CreatePrivateKey(i *fastly.CreatePrivateKeyInput) (*fastly.PrivateKey, error) {
+	return call(c, "CreatePrivateKey", false, func() (*fastly.PrivateKey, error) {
+		return c.Interface.CreatePrivateKey(i)
+	})
+}
+
+// DeletePrivateKey implements api.Interface.
+func (c *Client) DeletePrivateKey(i *fastly.DeletePrivateKeyInput) error {
+	return c.do("DeletePrivateKey", false, func() error {
+		return c.Interface.DeletePrivateKey(i)
+	})
+}
+
+// GetPrivateKey implements api.Interface.
+func (c *Client) GetPrivateKey(i *fastly.GetPrivateKeyInput) (*fastly.PrivateKey, error) {
+	return call(c, "GetPrivateKey", true, func() (*fastly.PrivateKey, error) {
+		return c.Interface.GetPrivateKey(i)
+	})
+}
+
+// ListPrivateKeys implements api.Interface.
+func (c *Client) ListPrivateKeys(i *fastly.ListPrivateKeysInput) ([]*fastly.PrivateKey, error) {
+	return call(c, "ListPrivateKeys", true, func() ([]*fastly.PrivateKey, error) {
+		return c.Interface.ListPrivateKeys(i)
+	})
+}
+
+// CreateBulkCertificate implements api.Interface.
+func (c *Client) CreateBulkCertificate(i *fastly.CreateBulkCertificateInput) (*fastly.BulkCertificate, error) {
+	return call(c, "CreateBulkCertificate", false, func() (*fastly.BulkCertificate, error) {
+		return c.Interface.CreateBulkCertificate(i)
+	})
+}
+
+// DeleteBulkCertificate implements api.Interface.
+func (c *Client) DeleteBulkCertificate(i *fastly.DeleteBulkCertificateInput) error {
+	return c.do("DeleteBulkCertificate", false, func() error {
+		return c.Interface.DeleteBulkCertificate(i)
+	})
+}
+
+// GetBulkCertificate implements api.Interface.
+func (c *Client) GetBulkCertificate(i *fastly.GetBulkCertificateInput) (*fastly.BulkCertificate, error) {
+	return call(c, "GetBulkCertificate", true, func() (*fastly.BulkCertificate, error) {
+		return c.Interface.GetBulkCertificate(i)
+	})
+}
+
+// ListBulkCertificates implements api.Interface.
+func (c *Client) ListBulkCertificates(i *fastly.ListBulkCertificatesInput) ([]*fastly.BulkCertificate, error) {
+	return call(c, "ListBulkCertificates", true, func() ([]*fastly.BulkCertificate, error) {
+		return c.Interface.ListBulkCertificates(i)
+	})
+}
+
+// UpdateBulkCertificate implements api.Interface.
+func (c *Client) UpdateBulkCertificate(i *fastly.UpdateBulkCertificateInput) (*fastly.BulkCertificate, error) {
+	return call(c, "UpdateBulkCertificate", false, func() (*fastly.BulkCertificate, error) {
+		return c.Interface.UpdateBulkCertificate(i)
+	})
+}
+
+// CreateTLSSubscription implements api.Interface.
+func (c *Client) CreateTLSSubscription(i *fastly.CreateTLSSubscriptionInput) (*fastly.TLSSubscription, error) {
+	return call(c, "CreateTLSSubscription", false, func() (*fastly.TLSSubscription, error) {
+		return c.Interface.CreateTLSSubscription(i)
+	})
+}
+
+// DeleteTLSSubscription implements api.Interface.
+func (c *Client) DeleteTLSSubscription(i *fastly.DeleteTLSSubscriptionInput) error {
+	return c.do("DeleteTLSSubscription", false, func() error {
+		return c.Interface.DeleteTLSSubscription(i)
+	})
+}
+
+// GetTLSSubscription implements api.Interface.
+func (c *Client) GetTLSSubscription(i *fastly.GetTLSSubscriptionInput) (*fastly.TLSSubscription, error) {
+	return call(c, "GetTLSSubscription", true, func() (*fastly.TLSSubscription, error) {
+		return c.Interface.GetTLSSubscription(i)
+	})
+}
+
+// ListTLSSubscriptions implements api.Interface.
+func (c *Client) ListTLSSubscriptions(i *fastly.ListTLSSubscriptionsInput) ([]*fastly.TLSSubscription, error) {
+	return call(c, "ListTLSSubscriptions", true, func() ([]*fastly.TLSSubscription, error) {
+		return c.Interface.ListTLSSubscriptions(i)
+	})
+}
+
+// UpdateTLSSubscription implements api.Interface.
+func (c *Client) UpdateTLSSubscription(i *fastly.UpdateTLSSubscriptionInput) (*fastly.TLSSubscription, error) {
+	return call(c, "UpdateTLSSubscription", false, func() (*fastly.TLSSubscription, error) {
+		return c.Interface.UpdateTLSSubscription(i)
+	})
+}
+
+// ListServiceAuthorizations implements api.Interface.
+func (c *Client) ListServiceAuthorizations(i *fastly.ListServiceAuthorizationsInput) (*fastly.ServiceAuthorizations, error) {
+	return call(c, "ListServiceAuthorizations", true, func() (*fastly.ServiceAuthorizations, error) {
+		return c.Interface.ListServiceAuthorizations(i)
+	})
+}
+
+// GetServiceAuthorization implements api.Interface.
+func (c *Client) GetServiceAuthorization(i *fastly.GetServiceAuthorizationInput) (*fastly.ServiceAuthorization, error) {
+	return call(c, "GetServiceAuthorization", true, func() (*fastly.ServiceAuthorization, error) {
+		return c.Interface.GetServiceAuthorization(i)
+	})
+}
+
+// CreateServiceAuthorization implements api.Interface.
+func (c *Client) CreateServiceAuthorization(i *fastly.CreateServiceAuthorizationInput) (*fastly.ServiceAuthorization, error) {
+	return call(c, "CreateServiceAuthorization", false, func() (*fastly.ServiceAuthorization, error) {
+		return c.Interface.CreateServiceAuthorization(i)
+	})
+}
+
+// UpdateServiceAuthorization implements api.Interface.
+func (c *Client) UpdateServiceAuthorization(i *fastly.UpdateServiceAuthorizationInput) (*fastly.ServiceAuthorization, error) {
+	return call(c, "UpdateServiceAuthorization", false, func() (*fastly.ServiceAuthorization, error) {
+		return c.Interface.UpdateServiceAuthorization(i)
+	})
+}
+
+// DeleteServiceAuthorization implements api.Interface.
+func (c *Client) DeleteServiceAuthorization(i *fastly.DeleteServiceAuthorizationInput) error {
+	return c.do("DeleteServiceAuthorization", false, func() error {
+		return c.Interface.DeleteServiceAuthorization(i)
+	})
+}
+
+// CreateConfigStore implements api.Interface.
+func (c *Client) CreateConfigStore(i *fastly.CreateConfigStoreInput) (*fastly.ConfigStore, error) {
+	return call(c, "CreateConfigStore", false, func() (*fastly.ConfigStore, error) {
+		return c.Interface.CreateConfigStore(i)
+	})
+}
+
+// DeleteConfigStore implements api.Interface.
+func (c *Client) DeleteConfigStore(i *fastly.DeleteConfigStoreInput) error {
+	return c.do("DeleteConfigStore", false, func() error {
+		return c.Interface.DeleteConfigStore(i)
+	})
+}
+
+// GetConfigStore implements api.Interface.
+func (c *Client) GetConfigStore(i *fastly.GetConfigStoreInput) (*fastly.ConfigStore, error) {
+	return call(c, "GetConfigStore", true, func() (*fastly.ConfigStore, error) {
+		return c.Interface.GetConfigStore(i)
+	})
+}
+
+// GetConfigStoreMetadata implements api.Interface.
+func (c *Client) GetConfigStoreMetadata(i *fastly.GetConfigStoreMetadataInput) (*fastly.ConfigStoreMetadata, error) {
+	return call(c, "GetConfigStoreMetadata", true, func() (*fastly.ConfigStoreMetadata, error) {
+		return c.Interface.GetConfigStoreMetadata(i)
+	})
+}
+
+// ListConfigStores implements api.Interface.
+func (c *Client) ListConfigStores() ([]*fastly.ConfigStore, error) {
+	return call(c, "ListConfigStores", true, func() ([]*fastly.ConfigStore, error) {
+		return c.Interface.ListConfigStores()
+	})
+}
+
+// ListConfigStoreServices implements api.Interface.
+func (c *Client) ListConfigStoreServices(i *fastly.ListConfigStoreServicesInput) ([]*fastly.Service, error) {
+	return call(c, "ListConfigStoreServices", true, func() ([]*fastly.Service, error) {
+		return c.Interface.ListConfigStoreServices(i)
+	})
+}
+
+// UpdateConfigStore implements api.Interface.
+func (c *Client) UpdateConfigStore(i *fastly.UpdateConfigStoreInput) (*fastly.ConfigStore, error) {
+	return call(c, "UpdateConfigStore", false, func() (*fastly.ConfigStore, error) {
+		return c.Interface.UpdateConfigStore(i)
+	})
+}
+
+// CreateConfigStoreItem implements api.Interface.
+func (c *Client) CreateConfigStoreItem(i *fastly.CreateConfigStoreItemInput) (*fastly.ConfigStoreItem, error) {
+	return call(c, "CreateConfigStoreItem", false, func() (*fastly.ConfigStoreItem, error) {
+		return c.Interface.CreateConfigStoreItem(i)
+	})
+}
+
+// DeleteConfigStoreItem implements api.Interface.
+func (c *Client) DeleteConfigStoreItem(i *fastly.DeleteConfigStoreItemInput) error {
+	return c.do("DeleteConfigStoreItem", false, func() error {
+		return c.Interface.DeleteConfigStoreItem(i)
+	})
+}
+
+// GetConfigStoreItem implements api.Interface.
+func (c *Client) GetConfigStoreItem(i *fastly.GetConfigStoreItemInput) (*fastly.ConfigStoreItem, error) {
+	return call(c, "GetConfigStoreItem", true, func() (*fastly.ConfigStoreItem, error) {
+		return c.Interface.GetConfigStoreItem(i)
+	})
+}
+
+// ListConfigStoreItems implements api.Interface.
+func (c *Client) ListConfigStoreItems(i *fastly.ListConfigStoreItemsInput) ([]*fastly.ConfigStoreItem, error) {
+	return call(c, "ListConfigStoreItems", true, func() ([]*fastly.ConfigStoreItem, error) {
+		return c.Interface.ListConfigStoreItems(i)
+	})
+}
+
+// UpdateConfigStoreItem implements api.Interface.
+func (c *Client) UpdateConfigStoreItem(i *fastly.UpdateConfigStoreItemInput) (*fastly.ConfigStoreItem, error) {
+	return call(c, "UpdateConfigStoreItem", false, func() (*fastly.ConfigStoreItem, error) {
+		return c.Interface.UpdateConfigStoreItem(i)
+	})
+}
+
+// CreateKVStore implements api.Interface.
+func (c *Client) CreateKVStore(i *fastly.CreateKVStoreInput) (*fastly.KVStore, error) {
+	return call(c, "CreateKVStore", false, func() (*fastly.KVStore, error) {
+		return c.Interface.CreateKVStore(i)
+	})
+}
+
+// ListKVStores implements api.Interface.
+func (c *Client) ListKVStores(i *fastly.ListKVStoresInput) (*fastly.ListKVStoresResponse, error) {
+	return call(c, "ListKVStores", true, func() (*fastly.ListKVStoresResponse, error) {
+		return c.Interface.ListKVStores(i)
+	})
+}
+
+// DeleteKVStore implements api.Interface.
+func (c *Client) DeleteKVStore(i *fastly.DeleteKVStoreInput) error {
+	return c.do("DeleteKVStore", false, func() error {
+		return c.Interface.DeleteKVStore(i)
+	})
+}
+
+// GetKVStore implements api.Interface.
+func (c *Client) GetKVStore(i *fastly.GetKVStoreInput) (*fastly.KVStore, error) {
+	return call(c, "GetKVStore", true, func() (*fastly.KVStore, error) {
+		return c.Interface.GetKVStore(i)
+	})
+}
+
+// ListKVStoreKeys implements api.Interface.
+func (c *Client) ListKVStoreKeys(i *fastly.ListKVStoreKeysInput) (*fastly.ListKVStoreKeysResponse, error) {
+	return call(c, "ListKVStoreKeys", true, func() (*fastly.ListKVStoreKeysResponse, error) {
+		return c.Interface.ListKVStoreKeys(i)
+	})
+}
+
+// GetKVStoreKey implements api.Interface.
+func (c *Client) GetKVStoreKey(i *fastly.GetKVStoreKeyInput) (string, error) {
+	return call(c, "GetKVStoreKey", true, func() (string, error) {
+		return c.Interface.GetKVStoreKey(i)
+	})
+}
+
+// DeleteKVStoreKey implements api.Interface.
+func (c *Client) DeleteKVStoreKey(i *fastly.DeleteKVStoreKeyInput) error {
+	return c.do("DeleteKVStoreKey", false, func() error {
+		return c.Interface.DeleteKVStoreKey(i)
+	})
+}
+
+// InsertKVStoreKey implements api.Interface.
+func (c *Client) InsertKVStoreKey(i *fastly.InsertKVStoreKeyInput) error {
+	return c.do("InsertKVStoreKey", false, func() error {
+		return c.Interface.InsertKVStoreKey(i)
+	})
+}
+
+// BatchModifyKVStoreKey implements api.Interface.
+func (c *Client) BatchModifyKVStoreKey(i *fastly.BatchModifyKVStoreKeyInput) error {
+	return c.do("BatchModifyKVStoreKey", false, func() error {
+		return c.Interface.BatchModifyKVStoreKey(i)
+	})
+}
+
+// CreateSecretStore implements api.Interface.
+func (c *Client) CreateSecretStore(i *fastly.CreateSecretStoreInput) (*fastly.SecretStore, error) {
+	return call(c, "CreateSecretStore", false, func() (*fastly.SecretStore, error) {
+		return c.Interface.CreateSecretStore(i)
+	})
+}
+
+// GetSecretStore implements api.Interface.
+func (c *Client) GetSecretStore(i *fastly.GetSecretStoreInput) (*fastly.SecretStore, error) {
+	return call(c, "GetSecretStore", true, func() (*fastly.SecretStore, error) {
+		return c.Interface.GetSecretStore(i)
+	})
+}
+
+// DeleteSecretStore implements api.Interface.
+func (c *Client) DeleteSecretStore(i *fastly.DeleteSecretStoreInput) error {
+	return c.do("DeleteSecretStore", false, func() error {
+		return c.Interface.DeleteSecretStore(i)
+	})
+}
+
+// ListSecretStores implements api.Interface.
+func (c *Client) ListSecretStores(i *fastly.ListSecretStoresInput) (*fastly.SecretStores, error) {
+	return call(c, "ListSecretStores", true, func() (*fastly.SecretStores, error) {
+		return c.Interface.ListSecretStores(i)
+	})
+}
+
+// CreateSecret implements api.Interface.
+func (c *Client) CreateSecret(i *fastly.CreateSecretInput) (*fastly.Secret, error) {
+	return call(c, "CreateSecret", false, func() (*fastly.Secret, error) {
+		return c.Interface.CreateSecret(i)
+	})
+}
+
+// GetSecret implements api.Interface.
+func (c *Client) GetSecret(i *fastly.GetSecretInput) (*fastly.Secret, error) {
+	return call(c, "GetSecret", true, func() (*fastly.Secret, error) {
+		return c.Interface.GetSecret(i)
+	})
+}
+
+// DeleteSecret implements api.Interface.
+func (c *Client) DeleteSecret(i *fastly.DeleteSecretInput) error {
+	return c.do("DeleteSecret", false, func() error {
+		return c.Interface.DeleteSecret(i)
+	})
+}
+
+// ListSecrets implements api.Interface.
+func (c *Client) ListSecrets(i *fastly.ListSecretsInput) (*fastly.Secrets, error) {
+	return call(c, "ListSecrets", true, func() (*fastly.Secrets, error) {
+		return c.Interface.ListSecrets(i)
+	})
+}
+
+// CreateClientKey implements api.Interface.
+func (c *Client) CreateClientKey() (*fastly.ClientKey, error) {
+	return call(c, "CreateClientKey", false, func() (*fastly.ClientKey, error) {
+		return c.Interface.CreateClientKey()
+	})
+}
+
+// GetSigningKey implements api.Interface.
+func (c *Client) GetSigningKey() (ed25519.PublicKey, error) {
+	return call(c, "GetSigningKey", true, func() (ed25519.PublicKey, error) {
+		return c.Interface.GetSigningKey()
+	})
+}
+
+// CreateResource implements api.Interface.
+func (c *Client) CreateResource(i *fastly.CreateResourceInput) (*fastly.Resource, error) {
+	return call(c, "CreateResource", false, func() (*fastly.Resource, error) {
+		return c.Interface.CreateResource(i)
+	})
+}
+
+// DeleteResource implements api.Interface.
+func (c *Client) DeleteResource(i *fastly.DeleteResourceInput) error {
+	return c.do("DeleteResource", false, func() error {
+		return c.Interface.DeleteResource(i)
+	})
+}
+
+// GetResource implements api.Interface.
+func (c *Client) GetResource(i *fastly.GetResourceInput) (*fastly.Resource, error) {
+	return call(c, "GetResource", true, func() (*fastly.Resource, error) {
+		return c.Interface.GetResource(i)
+	})
+}
+
+// ListResources implements api.Interface.
+func (c *Client) ListResources(i *fastly.ListResourcesInput) ([]*fastly.Resource, error) {
+	return call(c, "ListResources", true, func() ([]*fastly.Resource, error) {
+		return c.Interface.ListResources(i)
+	})
+}
+
+// UpdateResource implements api.Interface.
+func (c *Client) UpdateResource(i *fastly.UpdateResourceInput) (*fastly.Resource, error) {
+	return call(c, "UpdateResource", false, func() (*fastly.Resource, error) {
+		return c.Interface.UpdateResource(i)
+	})
+}
+
+// CreateERL implements api.Interface.
+func (c *Client) CreateERL(i *fastly.CreateERLInput) (*fastly.ERL, error) {
+	return call(c, "CreateERL", false, func() (*fastly.ERL, error) {
+		return c.Interface.CreateERL(i)
+	})
+}
+
+// DeleteERL implements api.Interface.
+func (c *Client) DeleteERL(i *fastly.DeleteERLInput) error {
+	return c.do("DeleteERL", false, func() error {
+		return c.Interface.DeleteERL(i)
+	})
+}
+
+// GetERL implements api.Interface.
+func (c *Client) GetERL(i *fastly.GetERLInput) (*fastly.ERL, error) {
+	return call(c, "GetERL", true, func() (*fastly.ERL, error) {
+		return c.Interface.GetERL(i)
+	})
+}
+
+// ListERLs implements api.Interface.
+func (c *Client) ListERLs(i *fastly.ListERLsInput) ([]*fastly.ERL, error) {
+	return call(c, "ListERLs", true, func() ([]*fastly.ERL, error) {
+		return c.Interface.ListERLs(i)
+	})
+}
+
+// UpdateERL implements api.Interface.
+func (c *Client) UpdateERL(i *fastly.UpdateERLInput) (*fastly.ERL, error) {
+	return call(c, "UpdateERL", false, func() (*fastly.ERL, error) {
+		return c.Interface.UpdateERL(i)
+	})
+}
+
+// CreateCondition implements api.Interface.
+func (c *Client) CreateCondition(i *fastly.CreateConditionInput) (*fastly.Condition, error) {
+	return call(c, "CreateCondition", false, func() (*fastly.Condition, error) {
+		return c.Interface.CreateCondition(i)
+	})
+}
+
+// DeleteCondition implements api.Interface.
+func (c *Client) DeleteCondition(i *fastly.DeleteConditionInput) error {
+	return c.do("DeleteCondition", false, func() error {
+		return c.Interface.DeleteCondition(i)
+	})
+}
+
+// GetCondition implements api.Interface.
+func (c *Client) GetCondition(i *fastly.GetConditionInput) (*fastly.Condition, error) {
+	return call(c, "GetCondition", true, func() (*fastly.Condition, error) {
+		return c.Interface.GetCondition(i)
+	})
+}
+
+// ListConditions implements api.Interface.
+func (c *Client) ListConditions(i *fastly.ListConditionsInput) ([]*fastly.Condition, error) {
+	return call(c, "ListConditions", true, func() ([]*fastly.Condition, error) {
+		return c.Interface.ListConditions(i)
+	})
+}
+
+// UpdateCondition implements api.Interface.
+func (c *Client) UpdateCondition(i *fastly.UpdateConditionInput) (*fastly.Condition, error) {
+	return call(c, "UpdateCondition", false, func() (*fastly.Condition, error) {
+		return c.Interface.UpdateCondition(i)
+	})
+}
+
+// GetProduct implements api.Interface.
+func (c *Client) GetProduct(i *fastly.ProductEnablementInput) (*fastly.ProductEnablement, error) {
+	return call(c, "GetProduct", true, func() (*fastly.ProductEnablement, error) {
+		return c.Interface.GetProduct(i)
+	})
+}
+
+// EnableProduct implements api.Interface.
+func (c *Client) EnableProduct(i *fastly.ProductEnablementInput) (*fastly.ProductEnablement, error) {
+	return call(c, "EnableProduct", false, func() (*fastly.ProductEnablement, error) {
+		return c.Interface.EnableProduct(i)
+	})
+}
+
+// DisableProduct implements api.Interface.
+func (c *Client) DisableProduct(i *fastly.ProductEnablementInput) error {
+	return c.do("DisableProduct", false, func() error {
+		return c.Interface.DisableProduct(i)
+	})
+}
@@ -0,0 +1,210 @@
+// Package retry provides an API client which retries failed API calls with
+// backoff, honouring the rate limit information returned by the Fastly API.
+package retry
+
+import (
+	"errors"
+	"io"
+	"math/rand"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/fastly/go-fastly/v8/fastly"
+
+	"github.com/fastly/cli/pkg/api"
+	"github.com/fastly/cli/pkg/text"
+)
+
+const (
+	// DefaultBaseDelay is the delay before the first retry, which doubles with
+	// each subsequent retry.
+	DefaultBaseDelay = 500 * time.Millisecond
+	// DefaultMaxDelay is the longest the client will wait before a retry.
+	DefaultMaxDelay = 30 * time.Second
+)
+
+// Options configures the behaviour of a Client.
+type Options struct {
+	// MaxRetries is the maximum number of times a failed call is retried.
+	MaxRetries int
+	// BaseDelay is the delay before the first retry (default: DefaultBaseDelay).
+	BaseDelay time.Duration
+	// MaxDelay is the longest to wait before a retry (default: DefaultMaxDelay).
+	// If the API requests a longer delay, the call isn't retried.
+	MaxDelay time.Duration
+	// Out is where a message is written before each retry (optional).
+	Out io.Writer
+}
+
+// Client is an api.Interface implementation that retries the calls of the
+// wrapped client when they fail because of the API rate limit (HTTP 429).
+//
+// Calls which are idempotent (i.e. those that only read resources) are also
+// retried when they fail with a transient server (HTTP 5xx) or network error.
+//
+// The delay before a retry is the time until the rate limit resets (see the
+// Fastly-RateLimit-Reset header and Transport), otherwise an exponential
+// backoff with jitter.
+type Client struct {
+	api.Interface
+
+	opts  Options
+	now   func() time.Time
+	sleep func(time.Duration)
+	rand  func() float64
+}
+
+// NewClient returns a Client wrapping c.
+func NewClient(c api.Interface, opts Options) *Client {
+	if opts.BaseDelay <= 0 {
+		opts.BaseDelay = DefaultBaseDelay
+	}
+	if opts.MaxDelay <= 0 {
+		opts.MaxDelay = DefaultMaxDelay
+	}
+	return &Client{
+		Interface: c,
+		opts:      opts,
+		now:       time.Now,
+		sleep:     time.Sleep,
+		rand:      rand.Float64, // #nosec G404 (jitter doesn't need a secure source)
+	}
+}
+
+// do calls fn, retrying it according to the client's options.
+func (c *Client) do(method string, idempotent bool, fn func() error) error {
+	for attempt := 0; ; attempt++ {
+		err := fn()
+		if err == nil || attempt >= c.opts.MaxRetries {
+			return err
+		}
+		delay, ok := c.delay(err, idempotent, attempt)
+		if !ok {
+			return err
+		}
+		if c.opts.Out != nil {
+			text.Info(c.opts.Out, "%s failed (%v), retrying in %s (attempt %d of %d)", method, err, delay.Round(time.Millisecond), attempt+1, c.opts.MaxRetries)
+		}
+		c.sleep(delay)
+	}
+}
+
+// delay returns how long to wait before retrying a call that failed with err,
+// and whether the call should be retried at all.
+func (c *Client) delay(err error, idempotent bool, attempt int) (time.Duration, bool) {
+	var httpError *fastly.HTTPError
+	if errors.As(err, &httpError) {
+		rateLimited := httpError.StatusCode == http.StatusTooManyRequests
+		if !rateLimited && !(idempotent && retryableStatus(httpError.StatusCode)) {
+			return 0, false
+		}
+		if httpError.RateLimitReset != nil && (rateLimited || httpError.RateLimitRemaining == nil || *httpError.RateLimitRemaining == 0) {
+			d := time.Unix(int64(*httpError.RateLimitReset), 0).Sub(c.now())
+			if d > c.opts.MaxDelay {
+				return 0, false
+			}
+			if d > 0 {
+				return d, true
+			}
+		}
+		return c.backoff(attempt), true
+	}
+
+	var netError net.Error
+	if idempotent && errors.As(err, &netError) {
+		return c.backoff(attempt), true
+	}
+	return 0, false
+}
+
+// backoff returns the exponential backoff delay for the given attempt, with
+// "equal jitter" (i.e. a random delay between half and all of the backoff).
+func (c *Client) backoff(attempt int) time.Duration {
+	d := c.opts.BaseDelay << attempt
+	if d <= 0 || d > c.opts.MaxDelay {
+		d = c.opts.MaxDelay
+	}
+	return d/2 + time.Duration(c.rand()*float64(d/2))
+}
+
+// retryableStatus indicates if a status code is (likely to be) transient.
+func retryableStatus(code int) bool {
+	switch code {
+	case http.StatusInternalServerError, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// call calls fn via c.do and returns its result.
+func call[T any](c *Client, method string, idempotent bool, fn func() (T, error)) (T, error) {
+	var r T
+	err := c.do(method, idempotent, func() (err error) {
+		r, err = fn()
+		return err
+	})
+	return r, err
+}
+
+// AllIPs implements api.Interface.
+func (c *Client) AllIPs() (v4, v6 fastly.IPAddrs, err error) {
+	err = c.do("AllIPs", true, func() (err error) {
+		v4, v6, err = c.Interface.AllIPs()
+		return err
+	})
+	return v4, v6, err
+}
+
+// ValidateVersion implements api.Interface.
+func (c *Client) ValidateVersion(i *fastly.ValidateVersionInput) (valid bool, msg string, err error) {
+	err = c.do("ValidateVersion", true, func() (err error) {
+		valid, msg, err = c.Interface.ValidateVersion(i)
+		return err
+	})
+	return valid, msg, err
+}
+
+// Transport is an http.RoundTripper which exposes the delay requested by a
+// Retry-After response header as a Fastly-RateLimit-Reset header (unless one
+// is already set), so that it's available to the Client via a
+// fastly.HTTPError.
+type Transport struct {
+	// Base is the underlying http.RoundTripper (default: http.DefaultTransport).
+	Base http.RoundTripper
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	base := t.Base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	resp, err := base.RoundTrip(req)
+	if err != nil || resp == nil {
+		return resp, err
+	}
+	if resp.Header.Get("Fastly-RateLimit-Reset") == "" {
+		if reset, ok := retryAfter(resp.Header.Get("Retry-After"), time.Now()); ok {
+			resp.Header.Set("Fastly-RateLimit-Reset", strconv.FormatInt(reset.Unix(), 10))
+		}
+	}
+	return resp, nil
+}
+
+// retryAfter parses a Retry-After header value, which is either a number of
+// seconds or an HTTP date, returning the time after which to retry.
+func retryAfter(v string, now time.Time) (time.Time, bool) {
+	if v == "" {
+		return time.Time{}, false
+	}
+	if secs, err := strconv.Atoi(v); err == nil && secs >= 0 {
+		// Round up so that the retry isn't made before the requested delay.
+		return now.Add(time.Duration(secs)*time.Second + time.Second - 1).Truncate(time.Second), true
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		return t, true
+	}
+	return time.Time{}, false
+}
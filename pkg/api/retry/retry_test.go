@@ -0,0 +1,252 @@
+package retry_test
+
+import (
+	"errors"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/fastly/go-fastly/v8/fastly"
+
+	"github.com/fastly/cli/pkg/api"
+	"github.com/fastly/cli/pkg/api/retry"
+	"github.com/fastly/cli/pkg/mock"
+	"github.com/fastly/cli/pkg/testutil"
+)
+
+func TestClient(t *testing.T) {
+	future := int(time.Now().Add(time.Hour).Unix())
+	past := int(time.Now().Add(-time.Minute).Unix())
+
+	for _, testcase := range []struct {
+		name      string
+		errs      []error
+		mutating  bool
+		wantCalls int
+		wantError bool
+	}{
+		{
+			name:      "success",
+			wantCalls: 1,
+		},
+		{
+			name:      "rate limited then success",
+			errs:      []error{&fastly.HTTPError{StatusCode: http.StatusTooManyRequests}},
+			wantCalls: 2,
+		},
+		{
+			name:      "rate limited mutating call is retried",
+			errs:      []error{&fastly.HTTPError{StatusCode: http.StatusTooManyRequests, RateLimitReset: &past}},
+			mutating:  true,
+			wantCalls: 2,
+		},
+		{
+			name:      "server error then success",
+			errs:      []error{&fastly.HTTPError{StatusCode: http.StatusServiceUnavailable}, &fastly.HTTPError{StatusCode: http.StatusBadGateway}},
+			wantCalls: 3,
+		},
+		{
+			name:      "server error on mutating call isn't retried",
+			errs:      []error{&fastly.HTTPError{StatusCode: http.StatusInternalServerError}},
+			mutating:  true,
+			wantCalls: 1,
+			wantError: true,
+		},
+		{
+			name:      "client error isn't retried",
+			errs:      []error{&fastly.HTTPError{StatusCode: http.StatusNotFound}},
+			wantCalls: 1,
+			wantError: true,
+		},
+		{
+			name:      "plain error isn't retried",
+			errs:      []error{testutil.Err},
+			wantCalls: 1,
+			wantError: true,
+		},
+		{
+			name:      "rate limit reset beyond the maximum delay isn't waited for",
+			errs:      []error{&fastly.HTTPError{StatusCode: http.StatusTooManyRequests, RateLimitReset: &future}},
+			wantCalls: 1,
+			wantError: true,
+		},
+		{
+			name: "retries are exhausted",
+			errs: []error{
+				&fastly.HTTPError{StatusCode: http.StatusTooManyRequests},
+				&fastly.HTTPError{StatusCode: http.StatusTooManyRequests},
+				&fastly.HTTPError{StatusCode: http.StatusTooManyRequests},
+				&fastly.HTTPError{StatusCode: http.StatusTooManyRequests},
+			},
+			wantCalls: 3,
+			wantError: true,
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			var calls int
+			next := func() error {
+				calls++
+				if calls <= len(testcase.errs) {
+					return testcase.errs[calls-1]
+				}
+				return nil
+			}
+
+			c := retry.NewClient(mock.API{
+				GetServiceFn: func(i *fastly.GetServiceInput) (*fastly.Service, error) {
+					if err := next(); err != nil {
+						return nil, err
+					}
+					return &fastly.Service{ID: i.ID}, nil
+				},
+				DeleteServiceFn: func(_ *fastly.DeleteServiceInput) error {
+					return next()
+				},
+			}, retry.Options{
+				MaxRetries: 2,
+				BaseDelay:  time.Millisecond,
+				MaxDelay:   10 * time.Millisecond,
+			})
+
+			var err error
+			if testcase.mutating {
+				err = c.DeleteService(&fastly.DeleteServiceInput{ID: "123"})
+			} else {
+				var s *fastly.Service
+				s, err = c.GetService(&fastly.GetServiceInput{ID: "123"})
+				if err == nil {
+					testutil.AssertString(t, "123", s.ID)
+				}
+			}
+
+			testutil.AssertEqual(t, testcase.wantCalls, calls)
+			testutil.AssertBool(t, testcase.wantError, err != nil)
+			if testcase.wantError && calls <= len(testcase.errs) {
+				testutil.AssertBool(t, true, errors.Is(err, testcase.errs[calls-1]))
+			}
+		})
+	}
+}
+
+func TestTransport(t *testing.T) {
+	date := time.Now().Add(time.Minute).UTC().Truncate(time.Second)
+
+	for _, testcase := range []struct {
+		name       string
+		header     http.Header
+		wantHeader string
+		wantReset  func(reset int64) bool
+	}{
+		{
+			name:   "Retry-After seconds",
+			header: http.Header{"Retry-After": []string{"2"}},
+			wantReset: func(reset int64) bool {
+				d := time.Until(time.Unix(reset, 0))
+				return d > 0 && d <= 3*time.Second
+			},
+		},
+		{
+			name:   "Retry-After HTTP date",
+			header: http.Header{"Retry-After": []string{date.Format(http.TimeFormat)}},
+			wantReset: func(reset int64) bool {
+				return reset == date.Unix()
+			},
+		},
+		{
+			name:       "existing Fastly-RateLimit-Reset is kept",
+			header:     http.Header{"Retry-After": []string{"2"}, "Fastly-Ratelimit-Reset": []string{"123"}},
+			wantHeader: "123",
+		},
+		{
+			name:       "no Retry-After",
+			header:     http.Header{},
+			wantHeader: "",
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				for k, v := range testcase.header {
+					w.Header()[k] = v
+				}
+				w.WriteHeader(http.StatusTooManyRequests)
+			}))
+			defer ts.Close()
+
+			client := &http.Client{Transport: &retry.Transport{}}
+			resp, err := client.Get(ts.URL)
+			testutil.AssertNoError(t, err)
+			defer resp.Body.Close()
+
+			have := resp.Header.Get("Fastly-RateLimit-Reset")
+			if testcase.wantReset == nil {
+				testutil.AssertString(t, testcase.wantHeader, have)
+				return
+			}
+			reset, err := strconv.ParseInt(have, 10, 64)
+			testutil.AssertNoError(t, err)
+			if !testcase.wantReset(reset) {
+				t.Errorf("unexpected Fastly-RateLimit-Reset: %s", have)
+			}
+		})
+	}
+}
+
+// TestClientWrapsInterface ensures every api.Interface method is wrapped by
+// retry.Client, rather than promoted (without retries) from the embedded client.
+func TestClientWrapsInterface(t *testing.T) {
+	// NOTE: Paginators make their API calls lazily so they can't be wrapped.
+	// Do (api.HTTPClient) and GetRealtimeStatsJSON (api.RealtimeStatsInterface)
+	// aren't part of api.Interface and so are also not wrapped.
+	unwrapped := map[string]bool{
+		"NewListACLEntriesPaginator":      true,
+		"NewListDictionaryItemsPaginator": true,
+		"NewListKVStoreKeysPaginator":     true,
+		"NewListServicesPaginator":        true,
+	}
+
+	files, err := filepath.Glob("*.go")
+	testutil.AssertNoError(t, err)
+	defined := make(map[string]bool)
+	fset := token.NewFileSet()
+	for _, file := range files {
+		if strings.HasSuffix(file, "_test.go") {
+			continue
+		}
+		f, err := parser.ParseFile(fset, file, nil, 0)
+		testutil.AssertNoError(t, err)
+		for _, decl := range f.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || fn.Recv == nil {
+				continue
+			}
+			if star, ok := fn.Recv.List[0].Type.(*ast.StarExpr); ok {
+				if ident, ok := star.X.(*ast.Ident); ok && ident.Name == "Client" {
+					defined[fn.Name.Name] = true
+				}
+			}
+		}
+	}
+
+	iface := reflect.TypeOf((*api.Interface)(nil)).Elem()
+	for i := 0; i < iface.NumMethod(); i++ {
+		name := iface.Method(i).Name
+		switch {
+		case unwrapped[name] && defined[name]:
+			t.Errorf("%s is wrapped by retry.Client, remove it from the unwrapped methods", name)
+		case !unwrapped[name] && !defined[name]:
+			t.Errorf("%s isn't wrapped by retry.Client", name)
+		}
+		delete(unwrapped, name)
+	}
+	for name := range unwrapped {
+		t.Errorf("%s isn't an api.Interface method, remove it from the unwrapped methods", name)
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"io"
 	"net/http"
 	"os"
+	"path/filepath"
 	"slices"
 	"strconv"
 	"strings"
@@ -19,13 +20,18 @@ import (
 	"github.com/skratchdot/open-golang/open"
 
 	"github.com/fastly/cli/pkg/api"
+	"github.com/fastly/cli/pkg/api/cassette"
+	"github.com/fastly/cli/pkg/api/dryrun"
+	"github.com/fastly/cli/pkg/api/retry"
 	"github.com/fastly/cli/pkg/argparser"
 	"github.com/fastly/cli/pkg/auth"
 	"github.com/fastly/cli/pkg/commands"
 	"github.com/fastly/cli/pkg/commands/compute"
+	"github.com/fastly/cli/pkg/commands/sso"
 	"github.com/fastly/cli/pkg/commands/update"
 	"github.com/fastly/cli/pkg/commands/version"
 	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/credentials"
 	"github.com/fastly/cli/pkg/env"
 	fsterr "github.com/fastly/cli/pkg/errors"
 	"github.com/fastly/cli/pkg/github"
@@ -33,6 +39,7 @@ import (
 	"github.com/fastly/cli/pkg/lookup"
 	"github.com/fastly/cli/pkg/manifest"
 	"github.com/fastly/cli/pkg/profile"
+	"github.com/fastly/cli/pkg/project"
 	"github.com/fastly/cli/pkg/revision"
 	"github.com/fastly/cli/pkg/sync"
 	"github.com/fastly/cli/pkg/text"
@@ -54,6 +61,23 @@ var Init = func(args []string, stdin io.Reader) (*global.Data, error) {
 	// Parse the arguments provided by the user via the command-line interface.
 	args = args[1:]
 
+	// Read the `.fastly` project file (if any) and apply its default flags.
+	//
+	// NOTE: This happens before any other processing of the arguments so that
+	// the default flags (e.g. --verbose) are taken into account.
+	var projectFile project.File
+	if wd, err := os.Getwd(); err == nil {
+		if err := projectFile.Read(wd); err != nil {
+			fsterr.Log.Add(err)
+			return nil, err
+		}
+	}
+	args, err := applyProjectFlags(args, projectFile)
+	if err != nil {
+		fsterr.Log.Add(err)
+		return nil, err
+	}
+
 	// Define a HTTP client that will be used for making arbitrary HTTP requests.
 	httpClient := &http.Client{Timeout: time.Minute * 2}
 
@@ -97,8 +121,27 @@ var Init = func(args []string, stdin io.Reader) (*global.Data, error) {
 		return nil, err
 	}
 
+	// Configure where the profile tokens are stored.
+	//
+	// NOTE: An invalid [credentials] section isn't fatal, so the user can still
+	// run `fastly config --credential-store` to fix it.
+	credentialStore, err := credentials.New(credentials.Opts{
+		Dir:            filepath.Dir(config.FilePath),
+		Helper:         cfg.Credentials.Helper,
+		In:             in,
+		NonInteractive: nonInteractive,
+		Out:            out,
+		Passphrase:     e.CredentialsPassphrase,
+		Store:          cfg.Credentials.Store,
+	})
+	if err != nil {
+		fsterr.Log.Add(err)
+	}
+	cfg.SetCredentialStore(credentialStore)
+
 	// Extract user's project configuration from the fastly.toml manifest.
 	var md manifest.Data
+	md.Project = projectFile
 	md.File.Args = args
 	md.File.SetErrLog(fsterr.Log)
 	md.File.SetOutput(out)
@@ -108,10 +151,15 @@ var Init = func(args []string, stdin io.Reader) (*global.Data, error) {
 
 	factory := func(token, endpoint string, debugMode bool) (api.Interface, error) {
 		client, err := fastly.NewClientForEndpoint(token, endpoint)
+		if err != nil {
+			return nil, err
+		}
 		if debugMode {
 			client.DebugMode = true
 		}
-		return client, err
+		// Expose any Retry-After delay to the retry.Client (see Exec).
+		client.HTTPClient.Transport = &retry.Transport{Base: client.HTTPClient.Transport}
+		return client, nil
 	}
 
 	versioners := global.Versioners{
@@ -165,7 +213,7 @@ var Init = func(args []string, stdin io.Reader) (*global.Data, error) {
 // The Exec helper should NOT output any error-related information to the out
 // io.Writer. All error-related information should be encoded into an error type
 // and returned to the caller. This includes usage text.
-func Exec(data *global.Data) error {
+func Exec(data *global.Data) (err error) {
 	app := configureKingpin(data)
 	cmds := commands.Define(app, data)
 	command, commandName, err := processCommandInput(data, app, cmds)
@@ -186,6 +234,18 @@ func Exec(data *global.Data) error {
 		return nil
 	}
 
+	c, err := configureCassette(commandName, data)
+	if err != nil {
+		return err
+	}
+	if c != nil {
+		defer func() {
+			if saveErr := c.Save(); saveErr != nil && err == nil {
+				err = saveErr
+			}
+		}()
+	}
+
 	metadataDisable, _ := strconv.ParseBool(data.Env.WasmMetadataDisable)
 	if !slices.Contains(data.Args, "--metadata-disable") && !metadataDisable && !data.Config.CLI.MetadataNoticeDisplayed && commandCollectsData(commandName) {
 		text.Important(data.Output, "The Fastly CLI is configured to collect data related to Wasm builds (e.g. compilation times, resource usage, and other non-identifying data). To learn more about what data is being collected, why, and how to disable it: https://developer.fastly.com/reference/cli/")
@@ -202,12 +262,24 @@ func Exec(data *global.Data) error {
 		data.Manifest.File.SetQuiet(true)
 	}
 
+	if data.Verbose() && data.Manifest.Project.Exists() {
+		fmt.Fprintf(data.Output, "Project file: %s\n", data.Manifest.Project.Path())
+	}
+
 	apiEndpoint, endpointSource := data.APIEndpoint()
+	if endpointSource == lookup.SourceProject && !project.TrustedAPIEndpoint(apiEndpoint, data.Config.Fastly.APIEndpoint) {
+		err := fmt.Errorf("the api_endpoint '%s' in project file '%s' isn't trusted", apiEndpoint, data.Manifest.Project.Path())
+		data.ErrLog.Add(err)
+		return fsterr.RemediationError{
+			Inner:       err,
+			Remediation: fmt.Sprintf("A project file can only set a Fastly API endpoint (or the one in the CLI config). Use the %s environment variable to use another endpoint.", env.APIEndpoint),
+		}
+	}
 	if data.Verbose() {
 		displayAPIEndpoint(apiEndpoint, endpointSource, data.Output)
 	}
 
-	if commandRequiresToken(commandName) {
+	if commandRequiresToken(commandName, data.Args) {
 		// NOTE: Checking for nil allows our test suite to mock the server.
 		// i.e. it'll be nil whenever the CLI is run by a user but not `go test`.
 		if data.AuthServer == nil {
@@ -231,6 +303,7 @@ func Exec(data *global.Data) error {
 		}
 		if !data.Flags.Quiet {
 			checkConfigPermissions(commandName, tokenSource, data.Output)
+			checkTokenExpiry(tokenSource, data)
 		}
 
 		data.APIClient, data.RTSClient, err = configureClients(token, apiEndpoint, data.APIClientFactory, data.Flags.Debug)
@@ -238,6 +311,20 @@ func Exec(data *global.Data) error {
 			data.ErrLog.Add(err)
 			return fmt.Errorf("error constructing client: %w", err)
 		}
+
+		maxRetries, _ := data.MaxRetries()
+		opts := retry.Options{MaxRetries: maxRetries}
+		if data.Verbose() {
+			opts.Out = data.Output
+		}
+		data.APIClient = retry.NewClient(data.APIClient, opts)
+
+		if data.Flags.DryRun {
+			dc := dryrun.NewClient(data.APIClient, data.Output)
+			data.APIClient = dc
+			// NOTE: Some commands call the API directly (e.g. undocumented endpoints).
+			data.HTTPClient = dc.WrapHTTPClient(data.HTTPClient)
+		}
 	}
 
 	f := checkForUpdates(data.Versioners.CLI, commandName, data.Flags.Quiet)
@@ -279,11 +366,15 @@ func configureKingpin(data *global.Data) *kingpin.Application {
 	app.Flag("auto-yes", "Answer yes automatically to all Yes/No confirmations. This may suppress security warnings").Short('y').BoolVar(&data.Flags.AutoYes)
 	// IMPORTANT: `--debug` is a built-in Kingpin flag so we must use `debug-mode`.
 	app.Flag("debug-mode", "Print API request and response details (NOTE: can disrupt the normal CLI flow output formatting)").BoolVar(&data.Flags.Debug)
+	app.Flag("dry-run", "Print the API calls that would modify resources instead of making them (read-only API calls are still made)").BoolVar(&data.Flags.DryRun)
 	// IMPORTANT: `--sso` causes a Kingpin runtime panic 🤦 so we use `enable-sso`.
 	app.Flag("enable-sso", "Enable Single-Sign On (SSO) for current profile execution (see also: 'fastly sso')").Hidden().BoolVar(&data.Flags.SSO)
+	app.Flag("max-retries", fmt.Sprintf("Maximum number of times a failed API call is retried (or via %s, default: %d)", env.MaxRetries, global.DefaultMaxRetries)).Default("-1").PlaceHolder("N").IntVar(&data.Flags.MaxRetries)
 	app.Flag("non-interactive", "Do not prompt for user input - suitable for CI processes. Equivalent to --accept-defaults and --auto-yes").Short('i').BoolVar(&data.Flags.NonInteractive)
 	app.Flag("profile", "Switch account profile for single command execution (see also: 'fastly profile switch')").Short('o').StringVar(&data.Flags.Profile)
 	app.Flag("quiet", "Silence all output except direct command output. This won't prevent interactive prompts (see: --accept-defaults, --auto-yes, --non-interactive)").Short('q').BoolVar(&data.Flags.Quiet)
+	app.Flag("record", "Record the HTTP requests and responses into a cassette file within the given directory").Hidden().PlaceHolder("DIR").StringVar(&data.Flags.Record)
+	app.Flag("replay", "Replay the HTTP responses from a cassette file (see --record) within the given directory, without network access").Hidden().PlaceHolder("DIR").StringVar(&data.Flags.Replay)
 	app.Flag("token", tokenHelp).HintAction(env.Vars).Short('t').StringVar(&data.Flags.Token)
 	app.Flag("verbose", "Verbose logging").Short('v').BoolVar(&data.Flags.Verbose)
 
@@ -322,6 +413,10 @@ func processToken(cmds []argparser.Command, data *global.Data) (token string, to
 		if err != nil {
 			return "", tokenSource, err
 		}
+		if err := data.Config.LoadCredentials(profileName); err != nil {
+			return "", tokenSource, err
+		}
+		token = profileData.Token
 		// User with long-lived token will skip SSO if they've not enabled it.
 		if shouldSkipSSO(profileName, profileData, data) {
 			return token, tokenSource, nil
@@ -350,7 +445,7 @@ func processToken(cmds []argparser.Command, data *global.Data) (token string, to
 			return "", tokenSource, nil
 		}
 		return ssoAuthentication("No API token could be found", cmds, data)
-	case lookup.SourceEnvironment, lookup.SourceFlag, lookup.SourceDefault:
+	case lookup.SourceEnvironment, lookup.SourceFlag, lookup.SourceDefault, lookup.SourceProject:
 		// no-op
 	}
 
@@ -369,6 +464,8 @@ func getProfile(data *global.Data) (string, *config.Profile, error) {
 		profileName = data.Flags.Profile
 	case data.Manifest.File.Profile != "": // `profile` field in fastly.toml
 		profileName = data.Manifest.File.Profile
+	case data.Manifest.Project.Profile != "": // `profile` field in .fastly
+		profileName = data.Manifest.Project.Profile
 	default:
 		profileName = "default"
 	}
@@ -499,7 +596,11 @@ func ssoAuthentication(outputMessage string, cmds []argparser.Command, data *glo
 				if data.Verbose() {
 					text.Break(data.Output)
 				}
-				text.Important(data.Output, "%s. We need to open your browser to authenticate you.", outputMessage)
+				promptMessage := "We need to open your browser to authenticate you."
+				if ssoCmd, ok := command.(*sso.RootCommand); ok {
+					promptMessage = ssoCmd.PromptMessage()
+				}
+				text.Important(data.Output, "%s. %s", outputMessage, promptMessage)
 				text.Break(data.Output)
 				cont, err := text.AskYesNo(data.Output, text.BoldYellow("Do you want to continue? [y/N]: "), data.Input)
 				text.Break(data.Output)
@@ -530,7 +631,7 @@ func ssoAuthentication(outputMessage string, cmds []argparser.Command, data *glo
 }
 
 func displayToken(tokenSource lookup.Source, data *global.Data) {
-	profileSource := determineProfile(data.Manifest.File.Profile, data.Flags.Profile, data.Config.Profiles)
+	profileSource := determineProfile(data.Manifest.File.Profile, data.Manifest.Project.Profile, data.Flags.Profile, data.Config.Profiles)
 
 	switch tokenSource {
 	case lookup.SourceFlag:
@@ -562,6 +663,32 @@ func checkConfigPermissions(commandName string, tokenSource lookup.Source, out i
 	}
 }
 
+// checkTokenExpiry warns the user if the profile token is due to expire (as
+// recorded the last time its details were retrieved from the API).
+func checkTokenExpiry(tokenSource lookup.Source, data *global.Data) {
+	if tokenSource != lookup.SourceFile {
+		return
+	}
+	profileName, profileData, err := getProfile(data)
+	if err != nil {
+		return
+	}
+	days := data.Config.CLI.TokenExpiryWarningDays
+	if days == 0 {
+		days = profile.TokenExpiryWarningDays
+	}
+	now := time.Now()
+	expires, ok := profile.TokenExpiresWithin(profileData, days, now)
+	if !ok {
+		return
+	}
+	if expires.Before(now) {
+		text.Warning(data.Output, "The API token of profile '%s' expired at %s. Create a new token and run `fastly profile update %s`.\n\n", profileName, expires.UTC().Format(time.RFC3339), profileName)
+		return
+	}
+	text.Warning(data.Output, "The API token of profile '%s' expires at %s. Create a new token and run `fastly profile update %s`.\n\n", profileName, expires.UTC().Format(time.RFC3339), profileName)
+}
+
 func displayAPIEndpoint(endpoint string, endpointSource lookup.Source, out io.Writer) {
 	switch endpointSource {
 	case lookup.SourceFlag:
@@ -570,6 +697,8 @@ func displayAPIEndpoint(endpoint string, endpointSource lookup.Source, out io.Wr
 		fmt.Fprintf(out, "Fastly API endpoint (via %s): %s\n", env.APIEndpoint, endpoint)
 	case lookup.SourceFile:
 		fmt.Fprintf(out, "Fastly API endpoint (via config file): %s\n", endpoint)
+	case lookup.SourceProject:
+		fmt.Fprintf(out, "Fastly API endpoint (via %s): %s\n", project.Filename, endpoint)
 	case lookup.SourceDefault, lookup.SourceUndefined:
 		fallthrough
 	default:
@@ -577,6 +706,52 @@ func displayAPIEndpoint(endpoint string, endpointSource lookup.Source, out io.Wr
 	}
 }
 
+// configureCassette handles the hidden --record and --replay flags, which
+// record the HTTP exchanges of the invocation into a cassette file within the
+// given directory, or replay them from it without making network requests.
+//
+// NOTE: Only the transports of an *http.Client and a *fastly.Client can be
+// wrapped, so the HTTPClient and APIClientFactory used by our test suite are
+// otherwise left alone.
+func configureCassette(commandName string, data *global.Data) (*cassette.Cassette, error) {
+	var c *cassette.Cassette
+	switch {
+	case data.Flags.Record != "" && data.Flags.Replay != "":
+		return nil, fsterr.ErrInvalidRecordReplayCombo
+	case data.Flags.Record != "":
+		c = cassette.New(data.Flags.Record, commandName, data.Args)
+	case data.Flags.Replay != "":
+		var err error
+		c, err = cassette.Load(data.Flags.Replay, commandName, data.Args)
+		if err != nil {
+			return nil, fsterr.RemediationError{
+				Inner:       err,
+				Remediation: "Record the cassette first by running the same command with --record instead of --replay.",
+			}
+		}
+	default:
+		return nil, nil
+	}
+
+	if hc, ok := data.HTTPClient.(*http.Client); ok {
+		hc.Transport = c.Transport(hc.Transport)
+	}
+
+	factory := data.APIClientFactory
+	data.APIClientFactory = func(token, endpoint string, debugMode bool) (api.Interface, error) {
+		client, err := factory(token, endpoint, debugMode)
+		if err != nil {
+			return nil, err
+		}
+		if fc, ok := client.(*fastly.Client); ok {
+			fc.HTTPClient.Transport = c.Transport(fc.HTTPClient.Transport)
+		}
+		return client, nil
+	}
+
+	return c, nil
+}
+
 func configureClients(token, apiEndpoint string, acf global.APIClientFactory, debugMode bool) (apiClient api.Interface, rtsClient api.RealtimeStatsInterface, err error) {
 	apiClient, err = acf(token, apiEndpoint, debugMode)
 	if err != nil {
@@ -600,13 +775,95 @@ func checkForUpdates(av github.AssetVersioner, commandName string, quietMode boo
 	}
 }
 
+// applyProjectFlags appends the default flags of the project file to the
+// arguments, unless the user has provided them.
+//
+// NOTE: Only global flags are supported, as other flags aren't valid for every
+// command. The flags are inserted before any `--` argument terminator.
+func applyProjectFlags(args []string, p project.File) ([]string, error) {
+	if len(p.Flags) == 0 || argparser.ArgsIsHelpJSON(args) {
+		return args, nil
+	}
+
+	// The kingpin model is used to resolve the short form of each global flag.
+	model := configureKingpin(&global.Data{Output: io.Discard}).Model()
+
+	end := len(args)
+	for i, a := range args {
+		if a == "--" {
+			end = i
+			break
+		}
+	}
+
+	var flags []string
+	for _, flag := range p.Flags {
+		name := project.FlagName(flag)
+		f := model.FlagByName(name)
+		if f == nil || !globalFlags[name] || name == "help" {
+			return nil, fsterr.RemediationError{
+				Inner:       fmt.Errorf("unsupported flag '%s' in project file '%s'", flag, p.Path()),
+				Remediation: "Only global flags (see `fastly help`) can be set in the project file.",
+			}
+		}
+		if !flagProvided(args[:end], f, model) {
+			flags = append(flags, flag)
+		}
+	}
+
+	result := make([]string, 0, len(args)+len(flags))
+	result = append(result, args[:end]...)
+	result = append(result, flags...)
+	return append(result, args[end:]...), nil
+}
+
+// flagProvided determines if the flag is in the arguments, in either its long
+// (--name, --name=value, --no-name) or short (-n, -nvalue) form.
+//
+// NOTE: Short boolean flags can be combined (e.g. -vy), up until a flag that
+// takes a value (e.g. -vostaging is -v -o staging).
+func flagProvided(args []string, f *kingpin.ClauseModel, model *kingpin.ApplicationModel) bool {
+	for _, a := range args {
+		switch {
+		case a == "--"+f.Name || strings.HasPrefix(a, "--"+f.Name+"="):
+			return true
+		case f.IsBoolFlag() && a == "--no-"+f.Name:
+			return true
+		case f.Short != 0 && len(a) > 1 && a[0] == '-' && a[1] != '-':
+			for _, r := range a[1:] {
+				if r == f.Short {
+					return true
+				}
+				sf := shortFlag(model, r)
+				if sf == nil || !sf.IsBoolFlag() {
+					break
+				}
+			}
+		}
+	}
+	return false
+}
+
+// shortFlag returns the global flag with the short form (nil if there isn't one).
+func shortFlag(model *kingpin.ApplicationModel, r rune) *kingpin.ClauseModel {
+	for _, f := range model.Flags {
+		if f.Short == r {
+			return f
+		}
+	}
+	return nil
+}
+
 // determineProfile determines if the provided token was acquired via the
 // fastly.toml manifest, the --profile flag, or was a default profile from
 // within the config.toml application configuration.
-func determineProfile(manifestValue, flagValue string, profiles config.Profiles) string {
+func determineProfile(manifestValue, projectValue, flagValue string, profiles config.Profiles) string {
 	if manifestValue != "" {
 		return manifestValue + " -- via fastly.toml"
 	}
+	if projectValue != "" && flagValue == "" {
+		return projectValue + " -- via " + project.Filename
+	}
 	if flagValue != "" {
 		return flagValue
 	}
@@ -624,12 +881,34 @@ func commandCollectsData(command string) bool {
 	return false
 }
 
+// boolFlagSet reports whether the named boolean flag is enabled within args.
+// The flag may be given as --name, --name=<bool> or --no-name, and the last
+// occurrence wins.
+func boolFlagSet(args []string, name string) bool {
+	var set bool
+	for _, arg := range args {
+		switch {
+		case arg == "--"+name:
+			set = true
+		case arg == "--no-"+name:
+			set = false
+		case strings.HasPrefix(arg, "--"+name+"="):
+			v, err := strconv.ParseBool(strings.TrimPrefix(arg, "--"+name+"="))
+			set = err == nil && v
+		}
+	}
+	return set
+}
+
 // commandRequiresToken determines if the command to be executed is one that
 // requires an API token.
-func commandRequiresToken(command string) bool {
+func commandRequiresToken(command string, args []string) bool {
 	switch command {
-	case "compute init", "compute metadata", "compute serve":
+	case "compute init", "compute inspect", "compute metadata", "compute test":
 		return false
+	case "compute serve":
+		// NOTE: The --pull-stores flag reads the linked stores from the API.
+		return boolFlagSet(args, "pull-stores")
 	}
 	command = strings.Split(command, " ")[0]
 	switch command {
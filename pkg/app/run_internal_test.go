@@ -0,0 +1,118 @@
+package app
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/fastly/cli/pkg/project"
+)
+
+func TestCommandRequiresToken(t *testing.T) {
+	tcs := []struct {
+		command  string
+		args     []string
+		expected bool
+	}{
+		{
+			command:  "compute serve",
+			args:     []string{"compute", "serve"},
+			expected: false,
+		},
+		{
+			command:  "compute serve",
+			args:     []string{"compute", "serve", "--pull-stores"},
+			expected: true,
+		},
+		{
+			command:  "compute serve",
+			args:     []string{"compute", "serve", "--pull-stores=true"},
+			expected: true,
+		},
+		{
+			command:  "compute serve",
+			args:     []string{"compute", "serve", "--pull-stores=false"},
+			expected: false,
+		},
+		{
+			command:  "compute serve",
+			args:     []string{"compute", "serve", "--pull-stores", "--no-pull-stores"},
+			expected: false,
+		},
+		{
+			command:  "compute init",
+			args:     []string{"compute", "init"},
+			expected: false,
+		},
+		{
+			command:  "service list",
+			args:     []string{"service", "list"},
+			expected: true,
+		},
+	}
+	for _, tc := range tcs {
+		if got := commandRequiresToken(tc.command, tc.args); got != tc.expected {
+			t.Errorf("commandRequiresToken(%q, %v): want %t, have %t", tc.command, tc.args, tc.expected, got)
+		}
+	}
+}
+
+func TestApplyProjectFlags(t *testing.T) {
+	p := project.File{Flags: []string{"--verbose", "--profile=staging", "--max-retries=5"}}
+	tcs := []struct {
+		name     string
+		args     []string
+		expected []string
+	}{
+		{
+			name:     "not provided",
+			args:     []string{"service", "list"},
+			expected: []string{"service", "list", "--verbose", "--profile=staging", "--max-retries=5"},
+		},
+		{
+			name:     "long form",
+			args:     []string{"service", "list", "--verbose", "--profile", "user"},
+			expected: []string{"service", "list", "--verbose", "--profile", "user", "--max-retries=5"},
+		},
+		{
+			name:     "long form with value",
+			args:     []string{"service", "list", "--max-retries=1"},
+			expected: []string{"service", "list", "--max-retries=1", "--verbose", "--profile=staging"},
+		},
+		{
+			name:     "negated long form",
+			args:     []string{"service", "list", "--no-verbose"},
+			expected: []string{"service", "list", "--no-verbose", "--profile=staging", "--max-retries=5"},
+		},
+		{
+			name:     "short form",
+			args:     []string{"service", "list", "-v", "-o", "user"},
+			expected: []string{"service", "list", "-v", "-o", "user", "--max-retries=5"},
+		},
+		{
+			name:     "combined short form",
+			args:     []string{"service", "list", "-vouser"},
+			expected: []string{"service", "list", "-vouser", "--max-retries=5"},
+		},
+		{
+			name:     "short form value isn't a flag",
+			args:     []string{"service", "list", "-ov"},
+			expected: []string{"service", "list", "-ov", "--verbose", "--max-retries=5"},
+		},
+		{
+			name:     "after the argument terminator",
+			args:     []string{"compute", "serve", "--", "-v"},
+			expected: []string{"compute", "serve", "--verbose", "--profile=staging", "--max-retries=5", "--", "-v"},
+		},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := applyProjectFlags(tc.args, p)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, tc.expected) {
+				t.Errorf("want %q, have %q", tc.expected, got)
+			}
+		})
+	}
+}
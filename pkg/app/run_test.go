@@ -4,13 +4,23 @@ import (
 	"bufio"
 	"bytes"
 	"io"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/fastly/go-fastly/v8/fastly"
+
+	"github.com/fastly/cli/pkg/api"
 	"github.com/fastly/cli/pkg/app"
+	"github.com/fastly/cli/pkg/config"
 	"github.com/fastly/cli/pkg/errors"
 	"github.com/fastly/cli/pkg/global"
+	"github.com/fastly/cli/pkg/mock"
+	"github.com/fastly/cli/pkg/project"
 	"github.com/fastly/cli/pkg/testutil"
 )
 
@@ -69,6 +79,7 @@ config-store
 config-store-entry
 dictionary
 dictionary-entry
+director
 domain
 healthcheck
 install
@@ -77,6 +88,7 @@ kv-store
 kv-store-entry
 log-tail
 logging
+pool
 pops
 products
 profile
@@ -152,3 +164,203 @@ func stripTrailingSpace(str string) string {
 	}
 	return buf.String()
 }
+
+func TestRecordReplay(t *testing.T) {
+	dir := t.TempDir()
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/service/123/details" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = io.WriteString(w, `{"id":"123","name":"Foo","type":"wasm"}`)
+	}))
+
+	run := func(args []string) (string, error) {
+		var stdout bytes.Buffer
+		app.Init = func(_ []string, _ io.Reader) (*global.Data, error) {
+			opts := testutil.MockGlobalData(args, &stdout)
+			opts.APIClientFactory = func(token, _ string, _ bool) (api.Interface, error) {
+				return fastly.NewClientForEndpoint(token, ts.URL)
+			}
+			return opts, nil
+		}
+		err := app.Run(args, nil)
+		return stdout.String(), err
+	}
+
+	recorded, err := run(testutil.Args("service describe --service-id 123 --token 123 --record " + dir))
+	testutil.AssertNoError(t, err)
+	testutil.AssertStringContains(t, recorded, "Name: Foo")
+
+	// Replaying doesn't require the server (or the same token).
+	ts.Close()
+	replayed, err := run(testutil.Args("service describe --service-id 123 --token 456 --replay " + dir))
+	testutil.AssertNoError(t, err)
+	testutil.AssertString(t, recorded, replayed)
+
+	_, err = run(testutil.Args("service describe --service-id 456 --token 456 --replay " + dir))
+	testutil.AssertErrorContains(t, err, "no cassette recorded for these arguments")
+
+	_, err = run(testutil.Args("service describe --service-id 123 --token 456 --record " + dir + " --replay " + dir))
+	testutil.AssertErrorContains(t, err, "invalid flag combination, --record and --replay")
+}
+
+func TestProjectFile(t *testing.T) {
+	dir := t.TempDir()
+	content := "service_id = \"123\"\napi_endpoint = \"https://api.example.com\"\n"
+	if err := os.WriteFile(filepath.Join(dir, project.Filename), []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	var p project.File
+	if err := p.Read(dir); err != nil {
+		t.Fatal(err)
+	}
+
+	args := testutil.Args("service describe --token 123 --verbose")
+	var stdout bytes.Buffer
+	app.Init = func(_ []string, _ io.Reader) (*global.Data, error) {
+		opts := testutil.MockGlobalData(args, &stdout)
+		opts.Manifest.Project = p
+		// A custom endpoint in the project file must match the CLI config.
+		opts.Config.Fastly.APIEndpoint = "https://api.example.com"
+		opts.APIClientFactory = mock.APIClient(mock.API{
+			GetServiceDetailsFn: func(i *fastly.GetServiceInput) (*fastly.ServiceDetail, error) {
+				return &fastly.ServiceDetail{ID: i.ID, Name: "Foo"}, nil
+			},
+		})
+		return opts, nil
+	}
+	err := app.Run(args, nil)
+	testutil.AssertNoError(t, err)
+	testutil.AssertStringContains(t, stdout.String(), "Project file: "+p.Path())
+	testutil.AssertStringContains(t, stdout.String(), "Fastly API endpoint (via .fastly): https://api.example.com")
+	testutil.AssertStringContains(t, stdout.String(), "Service ID (via .fastly): 123")
+}
+
+func TestProjectFileAndManifest(t *testing.T) {
+	dir := t.TempDir()
+	content := "service_id = \"123\"\nprofile = \"project\"\n"
+	if err := os.WriteFile(filepath.Join(dir, project.Filename), []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	var p project.File
+	if err := p.Read(dir); err != nil {
+		t.Fatal(err)
+	}
+
+	args := testutil.Args("service describe --verbose")
+	var (
+		stdout bytes.Buffer
+		token  string
+	)
+	app.Init = func(_ []string, _ io.Reader) (*global.Data, error) {
+		opts := testutil.MockGlobalData(args, &stdout)
+		opts.Manifest.Project = p
+		opts.Manifest.File.ServiceID = "456"
+		opts.Manifest.File.Profile = "user"
+		opts.Config.Profiles["project"] = &config.Profile{Token: "project-token"}
+		opts.APIClientFactory = func(t, _ string, _ bool) (api.Interface, error) {
+			token = t
+			return mock.API{
+				GetServiceDetailsFn: func(i *fastly.GetServiceInput) (*fastly.ServiceDetail, error) {
+					return &fastly.ServiceDetail{ID: i.ID, Name: "Foo"}, nil
+				},
+			}, nil
+		}
+		return opts, nil
+	}
+	err := app.Run(args, nil)
+	testutil.AssertNoError(t, err)
+
+	// The fastly.toml values take precedence over the .fastly project file,
+	// which may be found in a parent directory.
+	testutil.AssertStringContains(t, stdout.String(), "Service ID (via fastly.toml): 456")
+	testutil.AssertStringContains(t, stdout.String(), "profile: user -- via fastly.toml")
+	testutil.AssertString(t, "mock-token", token)
+}
+
+func TestProjectFileUntrustedEndpoint(t *testing.T) {
+	dir := t.TempDir()
+	content := "api_endpoint = \"https://api.example.com\"\n"
+	if err := os.WriteFile(filepath.Join(dir, project.Filename), []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	var p project.File
+	if err := p.Read(dir); err != nil {
+		t.Fatal(err)
+	}
+
+	args := testutil.Args("service describe --service-id 123 --token 123")
+	var stdout bytes.Buffer
+	app.Init = func(_ []string, _ io.Reader) (*global.Data, error) {
+		opts := testutil.MockGlobalData(args, &stdout)
+		opts.Manifest.Project = p
+		opts.APIClientFactory = mock.APIClient(mock.API{
+			GetServiceDetailsFn: func(_ *fastly.GetServiceInput) (*fastly.ServiceDetail, error) {
+				t.Fatal("unexpected API call")
+				return nil, nil
+			},
+		})
+		return opts, nil
+	}
+	err := app.Run(args, nil)
+	testutil.AssertErrorContains(t, err, "the api_endpoint 'https://api.example.com' in project file '"+p.Path()+"' isn't trusted")
+}
+
+func TestTokenExpiryWarning(t *testing.T) {
+	for _, testcase := range []struct {
+		name           string
+		expires        time.Duration
+		warningDays    int
+		wantOutput     string
+		dontWantOutput string
+	}{
+		{
+			name:       "expires soon",
+			expires:    48 * time.Hour,
+			wantOutput: "WARNING: The API token of profile 'user' expires at",
+		},
+		{
+			name:       "expired",
+			expires:    -time.Hour,
+			wantOutput: "WARNING: The API token of profile 'user' expired at",
+		},
+		{
+			name:           "expires later",
+			expires:        30 * 24 * time.Hour,
+			dontWantOutput: "WARNING",
+		},
+		{
+			name:           "warning disabled",
+			expires:        48 * time.Hour,
+			warningDays:    -1,
+			dontWantOutput: "WARNING",
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			args := testutil.Args("service describe --service-id 123")
+			var stdout bytes.Buffer
+			app.Init = func(_ []string, _ io.Reader) (*global.Data, error) {
+				opts := testutil.MockGlobalData(args, &stdout)
+				opts.Config.CLI.TokenExpiryWarningDays = testcase.warningDays
+				opts.Config.Profiles["user"].TokenExpiresAt = time.Now().Add(testcase.expires).Unix()
+				opts.APIClientFactory = mock.APIClient(mock.API{
+					GetServiceDetailsFn: func(i *fastly.GetServiceInput) (*fastly.ServiceDetail, error) {
+						return &fastly.ServiceDetail{ID: i.ID, Name: "Foo"}, nil
+					},
+				})
+				return opts, nil
+			}
+			err := app.Run(args, nil)
+			testutil.AssertNoError(t, err)
+			if testcase.wantOutput != "" {
+				testutil.AssertStringContains(t, stdout.String(), testcase.wantOutput)
+			}
+			if testcase.dontWantOutput != "" {
+				testutil.AssertStringDoesntContain(t, stdout.String(), testcase.dontWantOutput)
+			}
+		})
+	}
+}
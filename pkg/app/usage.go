@@ -149,12 +149,16 @@ var globalFlags = map[string]bool{
 	"account":         true,
 	"auto-yes":        true,
 	"debug-mode":      true,
+	"dry-run":         true,
 	"enable-sso":      true,
 	"endpoint":        true,
 	"help":            true,
+	"max-retries":     true,
 	"non-interactive": true,
 	"profile":         true,
 	"quiet":           true,
+	"record":          true,
+	"replay":          true,
 	"token":           true,
 	"verbose":         true,
 }
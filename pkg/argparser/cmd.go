@@ -12,6 +12,7 @@ import (
 	fsterr "github.com/fastly/cli/pkg/errors"
 	"github.com/fastly/cli/pkg/global"
 	"github.com/fastly/cli/pkg/manifest"
+	"github.com/fastly/cli/pkg/project"
 	"github.com/fastly/cli/pkg/text"
 )
 
@@ -187,6 +188,8 @@ func DisplayServiceID(sid, flag string, s manifest.Source, out io.Writer) {
 		via = fmt.Sprintf(" (via %s)", manifest.Filename)
 	case manifest.SourceEnv:
 		via = fmt.Sprintf(" (via %s)", env.ServiceID)
+	case manifest.SourceProject:
+		via = fmt.Sprintf(" (via %s)", project.Filename)
 	case manifest.SourceUndefined:
 		via = " (not provided)"
 	}
@@ -267,14 +270,18 @@ func IsGlobalFlagsOnly(args []string) bool {
 		"--auto-yes":        0,
 		"-y":                0,
 		"--debug-mode":      0,
+		"--dry-run":         0,
 		"--enable-sso":      0,
 		"--help":            0,
+		"--max-retries":     1,
 		"--non-interactive": 0,
 		"-i":                0,
 		"--profile":         1,
 		"-o":                1,
 		"--quiet":           0,
 		"-q":                0,
+		"--record":          1,
+		"--replay":          1,
 		"--token":           1,
 		"-t":                1,
 		"--verbose":         0,
@@ -38,6 +38,8 @@ type WellKnownEndpoints struct {
 	Auth string `json:"authorization_endpoint"`
 	// Certs is the jwks_uri.
 	Certs string `json:"jwks_uri"`
+	// DeviceAuthorization is the device_authorization_endpoint.
+	DeviceAuthorization string `json:"device_authorization_endpoint"`
 	// Token is the token_endpoint.
 	Token string `json:"token_endpoint"`
 }
@@ -47,8 +49,14 @@ type Runner interface {
 	// AuthURL returns a fully qualified authorization_endpoint.
 	// i.e. path + audience + scope + code_challenge etc.
 	AuthURL() (string, error)
+	// DeviceAuthorization calls the device_authorization_endpoint to start the
+	// device authorization flow.
+	DeviceAuthorization() (DeviceCode, error)
 	// GetResult returns the results channel
 	GetResult() chan AuthorizationResult
+	// PollDeviceToken polls the token_endpoint until the user has authorized
+	// the device code.
+	PollDeviceToken(dc DeviceCode) (JWT, error)
 	// RefreshAccessToken constructs and calls the token_endpoint with the
 	// refresh token so we can refresh and return the access token.
 	RefreshAccessToken(refreshToken string) (JWT, error)
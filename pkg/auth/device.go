@@ -0,0 +1,174 @@
+package auth
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// DeviceCodeGrantType is the grant_type used to poll the token_endpoint
+// during the device authorization flow.
+// https://datatracker.ietf.org/doc/html/rfc8628#section-3.4
+const DeviceCodeGrantType = "urn:ietf:params:oauth:grant-type:device_code"
+
+// DefaultDevicePollInterval is the polling interval used when the
+// authorization server doesn't specify one.
+const DefaultDevicePollInterval = 5 * time.Second
+
+// sleep is called between requests to the token_endpoint.
+//
+// NOTE: It's a variable so our test suite can avoid waiting.
+var sleep = time.Sleep
+
+// DeviceCode is the device_authorization_endpoint response.
+type DeviceCode struct {
+	// DeviceCode is the code the CLI exchanges for a JWT.
+	DeviceCode string `json:"device_code"`
+	// ExpiresIn indicates the lifetime (in seconds) of the device and user codes.
+	ExpiresIn int `json:"expires_in"`
+	// Interval indicates how long (in seconds) to wait between polling requests.
+	Interval int `json:"interval"`
+	// UserCode is the code the user enters at the verification URI.
+	UserCode string `json:"user_code"`
+	// VerificationURI is where the user enters the user code.
+	VerificationURI string `json:"verification_uri"`
+	// VerificationURIComplete is the verification URI including the user code.
+	VerificationURIComplete string `json:"verification_uri_complete"`
+}
+
+// deviceTokenError is the token_endpoint error response.
+type deviceTokenError struct {
+	Error       string `json:"error"`
+	Description string `json:"error_description"`
+}
+
+// DeviceAuthorization calls the device_authorization_endpoint to start the
+// device authorization flow, which doesn't require a local server or for the
+// browser to be on the same machine as the CLI.
+func (s *Server) DeviceAuthorization() (DeviceCode, error) {
+	if s.WellKnownEndpoints.DeviceAuthorization == "" {
+		return DeviceCode{}, errors.New("the authorization server doesn't support the device authorization flow")
+	}
+
+	payload := url.Values{
+		"audience":  {s.APIEndpoint},
+		"client_id": {ClientID},
+		"scope":     {"openid"},
+	}
+
+	res, err := s.postForm(s.WellKnownEndpoints.DeviceAuthorization, payload)
+	if err != nil {
+		return DeviceCode{}, err
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return DeviceCode{}, err
+	}
+
+	if res.StatusCode != http.StatusOK {
+		return DeviceCode{}, fmt.Errorf("failed to request a device code (status: %s)", res.Status)
+	}
+
+	var dc DeviceCode
+	err = json.Unmarshal(body, &dc)
+	if err != nil {
+		return DeviceCode{}, err
+	}
+	if dc.DeviceCode == "" || dc.UserCode == "" || dc.VerificationURI == "" {
+		return DeviceCode{}, errors.New("the device authorization response is missing the device code, user code or verification URI")
+	}
+
+	return dc, nil
+}
+
+// PollDeviceToken polls the token_endpoint until the user has authorized the
+// device code, returning a JWT containing the access and refresh tokens.
+//
+// It stops once the user denies the request or the device code expires.
+func (s *Server) PollDeviceToken(dc DeviceCode) (JWT, error) {
+	interval := time.Duration(dc.Interval) * time.Second
+	if interval <= 0 {
+		interval = DefaultDevicePollInterval
+	}
+	var deadline time.Time
+	if dc.ExpiresIn > 0 {
+		deadline = time.Now().Add(time.Duration(dc.ExpiresIn) * time.Second)
+	}
+
+	payload := url.Values{
+		"client_id":   {ClientID},
+		"device_code": {dc.DeviceCode},
+		"grant_type":  {DeviceCodeGrantType},
+	}
+
+	for {
+		if !deadline.IsZero() && time.Now().After(deadline) {
+			return JWT{}, errors.New("the device code expired before it was authorized")
+		}
+		sleep(interval)
+
+		res, err := s.postForm(s.WellKnownEndpoints.Token, payload)
+		if err != nil {
+			return JWT{}, err
+		}
+		body, err := io.ReadAll(res.Body)
+		_ = res.Body.Close()
+		if err != nil {
+			return JWT{}, err
+		}
+
+		if res.StatusCode == http.StatusOK {
+			var j JWT
+			err = json.Unmarshal(body, &j)
+			if err != nil {
+				return JWT{}, err
+			}
+			return j, nil
+		}
+
+		var te deviceTokenError
+		_ = json.Unmarshal(body, &te)
+
+		// https://datatracker.ietf.org/doc/html/rfc8628#section-3.5
+		switch te.Error {
+		case "authorization_pending":
+			continue
+		case "slow_down":
+			interval += 5 * time.Second
+			continue
+		case "access_denied":
+			return JWT{}, errors.New("the authorization request was denied")
+		case "expired_token":
+			return JWT{}, errors.New("the device code expired before it was authorized")
+		case "":
+			return JWT{}, fmt.Errorf("failed to exchange device code for jwt (status: %s)", res.Status)
+		default:
+			if te.Description != "" {
+				return JWT{}, fmt.Errorf("failed to exchange device code for jwt: %s (%s)", te.Error, te.Description)
+			}
+			return JWT{}, fmt.Errorf("failed to exchange device code for jwt: %s", te.Error)
+		}
+	}
+}
+
+// postForm sends a form encoded POST request.
+func (s *Server) postForm(endpoint string, payload url.Values) (*http.Response, error) {
+	req, err := http.NewRequest(http.MethodPost, endpoint, strings.NewReader(payload.Encode()))
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("content-type", "application/x-www-form-urlencoded")
+
+	if s.HTTPClient != nil {
+		return s.HTTPClient.Do(req)
+	}
+	return http.DefaultClient.Do(req)
+}
@@ -0,0 +1,127 @@
+package auth
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestDeviceAuthorization(t *testing.T) {
+	var form map[string]string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = r.ParseForm()
+		form = map[string]string{}
+		for k := range r.PostForm {
+			form[k] = r.PostForm.Get(k)
+		}
+		fmt.Fprint(w, `{"device_code":"dc","user_code":"ABCD-EFGH","verification_uri":"https://example.com/device","expires_in":600,"interval":5}`)
+	}))
+	defer ts.Close()
+
+	s := &Server{
+		APIEndpoint:        "https://api.example.com",
+		HTTPClient:         http.DefaultClient,
+		WellKnownEndpoints: WellKnownEndpoints{DeviceAuthorization: ts.URL},
+	}
+	dc, err := s.DeviceAuthorization()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if dc.DeviceCode != "dc" || dc.UserCode != "ABCD-EFGH" || dc.VerificationURI != "https://example.com/device" || dc.Interval != 5 {
+		t.Errorf("unexpected device code: %+v", dc)
+	}
+	if form["client_id"] != ClientID || form["audience"] != s.APIEndpoint {
+		t.Errorf("unexpected request: %v", form)
+	}
+
+	s.WellKnownEndpoints.DeviceAuthorization = ""
+	if _, err := s.DeviceAuthorization(); err == nil || !strings.Contains(err.Error(), "doesn't support the device authorization flow") {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestPollDeviceToken(t *testing.T) {
+	var slept []time.Duration
+	sleep = func(d time.Duration) {
+		slept = append(slept, d)
+	}
+	defer func() {
+		sleep = time.Sleep
+	}()
+
+	scenarios := []struct {
+		name      string
+		responses []string
+		wantError string
+		wantSleep []time.Duration
+	}{
+		{
+			name:      "authorized after pending and slow_down",
+			responses: []string{`{"error":"authorization_pending"}`, `{"error":"slow_down"}`, `{"access_token":"at","refresh_token":"rt"}`},
+			wantSleep: []time.Duration{time.Second, time.Second, 6 * time.Second},
+		},
+		{
+			name:      "access denied",
+			responses: []string{`{"error":"access_denied"}`},
+			wantError: "the authorization request was denied",
+			wantSleep: []time.Duration{time.Second},
+		},
+		{
+			name:      "expired token",
+			responses: []string{`{"error":"authorization_pending"}`, `{"error":"expired_token"}`},
+			wantError: "the device code expired before it was authorized",
+			wantSleep: []time.Duration{time.Second, time.Second},
+		},
+		{
+			name:      "unexpected error",
+			responses: []string{`{"error":"invalid_client","error_description":"device grant disabled"}`},
+			wantError: "invalid_client (device grant disabled)",
+			wantSleep: []time.Duration{time.Second},
+		},
+	}
+
+	for _, testcase := range scenarios {
+		testcase := testcase
+		t.Run(testcase.name, func(t *testing.T) {
+			slept = nil
+			var requests int
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.ParseForm()
+				if r.PostForm.Get("grant_type") != DeviceCodeGrantType || r.PostForm.Get("device_code") != "dc" {
+					t.Errorf("unexpected request: %v", r.PostForm)
+				}
+				body := testcase.responses[requests]
+				requests++
+				if strings.Contains(body, `"error"`) {
+					w.WriteHeader(http.StatusBadRequest)
+				}
+				fmt.Fprint(w, body)
+			}))
+			defer ts.Close()
+
+			s := &Server{
+				HTTPClient:         http.DefaultClient,
+				WellKnownEndpoints: WellKnownEndpoints{Token: ts.URL},
+			}
+			j, err := s.PollDeviceToken(DeviceCode{DeviceCode: "dc", ExpiresIn: 600, Interval: 1})
+			if testcase.wantError != "" {
+				if err == nil || !strings.Contains(err.Error(), testcase.wantError) {
+					t.Errorf("want error %q, got %v", testcase.wantError, err)
+				}
+			} else {
+				if err != nil {
+					t.Fatal(err)
+				}
+				if j.AccessToken != "at" || j.RefreshToken != "rt" {
+					t.Errorf("unexpected jwt: %+v", j)
+				}
+			}
+			if fmt.Sprint(slept) != fmt.Sprint(testcase.wantSleep) {
+				t.Errorf("want sleeps %v, got %v", testcase.wantSleep, slept)
+			}
+		})
+	}
+}
@@ -5,10 +5,13 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/fastly/go-fastly/v8/fastly"
 
+	"github.com/fastly/cli/pkg/api"
 	"github.com/fastly/cli/pkg/app"
 	"github.com/fastly/cli/pkg/global"
 	"github.com/fastly/cli/pkg/mock"
@@ -420,3 +423,149 @@ func listTokenOutputSummary(env bool) string {
 Foo   123       456      purge_all global:read  a, b
 Bar   456       789      global                 a, b`, msg)
 }
+
+func TestAuthTokenRotate(t *testing.T) {
+	created := testutil.Date
+	expires := created.Add(24 * time.Hour)
+	oldToken := &fastly.Token{
+		CreatedAt: &created,
+		ExpiresAt: &expires,
+		ID:        "old",
+		Name:      "ci",
+		Scope:     fastly.PurgeAllScope,
+		Services:  []string{"a", "b"},
+	}
+	newToken := &fastly.Token{
+		AccessToken: "new-token",
+		ID:          "new",
+		Name:        "ci",
+		Scope:       fastly.PurgeAllScope,
+	}
+
+	args := testutil.Args
+	scenarios := []struct {
+		name          string
+		args          []string
+		createErr     error
+		deleteSelfErr error
+		verifyID      string
+		wantError     string
+		wantOutput    string
+		wantToken     string
+		wantDeleted   string
+		wantNoWrite   bool
+	}{
+		{
+			name:      "validate missing --password flag",
+			args:      args("auth-token rotate"),
+			wantError: "error parsing arguments: required flag --password not provided",
+		},
+		{
+			name:      "validate --token can't be rotated",
+			args:      args("auth-token rotate --password secure --token 123"),
+			wantError: "only the token of a profile can be rotated",
+		},
+		{
+			name:      "validate CreateToken API error",
+			args:      args("auth-token rotate --password secure"),
+			createErr: testutil.Err,
+			wantError: "error creating the new token: test error",
+		},
+		{
+			name:        "validate rotation",
+			args:        args("auth-token rotate --password secure"),
+			verifyID:    "new",
+			wantOutput:  "Rotated the token of profile 'user' (name: ci, id: new, scope: purge_all, expires: never)",
+			wantToken:   "new-token",
+			wantDeleted: "old",
+		},
+		{
+			name:        "validate rollback when the new token can't be verified",
+			args:        args("auth-token rotate --password secure"),
+			verifyID:    "other",
+			wantError:   "error verifying the new token",
+			wantToken:   "mock-token",
+			wantDeleted: "new",
+		},
+		{
+			name:        "validate --dry-run prints the plan without changing the profile",
+			args:        args("auth-token rotate --password secure --dry-run"),
+			wantOutput:  "[dry-run] CreateToken (name: ci, scope: purge_all, services: a, b, expires: ",
+			wantNoWrite: true,
+		},
+		{
+			name:          "validate failure to revoke the old token",
+			args:          args("auth-token rotate --password secure"),
+			deleteSelfErr: testutil.Err,
+			verifyID:      "new",
+			wantError:     "the old token (id: old) couldn't be revoked",
+			wantToken:     "new-token",
+		},
+	}
+
+	for _, testcase := range scenarios {
+		testcase := testcase
+		t.Run(testcase.name, func(t *testing.T) {
+			configPath := filepath.Join(t.TempDir(), "config.toml")
+
+			var (
+				deleted string
+				stdout  bytes.Buffer
+			)
+			opts := testutil.MockGlobalData(testcase.args, &stdout)
+			opts.ConfigPath = configPath
+			opts.APIClientFactory = func(token, _ string, _ bool) (api.Interface, error) {
+				return mock.API{
+					CreateTokenFn: func(i *fastly.CreateTokenInput) (*fastly.Token, error) {
+						if i.Name != oldToken.Name || i.Scope != oldToken.Scope || len(i.Services) != 2 || i.Password != "secure" {
+							t.Errorf("unexpected input: %+v", i)
+						}
+						if i.ExpiresAt == nil || i.ExpiresAt.Before(time.Now()) {
+							t.Errorf("expected the new token to expire after 24 hours: %v", i.ExpiresAt)
+						}
+						return newToken, testcase.createErr
+					},
+					DeleteTokenFn: func(i *fastly.DeleteTokenInput) error {
+						deleted = i.TokenID
+						return nil
+					},
+					DeleteTokenSelfFn: func() error {
+						if testcase.deleteSelfErr == nil {
+							deleted = oldToken.ID
+						}
+						return testcase.deleteSelfErr
+					},
+					GetTokenSelfFn: func() (*fastly.Token, error) {
+						if token == newToken.AccessToken {
+							return &fastly.Token{ID: testcase.verifyID}, nil
+						}
+						return oldToken, nil
+					},
+				}, nil
+			}
+			app.Init = func(_ []string, _ io.Reader) (*global.Data, error) {
+				return opts, nil
+			}
+			err := app.Run(testcase.args, nil)
+			testutil.AssertErrorContains(t, err, testcase.wantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.wantOutput)
+			if testcase.wantToken != "" {
+				testutil.AssertString(t, testcase.wantToken, opts.Config.Profiles["user"].Token)
+				data, err := os.ReadFile(configPath)
+				if err != nil {
+					t.Fatal(err)
+				}
+				testutil.AssertStringContains(t, string(data), fmt.Sprintf("token = %q", testcase.wantToken))
+			}
+			if testcase.wantNoWrite {
+				testutil.AssertString(t, "mock-token", opts.Config.Profiles["user"].Token)
+				if _, err := os.Stat(configPath); !os.IsNotExist(err) {
+					t.Errorf("expected the config not to be written: %v", err)
+				}
+				testutil.AssertStringContains(t, stdout.String(), "[dry-run] Save the new token to the profile 'user' ("+configPath+")")
+				testutil.AssertStringContains(t, stdout.String(), "[dry-run] DeleteTokenSelf (id: old)")
+			}
+			testutil.AssertString(t, testcase.wantDeleted, deleted)
+		})
+	}
+}
@@ -0,0 +1,196 @@
+package authtoken
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/fastly/go-fastly/v8/fastly"
+
+	"github.com/fastly/cli/pkg/argparser"
+	fsterr "github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/global"
+	"github.com/fastly/cli/pkg/lookup"
+	"github.com/fastly/cli/pkg/profile"
+	"github.com/fastly/cli/pkg/text"
+)
+
+// NewRotateCommand returns a usable command registered under the parent.
+func NewRotateCommand(parent argparser.Registerer, g *global.Data) *RotateCommand {
+	c := RotateCommand{
+		Base: argparser.Base{
+			Globals: g,
+		},
+	}
+	c.CmdClause = parent.Command("rotate", "Replace the API token of a profile with a new token (with the same name, scope and services) and revoke the old token")
+
+	// Required.
+	//
+	// NOTE: The new token is created using the same `/sudo` flow as the create
+	// command, which requires the password of the user account.
+	c.CmdClause.Flag("password", "User password corresponding with the profile token").Required().StringVar(&c.password)
+
+	// Optional.
+	c.CmdClause.Flag("expires", "Time-stamp (UTC) of when the new token will expire (default: the lifetime of the old token, if it expires)").HintOptions("2016-07-28T19:24:50+00:00").TimeVar(time.RFC3339, &c.expires)
+	return &c
+}
+
+// RotateCommand calls the Fastly API to replace the token of a profile.
+type RotateCommand struct {
+	argparser.Base
+
+	expires  time.Time
+	password string
+}
+
+// Exec invokes the application logic for the command.
+//
+// The token is rotated in the following order, so that the profile always
+// holds a working token:
+//
+//  1. Create a new token with the same name, scope and services.
+//  2. Write the new token into the profile.
+//  3. Verify the new token works.
+//  4. Revoke the old token.
+//
+// If the new token can't be persisted or verified, then the profile is
+// restored and the new token is revoked. With --dry-run the steps are only
+// printed (see printPlan).
+func (c *RotateCommand) Exec(_ io.Reader, out io.Writer) error {
+	if _, source := c.Globals.Token(); source != lookup.SourceFile {
+		return fsterr.RemediationError{
+			Inner:       errors.New("only the token of a profile can be rotated"),
+			Remediation: "Remove the --token flag (or the FASTLY_API_TOKEN environment variable) and select the profile using --profile.",
+		}
+	}
+	profileName, p := c.Globals.Profile()
+	if p.AccessToken != "" {
+		return fsterr.RemediationError{
+			Inner:       fmt.Errorf("the profile '%s' uses an SSO-based token, which can't be rotated", profileName),
+			Remediation: "Run `fastly sso` to reauthenticate.",
+		}
+	}
+
+	old, err := c.Globals.APIClient.GetTokenSelf()
+	if err != nil {
+		c.Globals.ErrLog.Add(err)
+		return fmt.Errorf("error retrieving the current token: %w", err)
+	}
+
+	input := c.constructInput(old, time.Now())
+	if c.Globals.Flags.DryRun {
+		c.printPlan(profileName, old, input, out)
+		return nil
+	}
+
+	r, err := c.Globals.APIClient.CreateToken(input)
+	if err != nil {
+		c.Globals.ErrLog.Add(err)
+		return fmt.Errorf("error creating the new token: %w", err)
+	}
+
+	oldToken, oldExpiresAt := p.Token, p.TokenExpiresAt
+	p.Token = r.AccessToken
+	p.TokenExpiresAt = profile.TokenExpiry(r)
+	rollback := func(cause error) error {
+		p.Token, p.TokenExpiresAt = oldToken, oldExpiresAt
+		if err := c.Globals.Config.Write(c.Globals.ConfigPath); err != nil {
+			c.Globals.ErrLog.Add(err)
+			return fmt.Errorf("%w (and failed to restore the profile '%s': %v)", cause, profileName, err)
+		}
+		if err := c.Globals.APIClient.DeleteToken(&fastly.DeleteTokenInput{TokenID: r.ID}); err != nil {
+			c.Globals.ErrLog.Add(err)
+			return fsterr.RemediationError{
+				Inner:       fmt.Errorf("%w (and failed to revoke the new token: %v)", cause, err),
+				Remediation: fmt.Sprintf("Revoke the new token using `fastly auth-token delete --id %s`.", r.ID),
+			}
+		}
+		return cause
+	}
+
+	if err := c.Globals.Config.Write(c.Globals.ConfigPath); err != nil {
+		c.Globals.ErrLog.Add(err)
+		return rollback(fmt.Errorf("error saving the new token to the profile '%s': %w", profileName, err))
+	}
+
+	if err := c.verify(r); err != nil {
+		c.Globals.ErrLog.Add(err)
+		return rollback(fmt.Errorf("error verifying the new token: %w", err))
+	}
+
+	// NOTE: The new token is in place, so a failure to revoke the old token
+	// doesn't roll back the rotation.
+	if err := c.Globals.APIClient.DeleteTokenSelf(); err != nil {
+		c.Globals.ErrLog.Add(err)
+		return fsterr.RemediationError{
+			Inner:       fmt.Errorf("the profile '%s' was updated with the new token but the old token (id: %s) couldn't be revoked: %w", profileName, old.ID, err),
+			Remediation: fmt.Sprintf("Revoke the old token using `fastly auth-token delete --id %s`.", old.ID),
+		}
+	}
+
+	expires := "never"
+	if r.ExpiresAt != nil {
+		expires = r.ExpiresAt.String()
+	}
+	text.Success(out, "Rotated the token of profile '%s' (name: %s, id: %s, scope: %s, expires: %s)", profileName, r.Name, r.ID, r.Scope, expires)
+	return nil
+}
+
+// printPlan describes the rotation that would happen without --dry-run.
+//
+// NOTE: The dry-run API client would return a fake token (with no AccessToken)
+// so the rotation stops here, before the profile is updated or the new token
+// is verified.
+func (c *RotateCommand) printPlan(profileName string, old *fastly.Token, input *fastly.CreateTokenInput, out io.Writer) {
+	expires := "never"
+	if input.ExpiresAt != nil {
+		expires = input.ExpiresAt.String()
+	}
+	services := "all"
+	if len(input.Services) > 0 {
+		services = strings.Join(input.Services, ", ")
+	}
+	text.Output(out, "[dry-run] CreateToken (name: %s, scope: %s, services: %s, expires: %s)", input.Name, input.Scope, services, expires)
+	text.Output(out, "[dry-run] Save the new token to the profile '%s' (%s)", profileName, c.Globals.ConfigPath)
+	text.Output(out, "[dry-run] DeleteTokenSelf (id: %s)", old.ID)
+}
+
+// constructInput transforms the details of the old token into an object to be
+// used by the API client library to create the new token.
+func (c *RotateCommand) constructInput(old *fastly.Token, now time.Time) *fastly.CreateTokenInput {
+	input := fastly.CreateTokenInput{
+		Name:     old.Name,
+		Password: c.password,
+		Scope:    old.Scope,
+		Services: old.Services,
+	}
+
+	switch {
+	case !c.expires.IsZero():
+		input.ExpiresAt = &c.expires
+	case old.ExpiresAt != nil && old.CreatedAt != nil:
+		expires := now.Add(old.ExpiresAt.Sub(*old.CreatedAt)).UTC()
+		input.ExpiresAt = &expires
+	}
+
+	return &input
+}
+
+// verify checks the new token can be used to authenticate API requests.
+func (c *RotateCommand) verify(t *fastly.Token) error {
+	endpoint, _ := c.Globals.APIEndpoint()
+	client, err := c.Globals.APIClientFactory(t.AccessToken, endpoint, c.Globals.Flags.Debug)
+	if err != nil {
+		return fmt.Errorf("error regenerating Fastly API client: %w", err)
+	}
+	self, err := client.GetTokenSelf()
+	if err != nil {
+		return err
+	}
+	if self.ID != t.ID {
+		return fmt.Errorf("the API identified the token as '%s' rather than '%s'", self.ID, t.ID)
+	}
+	return nil
+}
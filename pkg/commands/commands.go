@@ -14,6 +14,7 @@ import (
 	"github.com/fastly/cli/pkg/commands/configstoreentry"
 	"github.com/fastly/cli/pkg/commands/dictionary"
 	"github.com/fastly/cli/pkg/commands/dictionaryentry"
+	"github.com/fastly/cli/pkg/commands/director"
 	"github.com/fastly/cli/pkg/commands/domain"
 	"github.com/fastly/cli/pkg/commands/healthcheck"
 	"github.com/fastly/cli/pkg/commands/install"
@@ -48,6 +49,8 @@ import (
 	"github.com/fastly/cli/pkg/commands/logging/sumologic"
 	"github.com/fastly/cli/pkg/commands/logging/syslog"
 	"github.com/fastly/cli/pkg/commands/logtail"
+	"github.com/fastly/cli/pkg/commands/pool"
+	poolserver "github.com/fastly/cli/pkg/commands/pool/server"
 	"github.com/fastly/cli/pkg/commands/pop"
 	"github.com/fastly/cli/pkg/commands/products"
 	"github.com/fastly/cli/pkg/commands/profile"
@@ -73,8 +76,13 @@ import (
 	"github.com/fastly/cli/pkg/commands/update"
 	"github.com/fastly/cli/pkg/commands/user"
 	"github.com/fastly/cli/pkg/commands/vcl"
+	"github.com/fastly/cli/pkg/commands/vcl/cachesetting"
 	"github.com/fastly/cli/pkg/commands/vcl/condition"
 	"github.com/fastly/cli/pkg/commands/vcl/custom"
+	"github.com/fastly/cli/pkg/commands/vcl/gzip"
+	"github.com/fastly/cli/pkg/commands/vcl/header"
+	"github.com/fastly/cli/pkg/commands/vcl/requestsetting"
+	"github.com/fastly/cli/pkg/commands/vcl/responseobject"
 	"github.com/fastly/cli/pkg/commands/vcl/snippet"
 	"github.com/fastly/cli/pkg/commands/version"
 	"github.com/fastly/cli/pkg/commands/whoami"
@@ -113,6 +121,7 @@ func Define(
 	authtokenDelete := authtoken.NewDeleteCommand(authtokenCmdRoot.CmdClause, data)
 	authtokenDescribe := authtoken.NewDescribeCommand(authtokenCmdRoot.CmdClause, data)
 	authtokenList := authtoken.NewListCommand(authtokenCmdRoot.CmdClause, data)
+	authtokenRotate := authtoken.NewRotateCommand(authtokenCmdRoot.CmdClause, data)
 	backendCmdRoot := backend.NewRootCommand(app, data)
 	backendCreate := backend.NewCreateCommand(backendCmdRoot.CmdClause, data)
 	backendDelete := backend.NewDeleteCommand(backendCmdRoot.CmdClause, data)
@@ -125,10 +134,15 @@ func Define(
 	computeHashFiles := compute.NewHashFilesCommand(computeCmdRoot.CmdClause, data, computeBuild)
 	computeHashsum := compute.NewHashsumCommand(computeCmdRoot.CmdClause, data, computeBuild)
 	computeInit := compute.NewInitCommand(computeCmdRoot.CmdClause, data)
+	computeInspect := compute.NewInspectCommand(computeCmdRoot.CmdClause, data)
 	computeMetadata := compute.NewMetadataCommand(computeCmdRoot.CmdClause, data)
 	computePack := compute.NewPackCommand(computeCmdRoot.CmdClause, data)
 	computePublish := compute.NewPublishCommand(computeCmdRoot.CmdClause, data, computeBuild, computeDeploy)
+	computeRollback := compute.NewRollbackCommand(computeCmdRoot.CmdClause, data)
 	computeServe := compute.NewServeCommand(computeCmdRoot.CmdClause, data, computeBuild)
+	computeSetupCmdRoot := compute.NewSetupRootCommand(computeCmdRoot.CmdClause, data)
+	computeSetupCheck := compute.NewSetupCheckCommand(computeSetupCmdRoot.CmdClause, data)
+	computeTest := compute.NewTestCommand(computeCmdRoot.CmdClause, data, computeBuild, computeServe)
 	computeUpdate := compute.NewUpdateCommand(computeCmdRoot.CmdClause, data)
 	computeValidate := compute.NewValidateCommand(computeCmdRoot.CmdClause, data)
 	configCmdRoot := config.NewRootCommand(app, data)
@@ -157,6 +171,14 @@ func Define(
 	dictionaryEntryUpdate := dictionaryentry.NewUpdateCommand(dictionaryEntryCmdRoot.CmdClause, data)
 	dictionaryList := dictionary.NewListCommand(dictionaryCmdRoot.CmdClause, data)
 	dictionaryUpdate := dictionary.NewUpdateCommand(dictionaryCmdRoot.CmdClause, data)
+	directorCmdRoot := director.NewRootCommand(app, data)
+	directorAddBackend := director.NewAddBackendCommand(directorCmdRoot.CmdClause, data)
+	directorCreate := director.NewCreateCommand(directorCmdRoot.CmdClause, data)
+	directorDelete := director.NewDeleteCommand(directorCmdRoot.CmdClause, data)
+	directorDescribe := director.NewDescribeCommand(directorCmdRoot.CmdClause, data)
+	directorList := director.NewListCommand(directorCmdRoot.CmdClause, data)
+	directorRemoveBackend := director.NewRemoveBackendCommand(directorCmdRoot.CmdClause, data)
+	directorUpdate := director.NewUpdateCommand(directorCmdRoot.CmdClause, data)
 	domainCmdRoot := domain.NewRootCommand(app, data)
 	domainCreate := domain.NewCreateCommand(domainCmdRoot.CmdClause, data)
 	domainDelete := domain.NewDeleteCommand(domainCmdRoot.CmdClause, data)
@@ -340,6 +362,18 @@ func Define(
 	loggingSyslogDescribe := syslog.NewDescribeCommand(loggingSyslogCmdRoot.CmdClause, data)
 	loggingSyslogList := syslog.NewListCommand(loggingSyslogCmdRoot.CmdClause, data)
 	loggingSyslogUpdate := syslog.NewUpdateCommand(loggingSyslogCmdRoot.CmdClause, data)
+	poolCmdRoot := pool.NewRootCommand(app, data)
+	poolCreate := pool.NewCreateCommand(poolCmdRoot.CmdClause, data)
+	poolDelete := pool.NewDeleteCommand(poolCmdRoot.CmdClause, data)
+	poolDescribe := pool.NewDescribeCommand(poolCmdRoot.CmdClause, data)
+	poolList := pool.NewListCommand(poolCmdRoot.CmdClause, data)
+	poolUpdate := pool.NewUpdateCommand(poolCmdRoot.CmdClause, data)
+	poolServerCmdRoot := poolserver.NewRootCommand(poolCmdRoot.CmdClause, data)
+	poolServerCreate := poolserver.NewCreateCommand(poolServerCmdRoot.CmdClause, data)
+	poolServerDelete := poolserver.NewDeleteCommand(poolServerCmdRoot.CmdClause, data)
+	poolServerDescribe := poolserver.NewDescribeCommand(poolServerCmdRoot.CmdClause, data)
+	poolServerList := poolserver.NewListCommand(poolServerCmdRoot.CmdClause, data)
+	poolServerUpdate := poolserver.NewUpdateCommand(poolServerCmdRoot.CmdClause, data)
 	popCmdRoot := pop.NewRootCommand(app, data)
 	productsCmdRoot := products.NewRootCommand(app, data)
 	profileCmdRoot := profile.NewRootCommand(app, data)
@@ -376,6 +410,8 @@ func Define(
 	serviceCreate := service.NewCreateCommand(serviceCmdRoot.CmdClause, data)
 	serviceDelete := service.NewDeleteCommand(serviceCmdRoot.CmdClause, data)
 	serviceDescribe := service.NewDescribeCommand(serviceCmdRoot.CmdClause, data)
+	serviceExport := service.NewExportCommand(serviceCmdRoot.CmdClause, data)
+	serviceApply := service.NewApplyCommand(serviceCmdRoot.CmdClause, data)
 	serviceList := service.NewListCommand(serviceCmdRoot.CmdClause, data)
 	serviceSearch := service.NewSearchCommand(serviceCmdRoot.CmdClause, data)
 	serviceUpdate := service.NewUpdateCommand(serviceCmdRoot.CmdClause, data)
@@ -389,6 +425,7 @@ func Define(
 	serviceVersionActivate := serviceversion.NewActivateCommand(serviceVersionCmdRoot.CmdClause, data)
 	serviceVersionClone := serviceversion.NewCloneCommand(serviceVersionCmdRoot.CmdClause, data)
 	serviceVersionDeactivate := serviceversion.NewDeactivateCommand(serviceVersionCmdRoot.CmdClause, data)
+	serviceVersionDiff := serviceversion.NewDiffCommand(serviceVersionCmdRoot.CmdClause, data)
 	serviceVersionList := serviceversion.NewListCommand(serviceVersionCmdRoot.CmdClause, data)
 	serviceVersionLock := serviceversion.NewLockCommand(serviceVersionCmdRoot.CmdClause, data)
 	serviceVersionUpdate := serviceversion.NewUpdateCommand(serviceVersionCmdRoot.CmdClause, data)
@@ -440,6 +477,12 @@ func Define(
 	userList := user.NewListCommand(userCmdRoot.CmdClause, data)
 	userUpdate := user.NewUpdateCommand(userCmdRoot.CmdClause, data)
 	vclCmdRoot := vcl.NewRootCommand(app, data)
+	vclCacheSettingCmdRoot := cachesetting.NewRootCommand(vclCmdRoot.CmdClause, data)
+	vclCacheSettingCreate := cachesetting.NewCreateCommand(vclCacheSettingCmdRoot.CmdClause, data)
+	vclCacheSettingDelete := cachesetting.NewDeleteCommand(vclCacheSettingCmdRoot.CmdClause, data)
+	vclCacheSettingDescribe := cachesetting.NewDescribeCommand(vclCacheSettingCmdRoot.CmdClause, data)
+	vclCacheSettingList := cachesetting.NewListCommand(vclCacheSettingCmdRoot.CmdClause, data)
+	vclCacheSettingUpdate := cachesetting.NewUpdateCommand(vclCacheSettingCmdRoot.CmdClause, data)
 	vclConditionCmdRoot := condition.NewRootCommand(vclCmdRoot.CmdClause, data)
 	vclConditionCreate := condition.NewCreateCommand(vclConditionCmdRoot.CmdClause, data)
 	vclConditionDelete := condition.NewDeleteCommand(vclConditionCmdRoot.CmdClause, data)
@@ -452,6 +495,30 @@ func Define(
 	vclCustomDescribe := custom.NewDescribeCommand(vclCustomCmdRoot.CmdClause, data)
 	vclCustomList := custom.NewListCommand(vclCustomCmdRoot.CmdClause, data)
 	vclCustomUpdate := custom.NewUpdateCommand(vclCustomCmdRoot.CmdClause, data)
+	vclGzipCmdRoot := gzip.NewRootCommand(vclCmdRoot.CmdClause, data)
+	vclGzipCreate := gzip.NewCreateCommand(vclGzipCmdRoot.CmdClause, data)
+	vclGzipDelete := gzip.NewDeleteCommand(vclGzipCmdRoot.CmdClause, data)
+	vclGzipDescribe := gzip.NewDescribeCommand(vclGzipCmdRoot.CmdClause, data)
+	vclGzipList := gzip.NewListCommand(vclGzipCmdRoot.CmdClause, data)
+	vclGzipUpdate := gzip.NewUpdateCommand(vclGzipCmdRoot.CmdClause, data)
+	vclHeaderCmdRoot := header.NewRootCommand(vclCmdRoot.CmdClause, data)
+	vclHeaderCreate := header.NewCreateCommand(vclHeaderCmdRoot.CmdClause, data)
+	vclHeaderDelete := header.NewDeleteCommand(vclHeaderCmdRoot.CmdClause, data)
+	vclHeaderDescribe := header.NewDescribeCommand(vclHeaderCmdRoot.CmdClause, data)
+	vclHeaderList := header.NewListCommand(vclHeaderCmdRoot.CmdClause, data)
+	vclHeaderUpdate := header.NewUpdateCommand(vclHeaderCmdRoot.CmdClause, data)
+	vclRequestSettingCmdRoot := requestsetting.NewRootCommand(vclCmdRoot.CmdClause, data)
+	vclRequestSettingCreate := requestsetting.NewCreateCommand(vclRequestSettingCmdRoot.CmdClause, data)
+	vclRequestSettingDelete := requestsetting.NewDeleteCommand(vclRequestSettingCmdRoot.CmdClause, data)
+	vclRequestSettingDescribe := requestsetting.NewDescribeCommand(vclRequestSettingCmdRoot.CmdClause, data)
+	vclRequestSettingList := requestsetting.NewListCommand(vclRequestSettingCmdRoot.CmdClause, data)
+	vclRequestSettingUpdate := requestsetting.NewUpdateCommand(vclRequestSettingCmdRoot.CmdClause, data)
+	vclResponseObjectCmdRoot := responseobject.NewRootCommand(vclCmdRoot.CmdClause, data)
+	vclResponseObjectCreate := responseobject.NewCreateCommand(vclResponseObjectCmdRoot.CmdClause, data)
+	vclResponseObjectDelete := responseobject.NewDeleteCommand(vclResponseObjectCmdRoot.CmdClause, data)
+	vclResponseObjectDescribe := responseobject.NewDescribeCommand(vclResponseObjectCmdRoot.CmdClause, data)
+	vclResponseObjectList := responseobject.NewListCommand(vclResponseObjectCmdRoot.CmdClause, data)
+	vclResponseObjectUpdate := responseobject.NewUpdateCommand(vclResponseObjectCmdRoot.CmdClause, data)
 	vclSnippetCmdRoot := snippet.NewRootCommand(vclCmdRoot.CmdClause, data)
 	vclSnippetCreate := snippet.NewCreateCommand(vclSnippetCmdRoot.CmdClause, data)
 	vclSnippetDelete := snippet.NewDeleteCommand(vclSnippetCmdRoot.CmdClause, data)
@@ -480,6 +547,7 @@ func Define(
 		authtokenDelete,
 		authtokenDescribe,
 		authtokenList,
+		authtokenRotate,
 		backendCmdRoot,
 		backendCreate,
 		backendDelete,
@@ -492,10 +560,15 @@ func Define(
 		computeHashFiles,
 		computeHashsum,
 		computeInit,
+		computeInspect,
 		computeMetadata,
 		computePack,
 		computePublish,
+		computeRollback,
 		computeServe,
+		computeSetupCmdRoot,
+		computeSetupCheck,
+		computeTest,
 		computeUpdate,
 		computeValidate,
 		configCmdRoot,
@@ -524,6 +597,14 @@ func Define(
 		dictionaryEntryUpdate,
 		dictionaryList,
 		dictionaryUpdate,
+		directorAddBackend,
+		directorCmdRoot,
+		directorCreate,
+		directorDelete,
+		directorDescribe,
+		directorList,
+		directorRemoveBackend,
+		directorUpdate,
 		domainCmdRoot,
 		domainCreate,
 		domainDelete,
@@ -705,6 +786,18 @@ func Define(
 		loggingSyslogDescribe,
 		loggingSyslogList,
 		loggingSyslogUpdate,
+		poolCmdRoot,
+		poolCreate,
+		poolDelete,
+		poolDescribe,
+		poolList,
+		poolServerCmdRoot,
+		poolServerCreate,
+		poolServerDelete,
+		poolServerDescribe,
+		poolServerList,
+		poolServerUpdate,
+		poolUpdate,
 		popCmdRoot,
 		productsCmdRoot,
 		profileCmdRoot,
@@ -739,6 +832,8 @@ func Define(
 		serviceCreate,
 		serviceDelete,
 		serviceDescribe,
+		serviceExport,
+		serviceApply,
 		serviceList,
 		serviceSearch,
 		serviceUpdate,
@@ -752,6 +847,7 @@ func Define(
 		serviceVersionClone,
 		serviceVersionCmdRoot,
 		serviceVersionDeactivate,
+		serviceVersionDiff,
 		serviceVersionList,
 		serviceVersionLock,
 		serviceVersionUpdate,
@@ -804,6 +900,12 @@ func Define(
 		userList,
 		userUpdate,
 		vclCmdRoot,
+		vclCacheSettingCmdRoot,
+		vclCacheSettingCreate,
+		vclCacheSettingDelete,
+		vclCacheSettingDescribe,
+		vclCacheSettingList,
+		vclCacheSettingUpdate,
 		vclConditionCmdRoot,
 		vclConditionCreate,
 		vclConditionDelete,
@@ -816,6 +918,30 @@ func Define(
 		vclCustomDescribe,
 		vclCustomList,
 		vclCustomUpdate,
+		vclGzipCmdRoot,
+		vclGzipCreate,
+		vclGzipDelete,
+		vclGzipDescribe,
+		vclGzipList,
+		vclGzipUpdate,
+		vclHeaderCmdRoot,
+		vclHeaderCreate,
+		vclHeaderDelete,
+		vclHeaderDescribe,
+		vclHeaderList,
+		vclHeaderUpdate,
+		vclRequestSettingCmdRoot,
+		vclRequestSettingCreate,
+		vclRequestSettingDelete,
+		vclRequestSettingDescribe,
+		vclRequestSettingList,
+		vclRequestSettingUpdate,
+		vclResponseObjectCmdRoot,
+		vclResponseObjectCreate,
+		vclResponseObjectDelete,
+		vclResponseObjectDescribe,
+		vclResponseObjectList,
+		vclResponseObjectUpdate,
 		vclSnippetCmdRoot,
 		vclSnippetCreate,
 		vclSnippetDelete,
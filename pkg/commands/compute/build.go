@@ -50,7 +50,9 @@ type Flags struct {
 	Env         string
 	IncludeSrc  bool
 	Lang        string
+	NoCache     bool
 	PackageName string
+	SignKey     string
 	Timeout     int
 }
 
@@ -65,6 +67,8 @@ type BuildCommand struct {
 	MetadataDisable       bool
 	MetadataFilterEnvVars string
 	MetadataShow          bool
+
+	cache argparser.OptionalBool
 }
 
 // NewBuildCommand returns a usable command registered under the parent.
@@ -75,6 +79,9 @@ func NewBuildCommand(parent argparser.Registerer, g *global.Data) *BuildCommand
 
 	// NOTE: when updating these flags, be sure to update the composite commands:
 	// `compute publish` and `compute serve`.
+	// NOTE: kingpin treats any flag prefixed with `no-` as negated, so we expose
+	// `--no-cache` via a negatable `cache` flag.
+	c.CmdClause.Flag("cache", "Use the build cache to skip compiling a project that hasn't changed since the last build (use --no-cache to force a rebuild)").Action(c.cache.Set).NegatableBoolVar(&c.cache.Value)
 	c.CmdClause.Flag("dir", "Project directory to build (default: current directory)").Short('C').StringVar(&c.Flags.Dir)
 	c.CmdClause.Flag("env", "The manifest environment config to use (e.g. 'stage' will attempt to read 'fastly.stage.toml')").StringVar(&c.Flags.Env)
 	c.CmdClause.Flag("include-source", "Include source code in built package").BoolVar(&c.Flags.IncludeSrc)
@@ -83,6 +90,7 @@ func NewBuildCommand(parent argparser.Registerer, g *global.Data) *BuildCommand
 	c.CmdClause.Flag("metadata-filter-envvars", "Redact specified environment variables from [scripts.env_vars] using comma-separated list").StringVar(&c.MetadataFilterEnvVars)
 	c.CmdClause.Flag("metadata-show", "Inspect the Wasm binary metadata").BoolVar(&c.MetadataShow)
 	c.CmdClause.Flag("package-name", "Package name").StringVar(&c.Flags.PackageName)
+	c.CmdClause.Flag("sign-key", "Path to a PEM encoded ed25519 private key used to produce a detached signature of the package (<package>.sig)").StringVar(&c.Flags.SignKey)
 	c.CmdClause.Flag("timeout", "Timeout, in seconds, for the build compilation step").IntVar(&c.Flags.Timeout)
 
 	return &c
@@ -96,6 +104,10 @@ func (c *BuildCommand) Exec(in io.Reader, out io.Writer) (err error) {
 		out = io.Discard
 	}
 
+	if c.cache.WasSet {
+		c.Flags.NoCache = !c.cache.Value
+	}
+
 	manifestFilename := EnvironmentManifest(c.Flags.Env)
 	if c.Flags.Env != "" {
 		if c.Globals.Verbose() {
@@ -111,6 +123,11 @@ func (c *BuildCommand) Exec(in io.Reader, out io.Writer) (err error) {
 	}()
 	manifestPath := filepath.Join(wd, manifestFilename)
 
+	signKey, err := absPath(c.Flags.SignKey)
+	if err != nil {
+		return err
+	}
+
 	projectDir, err := ChangeProjectDirectory(c.Flags.Dir)
 	if err != nil {
 		return err
@@ -152,7 +169,10 @@ func (c *BuildCommand) Exec(in io.Reader, out io.Writer) (err error) {
 		return err
 	}
 
-	wasmtools, wasmtoolsErr := GetWasmTools(spinner, out, c.Globals.Versioners.WasmTools, c.Globals)
+	err = c.runScript("pre_build", c.Globals.Manifest.File.Scripts.PreBuild, manifestFilename, spinner, in, out)
+	if err != nil {
+		return err
+	}
 
 	var pkgName string
 	err = spinner.Process("Identifying package name", func(_ *text.SpinnerWrapper) error {
@@ -185,50 +205,97 @@ func (c *BuildCommand) Exec(in io.Reader, out io.Writer) (err error) {
 		return err
 	}
 
-	err = binDir(c)
-	if err != nil {
-		return err
-	}
-
-	if err := language.Build(); err != nil {
-		c.Globals.ErrLog.AddWithContext(err, map[string]any{
-			"Language": language.Name,
-		})
-		return err
+	// NOTE: When nothing affecting the Wasm binary has changed since the last
+	// build, we skip the compilation and annotation steps (see BuildCacheKey).
+	var cacheKey string
+	if !c.Flags.NoCache {
+		cacheKey, err = c.BuildCacheKey(language.Name)
+		if err != nil {
+			c.Globals.ErrLog.Add(err)
+			if c.Globals.Verbose() {
+				text.Warning(out, "Unable to use the build cache: %s\n\n", err)
+			}
+		}
 	}
-
-	// IMPORTANT: We ignore errors downloading wasm-tools.
-	// This is because we don't want to block a user from building their project.
-	// Annotating the compiled binary with metadata isn't that important.
-	if wasmtoolsErr == nil {
-		metadataProcessedBy := fmt.Sprintf(
-			"--processed-by=fastly=%s (%s)",
-			revision.AppVersion, cases.Title(textlang.English).String(language.Name),
-		)
-		metadataArgs := []string{
-			"metadata", "add", "bin/main.wasm", metadataProcessedBy,
+	cached := false
+	if cacheKey != "" {
+		if bc, err := ReadBuildCache(); err == nil && bc.Hit(cacheKey) {
+			cached = true
 		}
+	}
 
-		metadataDisable, _ := strconv.ParseBool(c.Globals.Env.WasmMetadataDisable)
-		if !c.MetadataDisable && !metadataDisable {
-			if err := c.AnnotateWasmBinaryLong(wasmtools, metadataArgs, language); err != nil {
-				return err
-			}
-		} else {
-			if err := c.AnnotateWasmBinaryShort(wasmtools, metadataArgs); err != nil {
+	if cached {
+		text.Info(out, "No changes detected since the last build, so skipping to packaging (use --no-cache to force a rebuild).\n\n")
+		if c.MetadataShow {
+			wasmtools, err := GetWasmTools(spinner, out, c.Globals.Versioners.WasmTools, c.Globals)
+			if err != nil {
 				return err
 			}
-		}
-		if c.MetadataShow {
 			if err := c.ShowMetadata(wasmtools, out); err != nil {
 				return err
 			}
 		}
 	} else {
-		if !c.Globals.Verbose() {
-			text.Break(out)
+		RemoveBuildCache()
+
+		wasmtools, wasmtoolsErr := GetWasmTools(spinner, out, c.Globals.Versioners.WasmTools, c.Globals)
+
+		err = binDir(c)
+		if err != nil {
+			return err
+		}
+
+		if err := language.Build(); err != nil {
+			c.Globals.ErrLog.AddWithContext(err, map[string]any{
+				"Language": language.Name,
+			})
+			return err
+		}
+
+		// IMPORTANT: We ignore errors downloading wasm-tools.
+		// This is because we don't want to block a user from building their project.
+		// Annotating the compiled binary with metadata isn't that important.
+		if wasmtoolsErr == nil {
+			metadataProcessedBy := fmt.Sprintf(
+				"--processed-by=fastly=%s (%s)",
+				revision.AppVersion, cases.Title(textlang.English).String(language.Name),
+			)
+			metadataArgs := []string{
+				"metadata", "add", "bin/main.wasm", metadataProcessedBy,
+			}
+
+			metadataDisable, _ := strconv.ParseBool(c.Globals.Env.WasmMetadataDisable)
+			if !c.MetadataDisable && !metadataDisable {
+				if err := c.AnnotateWasmBinaryLong(wasmtools, metadataArgs, language); err != nil {
+					return err
+				}
+			} else {
+				if err := c.AnnotateWasmBinaryShort(wasmtools, metadataArgs); err != nil {
+					return err
+				}
+			}
+			if c.MetadataShow {
+				if err := c.ShowMetadata(wasmtools, out); err != nil {
+					return err
+				}
+			}
+		} else {
+			if !c.Globals.Verbose() {
+				text.Break(out)
+			}
+			text.Info(out, "There was an error downloading the wasm-tools (used for binary annotations) but we don't let that block you building your project. For reference here is the error (in case you want to let us know about it): %s\n\n", wasmtoolsErr.Error())
+		}
+
+		err = c.runScript("test", c.Globals.Manifest.File.Scripts.Test, manifestFilename, spinner, in, out)
+		if err != nil {
+			return err
+		}
+
+		if cacheKey != "" {
+			if err := c.writeBuildCache(cacheKey); err != nil {
+				c.Globals.ErrLog.Add(err)
+			}
 		}
-		text.Info(out, "There was an error downloading the wasm-tools (used for binary annotations) but we don't let that block you building your project. For reference here is the error (in case you want to let us know about it): %s\n\n", wasmtoolsErr.Error())
 	}
 
 	dest := filepath.Join("pkg", fmt.Sprintf("%s.tar.gz", pkgName))
@@ -306,11 +373,46 @@ func (c *BuildCommand) Exec(in io.Reader, out io.Writer) (err error) {
 		return err
 	}
 
+	// NOTE: A signature from a previous build no longer matches the package.
+	_ = os.Remove(SignatureFilePath(dest))
+	if signKey != "" {
+		err = spinner.Process("Signing package", func(_ *text.SpinnerWrapper) error {
+			_, err := SignPackage(dest, signKey)
+			return err
+		})
+		if err != nil {
+			c.Globals.ErrLog.Add(err)
+			return err
+		}
+	}
+
+	if err := recordSizeReport(binWasmPath, dest); err != nil {
+		c.Globals.ErrLog.Add(err)
+	}
+
 	out = originalOut
 	text.Success(out, "\nBuilt package (%s)", dest)
 	return nil
 }
 
+// runScript executes a build related [scripts] lifecycle script.
+func (c *BuildCommand) runScript(name, script, manifestFilename string, spinner text.Spinner, in io.Reader, out io.Writer) error {
+	return runScript(scriptOpts{
+		autoYes:          c.Globals.Flags.AutoYes,
+		env:              c.Globals.Manifest.File.Scripts.EnvVars,
+		errLog:           c.Globals.ErrLog,
+		in:               in,
+		manifestFilename: manifestFilename,
+		name:             name,
+		nonInteractive:   c.Globals.Flags.NonInteractive,
+		out:              out,
+		script:           script,
+		spinner:          spinner,
+		timeout:          c.Flags.Timeout,
+		verbose:          c.Globals.Verbose(),
+	})
+}
+
 // AnnotateWasmBinaryShort annotates the Wasm binary with only the CLI version.
 func (c *BuildCommand) AnnotateWasmBinaryShort(wasmtools string, args []string) error {
 	return c.Globals.ExecuteWasmTools(wasmtools, args)
@@ -920,6 +920,54 @@ func TestBuildOther(t *testing.T) {
 			},
 			wantError: "exit status 1", // because we have to trigger an error to see the post_build output
 		},
+		{
+			name: "pre_build and test scripts",
+			args: args("compute build --auto-yes --language other --verbose"),
+			fastlyManifest: `
+			manifest_version = 2
+			name = "test"
+			[scripts]
+			build = "ls ./bin"
+			pre_build = "echo doing a pre build"
+			test = "echo running the tests"`,
+			wantOutput: []string{
+				"doing a pre build",
+				"Running [scripts.pre_build]",
+				"running the tests",
+				"Running [scripts.test]",
+				"Built package",
+			},
+		},
+		{
+			name: "stop pre_build script",
+			args: args("compute build --language other"),
+			fastlyManifest: `
+			manifest_version = 2
+			name = "test"
+			[scripts]
+			build = "ls ./bin"
+			pre_build = "echo doing a pre build"`,
+			stdin: "N",
+			wantOutput: []string{
+				"This project has a custom pre_build script defined in the fastly.toml manifest",
+				"echo doing a pre build",
+			},
+			wantError: "[scripts.pre_build] stopped by user",
+		},
+		{
+			name: "test script failure",
+			args: args("compute build --auto-yes --language other"),
+			fastlyManifest: `
+			manifest_version = 2
+			name = "test"
+			[scripts]
+			build = "ls ./bin"
+			test = "exit 1"`,
+			wantError: "error running [scripts.test]",
+			dontWantOutput: []string{
+				"Built package",
+			},
+		},
 	} {
 		t.Run(testcase.name, func(t *testing.T) {
 			// We're going to chdir to a build environment,
@@ -1045,3 +1093,140 @@ func TestBuildOther(t *testing.T) {
 		})
 	}
 }
+
+func TestBuildCache(t *testing.T) {
+	if os.Getenv("TEST_COMPUTE_BUILD") == "" {
+		t.Log("skipping test")
+		t.Skip("Set TEST_COMPUTE_BUILD to run this test")
+	}
+
+	pwd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	wasmtoolsBinName := "wasm-tools"
+	latestDownloaded := wasmtoolsBinName + "-latest-downloaded"
+
+	// NOTE: The build script records each time it's run in bin/builds.
+	rootdir := testutil.NewEnv(testutil.EnvOpts{
+		T: t,
+		Copy: []testutil.FileIO{
+			{Src: "./testdata/main.wasm", Dst: "bin/main.wasm"},
+		},
+		Write: []testutil.FileIO{
+			{Src: `#!/usr/bin/env bash
+            echo wasm-tools 1.0.4`, Dst: wasmtoolsBinName, Executable: true},
+			{Src: `#!/usr/bin/env bash
+            echo wasm-tools 2.0.0`, Dst: latestDownloaded, Executable: true},
+			{Src: `manifest_version = 2
+name = "test"
+language = "other"
+[scripts]
+build = "echo build >> ./bin/builds"`, Dst: manifest.Filename},
+			{Src: "*.log\nwasm-tools*", Dst: ".fastlyignore"},
+			{Src: "one", Dst: "src/main.txt"},
+		},
+	})
+	defer os.RemoveAll(rootdir)
+	wasmtoolsBinPath := filepath.Join(rootdir, wasmtoolsBinName)
+
+	if err := os.Chdir(rootdir); err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		_ = os.Chdir(pwd)
+	}()
+
+	for _, step := range []struct {
+		name       string
+		args       string
+		setup      func() error
+		wantCached bool
+	}{
+		{
+			name: "first build",
+			args: "compute build --auto-yes",
+		},
+		{
+			name:       "nothing changed",
+			args:       "compute build --auto-yes",
+			wantCached: true,
+		},
+		{
+			name: "ignored file changed",
+			args: "compute build --auto-yes",
+			setup: func() error {
+				return os.WriteFile("debug.log", []byte("ignored"), 0o600)
+			},
+			wantCached: true,
+		},
+		{
+			name: "source file changed",
+			args: "compute build --auto-yes",
+			setup: func() error {
+				return os.WriteFile(filepath.Join("src", "main.txt"), []byte("two"), 0o600)
+			},
+		},
+		{
+			name: "--no-cache",
+			args: "compute build --auto-yes --no-cache",
+		},
+		{
+			name: "Wasm binary modified",
+			args: "compute build --auto-yes",
+			setup: func() error {
+				return os.WriteFile(filepath.Join("bin", "main.wasm"), []byte("\x00asm\x01\x00\x00\x00\x00"), 0o600)
+			},
+		},
+		{
+			name:       "rebuilt after Wasm binary modified",
+			args:       "compute build --auto-yes",
+			wantCached: true,
+		},
+	} {
+		if step.setup != nil {
+			if err := step.setup(); err != nil {
+				t.Fatal(err)
+			}
+		}
+		before, _ := os.ReadFile(filepath.Join("bin", "builds"))
+
+		args := testutil.Args(step.args)
+		var stdout threadsafe.Buffer
+		app.Init = func(_ []string, _ io.Reader) (*global.Data, error) {
+			opts := testutil.MockGlobalData(args, &stdout)
+			opts.Versioners = global.Versioners{
+				WasmTools: mock.AssetVersioner{
+					AssetVersion:    "1.2.3",
+					BinaryFilename:  wasmtoolsBinName,
+					DownloadOK:      true,
+					DownloadedFile:  latestDownloaded,
+					InstallFilePath: wasmtoolsBinPath, // avoid overwriting developer's actual wasm-tools install
+				},
+			}
+			return opts, nil
+		}
+		err := app.Run(args, nil)
+
+		t.Log(step.name)
+		t.Log(stdout.String())
+
+		testutil.AssertNoError(t, err)
+		testutil.AssertStringContains(t, stdout.String(), "Built package")
+
+		after, _ := os.ReadFile(filepath.Join("bin", "builds"))
+		built := len(after) > len(before)
+		if step.wantCached {
+			testutil.AssertStringContains(t, stdout.String(), "No changes detected since the last build")
+			if built {
+				t.Errorf("%s: want build script to be skipped", step.name)
+			}
+		} else {
+			testutil.AssertStringDoesntContain(t, stdout.String(), "No changes detected since the last build")
+			if !built {
+				t.Errorf("%s: want build script to run", step.name)
+			}
+		}
+	}
+}
@@ -0,0 +1,245 @@
+package compute
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	ignore "github.com/sabhiram/go-gitignore"
+
+	"github.com/fastly/cli/pkg/revision"
+)
+
+// BuildCacheFile is the file (relative to the project directory) recording the
+// inputs and output of the last successful build.
+const BuildCacheFile = "pkg/.build-cache.json"
+
+// buildCacheIgnoreFiles are the ignore files whose patterns identify files
+// that aren't build inputs.
+var buildCacheIgnoreFiles = []string{IgnoreFilePath, ".gitignore"}
+
+// buildCacheSkipDirs are directories that never contain build inputs (the
+// build outputs are written to ./bin and ./pkg).
+var buildCacheSkipDirs = []string{".git", "bin", "pkg"}
+
+// buildCacheEnvVars are environment variables (outside of those defined by
+// [scripts.env_vars]) that can affect the output of a language toolchain.
+var buildCacheEnvVars = []string{
+	"CARGO_BUILD_TARGET",
+	"CARGO_ENCODED_RUSTFLAGS",
+	"CARGO_TARGET_DIR",
+	"GOARCH",
+	"GOFLAGS",
+	"GOOS",
+	"NODE_ENV",
+	"NODE_OPTIONS",
+	"RUSTFLAGS",
+	"TINYGOFLAGS",
+}
+
+// toolchainVersionCommands are the commands which report the version of each
+// language toolchain.
+var toolchainVersionCommands = map[string][][]string{
+	"assemblyscript": {{"node", "--version"}, {"npm", "--version"}},
+	"go":             {{"go", "version"}, {"tinygo", "version"}},
+	"javascript":     {{"node", "--version"}, {"npm", "--version"}},
+	"rust":           {{"cargo", "--version"}, {"rustc", "--version"}},
+}
+
+// BuildCache records the key of the last successful build alongside a hash
+// of the Wasm binary it produced.
+type BuildCache struct {
+	Key      string `json:"key"`
+	WasmHash string `json:"wasm_hash"`
+}
+
+// ReadBuildCache reads the build cache from the project directory.
+func ReadBuildCache() (BuildCache, error) {
+	var bc BuildCache
+	data, err := os.ReadFile(BuildCacheFile)
+	if err != nil {
+		return bc, err
+	}
+	err = json.Unmarshal(data, &bc)
+	return bc, err
+}
+
+// Write persists the build cache to the project directory.
+func (bc BuildCache) Write() error {
+	data, err := json.MarshalIndent(bc, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(BuildCacheFile), 0o750); err != nil {
+		return err
+	}
+	return os.WriteFile(BuildCacheFile, data, 0o600)
+}
+
+// Hit indicates if the cache matches the key and the Wasm binary it recorded
+// still exists unmodified.
+func (bc BuildCache) Hit(key string) bool {
+	if bc.Key == "" || bc.Key != key {
+		return false
+	}
+	h, err := fileHash(binWasmPath)
+	return err == nil && h == bc.WasmHash
+}
+
+// RemoveBuildCache deletes any existing build cache (e.g. a build failed).
+func RemoveBuildCache() {
+	_ = os.Remove(BuildCacheFile)
+}
+
+// BuildCacheKey returns a hash of every input which affects the compiled Wasm
+// binary: the project source files (respecting the .fastlyignore and
+// .gitignore files), the [scripts] section of the manifest, the language
+// toolchain versions, the relevant environment variables and the Wasm
+// metadata settings.
+func (c *BuildCommand) BuildCacheKey(language string) (string, error) {
+	h := sha256.New()
+	write := func(name string, v any) error {
+		data, err := json.Marshal(v)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(h, "%s=%s\n", name, data)
+		return nil
+	}
+
+	scripts := c.Globals.Manifest.File.Scripts
+	settings := map[string]any{
+		"cli_version":             revision.AppVersion,
+		"env":                     c.Flags.Env,
+		"language":                language,
+		"metadata_disable":        c.MetadataDisable,
+		"metadata_disable_env":    c.Globals.Env.WasmMetadataDisable,
+		"metadata_filter_envvars": c.MetadataFilterEnvVars,
+		"metadata_settings":       c.Globals.Config.WasmMetadata,
+		"scripts_build":           scripts.Build,
+		"scripts_env_vars":        scripts.EnvVars,
+		"scripts_post_build":      scripts.PostBuild,
+		"scripts_pre_build":       scripts.PreBuild,
+		"scripts_test":            scripts.Test,
+		"timeout":                 c.Flags.Timeout,
+	}
+	keys := make([]string, 0, len(settings))
+	for k := range settings {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		if err := write(k, settings[k]); err != nil {
+			return "", err
+		}
+	}
+
+	for _, name := range buildCacheEnvVars {
+		fmt.Fprintf(h, "env:%s=%s\n", name, os.Getenv(name))
+	}
+
+	for _, args := range toolchainVersionCommands[language] {
+		fmt.Fprintf(h, "toolchain:%s=%s\n", strings.Join(args, " "), toolchainVersion(args))
+	}
+
+	gi := compileIgnoreFiles("", buildCacheIgnoreFiles...)
+	err := filepath.WalkDir(".", func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if path == "." {
+			return nil
+		}
+		path = filepath.ToSlash(path)
+		if entry.IsDir() {
+			for _, dir := range buildCacheSkipDirs {
+				if path == dir {
+					return filepath.SkipDir
+				}
+			}
+			if gi.MatchesPath(path + "/") {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !entry.Type().IsRegular() || gi.MatchesPath(path) {
+			return nil
+		}
+		sum, err := fileHash(path)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(h, "file:%s=%s\n", path, sum)
+		return nil
+	})
+	if err != nil {
+		return "", fmt.Errorf("error hashing project files: %w", err)
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// writeBuildCache records the key of the build that produced the current Wasm
+// binary.
+func (c *BuildCommand) writeBuildCache(key string) error {
+	sum, err := fileHash(binWasmPath)
+	if err != nil {
+		return fmt.Errorf("error hashing Wasm binary: %w", err)
+	}
+	return BuildCache{Key: key, WasmHash: sum}.Write()
+}
+
+// toolchainVersion returns the output of the given version command, or an
+// empty string if the command isn't available.
+func toolchainVersion(args []string) string {
+	// gosec flagged this:
+	// G204 (CWE-78): Subprocess launched with variable
+	// Disabling as the commands are defined by us.
+	// #nosec
+	// nosemgrep
+	out, err := exec.Command(args[0], args[1:]...).Output()
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(out))
+}
+
+// fileHash returns the SHA256 hash of the file content.
+func fileHash(path string) (string, error) {
+	// gosec flagged this:
+	// G304 (CWE-22): Potential file inclusion via variable
+	// Disabling as the path is within the user's project directory.
+	// #nosec
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close() // #nosec G307
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// compileIgnoreFiles returns the ignore rules defined within the given ignore
+// files (relative to root).
+//
+// NOTE: We also ignore the .git directory.
+func compileIgnoreFiles(root string, files ...string) *ignore.GitIgnore {
+	var patterns []string
+	for _, file := range files {
+		patterns = append(patterns, readIgnoreFile(root+file)...)
+	}
+	patterns = append(patterns, ".git/")
+	return ignore.CompileIgnoreLines(patterns...)
+}
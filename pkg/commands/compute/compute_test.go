@@ -69,6 +69,7 @@ func TestFlagDivergencePublish(t *testing.T) {
 // `compute build` command as `compute serve` delegates to build.
 func TestFlagDivergenceServe(t *testing.T) {
 	var cfg global.Data
+	cfg.Manifest = &manifest.Data{}
 	acmd := kingpin.New("foo", "bar")
 
 	rcmd := compute.NewRootCommand(acmd, &cfg)
@@ -84,8 +85,10 @@ func TestFlagDivergenceServe(t *testing.T) {
 	)
 
 	// Some flags on `compute build` are unique to it.
-	// NOTE: There are no flags to ignore but I'm keeping the logic for future.
-	ignoreBuildFlags := []string{}
+	// A package built for local testing isn't signed.
+	ignoreBuildFlags := []string{
+		"sign-key",
+	}
 
 	iter := buildFlags.MapRange()
 	for iter.Next() {
@@ -103,11 +106,19 @@ func TestFlagDivergenceServe(t *testing.T) {
 		"file",
 		"profile-guest",
 		"profile-guest-dir",
+		"pull-stores",
+		"record-har",
+		"replay-har",
+		"service-id",
+		"service-name",
 		"skip-build",
+		"startup-timeout",
+		"version",
 		"viceroy-check",
 		"viceroy-path",
 		"watch",
 		"watch-dir",
+		"workspace",
 	}
 
 	iter = serveFlags.MapRange()
@@ -123,11 +134,73 @@ func TestFlagDivergenceServe(t *testing.T) {
 	}
 }
 
+// TestFlagDivergenceTest validates that the manually curated list of flags
+// within the `compute test` command doesn't fall out of sync with the
+// `compute build` command as `compute test` delegates to build.
+func TestFlagDivergenceTest(t *testing.T) {
+	var cfg global.Data
+	cfg.Manifest = &manifest.Data{}
+	acmd := kingpin.New("foo", "bar")
+
+	rcmd := compute.NewRootCommand(acmd, &cfg)
+	bcmd := compute.NewBuildCommand(rcmd.CmdClause, &cfg)
+	scmd := compute.NewServeCommand(rcmd.CmdClause, &cfg, bcmd)
+	tcmd := compute.NewTestCommand(rcmd.CmdClause, &cfg, bcmd, scmd)
+
+	buildFlags := getFlags(bcmd.CmdClause)
+	testFlags := getFlags(tcmd.CmdClause)
+
+	var (
+		expect = make(map[string]int)
+		have   = make(map[string]int)
+	)
+
+	// Some flags on `compute build` are unique to it.
+	// A package built for local testing isn't signed.
+	ignoreBuildFlags := []string{
+		"sign-key",
+	}
+
+	iter := buildFlags.MapRange()
+	for iter.Next() {
+		flag := iter.Key().String()
+		if !ignoreFlag(ignoreBuildFlags, flag) {
+			expect[flag] = 1
+		}
+	}
+
+	// Some flags on `compute test` are unique to it.
+	// We only want to be sure test contains all build flags.
+	ignoreTestFlags := []string{
+		"addr",
+		"file",
+		"junit",
+		"skip-build",
+		"startup-timeout",
+		"tests",
+		"viceroy-check",
+		"viceroy-path",
+	}
+
+	iter = testFlags.MapRange()
+	for iter.Next() {
+		flag := iter.Key().String()
+		if !ignoreFlag(ignoreTestFlags, flag) {
+			have[flag] = 1
+		}
+	}
+
+	if !reflect.DeepEqual(expect, have) {
+		t.Fatalf("the flags between build and test don't match\n\nexpect: %+v\nhave:   %+v\n\n", expect, have)
+	}
+}
+
 // TestFlagDivergenceHashSum validates that the manually curated list of flags
 // within the `compute hashsum` command doesn't fall out of sync with the
 // `compute build` command as `compute hashsum` delegates to build.
 func TestFlagDivergenceHashSum(t *testing.T) {
 	var cfg global.Data
+	cfg.Manifest = &manifest.Data{}
 	acmd := kingpin.New("foo", "bar")
 
 	rcmd := compute.NewRootCommand(acmd, &cfg)
@@ -179,6 +252,7 @@ func TestFlagDivergenceHashSum(t *testing.T) {
 // `compute build` command as `compute hashsum` delegates to build.
 func TestFlagDivergenceHashFiles(t *testing.T) {
 	var cfg global.Data
+	cfg.Manifest = &manifest.Data{}
 	acmd := kingpin.New("foo", "bar")
 
 	rcmd := compute.NewRootCommand(acmd, &cfg)
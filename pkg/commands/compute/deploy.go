@@ -52,10 +52,14 @@ type DeployCommand struct {
 	PackagePath        string
 	ServiceName        argparser.OptionalServiceNameID
 	ServiceVersion     argparser.OptionalServiceVersion
+	Staged             bool
 	StatusCheckCode    int
 	StatusCheckOff     bool
 	StatusCheckPath    string
 	StatusCheckTimeout int
+	SyncSetup          bool
+	Timeout            int
+	VerifyKey          string
 }
 
 // NewDeployCommand returns a usable command registered under the parent.
@@ -89,15 +93,26 @@ func NewDeployCommand(parent argparser.Registerer, g *global.Data) *DeployComman
 	c.CmdClause.Flag("domain", "The name of the domain associated to the package").StringVar(&c.Domain)
 	c.CmdClause.Flag("env", "The manifest environment config to use (e.g. 'stage' will attempt to read 'fastly.stage.toml')").StringVar(&c.Env)
 	c.CmdClause.Flag("package", "Path to a package tar.gz").Short('p').StringVar(&c.PackagePath)
+	c.CmdClause.Flag("staged", "Validate the new version before activating it, then re-activate the previous version if the service availability check fails within the --status-check-timeout window").BoolVar(&c.Staged)
 	c.CmdClause.Flag("status-check-code", "Set the expected status response for the service availability check").IntVar(&c.StatusCheckCode)
 	c.CmdClause.Flag("status-check-off", "Disable the service availability check").BoolVar(&c.StatusCheckOff)
 	c.CmdClause.Flag("status-check-path", "Specify the URL path for the service availability check").Default("/").StringVar(&c.StatusCheckPath)
 	c.CmdClause.Flag("status-check-timeout", "Set a timeout (in seconds) for the service availability check").Default("120").IntVar(&c.StatusCheckTimeout)
+	c.CmdClause.Flag("sync-setup", "Create the [setup] resources missing from an existing service, and update backends whose address or port has changed").BoolVar(&c.SyncSetup)
+	c.CmdClause.Flag("timeout", "Timeout, in seconds, for the pre_deploy and post_deploy scripts").IntVar(&c.Timeout)
+	c.CmdClause.Flag("verify-key", "Path to a PEM encoded ed25519 public key used to verify the package signature (<package>.sig) before it's uploaded").StringVar(&c.VerifyKey)
 	return &c
 }
 
 // Exec implements the command interface.
 func (c *DeployCommand) Exec(in io.Reader, out io.Writer) (err error) {
+	if c.Staged && c.StatusCheckOff {
+		return fsterr.RemediationError{
+			Inner:       fmt.Errorf("invalid flag combination, --staged and --status-check-off"),
+			Remediation: "A staged deployment relies on the service availability check, so use either --staged or --status-check-off, not both.",
+		}
+	}
+
 	manifestFilename := EnvironmentManifest(c.Env)
 	if c.Env != "" {
 		if c.Globals.Verbose() {
@@ -113,6 +128,11 @@ func (c *DeployCommand) Exec(in io.Reader, out io.Writer) (err error) {
 	}()
 	c.manifestPath = filepath.Join(wd, manifestFilename)
 
+	verifyKey, err := absPath(c.VerifyKey)
+	if err != nil {
+		return err
+	}
+
 	projectDir, err := ChangeProjectDirectory(c.Dir)
 	if err != nil {
 		return err
@@ -169,6 +189,26 @@ func (c *DeployCommand) Exec(in io.Reader, out io.Writer) (err error) {
 	}
 	noExistingService := serviceID == ""
 
+	// NOTE: The signature is verified before anything is created or modified.
+	if verifyKey != "" {
+		err = spinner.Process("Verifying package signature", func(_ *text.SpinnerWrapper) error {
+			return VerifyPackage(c.PackagePath, verifyKey)
+		})
+		if err != nil {
+			c.Globals.ErrLog.AddWithContext(err, map[string]any{
+				"Package path": c.PackagePath,
+			})
+			return err
+		}
+	}
+
+	err = c.runScript("pre_deploy", c.Globals.Manifest.File.Scripts.PreDeploy, manifestFilename, []string{
+		ScriptEnvServiceID + "=" + serviceID,
+	}, spinner, in, out)
+	if err != nil {
+		return err
+	}
+
 	undoStack := undo.NewStack()
 	undoStack.Push(func() error {
 		if noExistingService && serviceID != "" {
@@ -207,11 +247,18 @@ func (c *DeployCommand) Exec(in io.Reader, out io.Writer) (err error) {
 			}
 			return err
 		}
-		if c.Globals.Manifest.File.Setup.Defined() && !c.Globals.Flags.Quiet {
-			text.Info(out, "\nProcessing of the %s [setup] configuration happens only for a new service. Once a service is created, any further changes to the service or its resources must be made manually.\n\n", manifestFilename)
+		if c.Globals.Manifest.File.Setup.Defined() && !c.SyncSetup && !c.Globals.Flags.Quiet {
+			text.Info(out, "\nProcessing of the %s [setup] configuration happens only for a new service. Once a service is created, any further changes to the service or its resources must be made manually (or by using the --sync-setup flag).\n\n", manifestFilename)
 		}
 	}
 
+	// NOTE: When syncing the [setup] configuration with an existing service, we
+	// only construct the resources that are missing from the (cloned) service
+	// version, while backends that exist but whose address or port differ are
+	// updated once the missing resources have been created.
+	syncSetup := !noExistingService && c.SyncSetup
+	var drift []setup.Drift
+
 	var sr ServiceResources
 
 	// NOTE: A 'domain' resource isn't strictly part of the [setup] config.
@@ -238,6 +285,14 @@ func (c *DeployCommand) Exec(in io.Reader, out io.Writer) (err error) {
 			&sr, serviceID, serviceVersion.Number, in, out,
 		)
 	}
+	if syncSetup {
+		drift, err = c.ConstructSyncServiceResources(
+			&sr, serviceID, serviceVersion.Number, in, out,
+		)
+		if err != nil {
+			return err
+		}
+	}
 
 	if sr.domains.Missing() {
 		if err := sr.domains.Configure(); err != nil {
@@ -250,6 +305,11 @@ func (c *DeployCommand) Exec(in io.Reader, out io.Writer) (err error) {
 			return err
 		}
 	}
+	if syncSetup {
+		if err = c.ConfigureSyncServiceResources(sr, serviceID, serviceVersion.Number); err != nil {
+			return err
+		}
+	}
 
 	if sr.domains.Missing() {
 		sr.domains.Spinner = spinner
@@ -264,11 +324,16 @@ func (c *DeployCommand) Exec(in io.Reader, out io.Writer) (err error) {
 			return err
 		}
 	}
-	if noExistingService {
+	if noExistingService || syncSetup {
 		if err = c.CreateServiceResources(sr, spinner, serviceID, serviceVersion.Number); err != nil {
 			return err
 		}
 	}
+	if syncSetup {
+		if err = c.UpdateServiceBackends(sr, drift, in, out); err != nil {
+			return err
+		}
+	}
 
 	err = c.UploadPackage(spinner, serviceID, serviceVersion.Number)
 	if err != nil {
@@ -280,16 +345,46 @@ func (c *DeployCommand) Exec(in io.Reader, out io.Writer) (err error) {
 		return err
 	}
 
+	// NOTE: An inactive version doesn't serve traffic, so for a staged
+	// deployment the new version is validated prior to activation, while the
+	// service availability check can only happen once it's activated.
+	var previousVersion *fastly.Version
+	if c.Staged {
+		if err = c.ValidateServiceVersion(serviceID, serviceVersion.Number, spinner); err != nil {
+			return err
+		}
+		if !noExistingService {
+			previousVersion, err = c.ActiveServiceVersion(serviceID)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
 	if err = c.ProcessService(serviceID, serviceVersion.Number, spinner); err != nil {
 		return err
 	}
 
+	// NOTE: For a new service the existing undo function deletes the service,
+	// otherwise we re-activate whichever version was active before this one.
+	if previousVersion != nil {
+		undoStack.Push(func() error {
+			return c.RollbackServiceVersion(serviceID, previousVersion.Number, serviceVersion.Number, out)
+		})
+	}
+
 	serviceURL, err := c.GetServiceURL(serviceID, serviceVersion.Number)
 	if err != nil {
 		return err
 	}
 
-	if !c.StatusCheckOff && noExistingService {
+	switch {
+	case c.Staged:
+		if err = c.StagedStatusCheck(serviceURL, spinner); err != nil {
+			errLogService(c.Globals.ErrLog, err, serviceID, serviceVersion.Number)
+			return err
+		}
+	case !c.StatusCheckOff && noExistingService:
 		c.StatusCheck(serviceURL, spinner, out)
 	}
 
@@ -297,7 +392,38 @@ func (c *DeployCommand) Exec(in io.Reader, out io.Writer) (err error) {
 		text.Break(out)
 	}
 	displayDeployOutput(out, manageServiceBaseURL, serviceID, serviceURL, serviceVersion.Number)
-	return nil
+
+	// NOTE: The deployment is complete, so a failing post_deploy script
+	// shouldn't cause the new service to be deleted (or rolled back).
+	for undoStack.Len() > 0 {
+		undoStack.Pop()
+	}
+	if c.Globals.Manifest.File.Scripts.PostDeploy != "" {
+		text.Break(out)
+	}
+	return c.runScript("post_deploy", c.Globals.Manifest.File.Scripts.PostDeploy, manifestFilename, []string{
+		ScriptEnvServiceID + "=" + serviceID,
+		fmt.Sprintf("%s=%d", ScriptEnvServiceVersion, serviceVersion.Number),
+		ScriptEnvServiceURL + "=" + serviceURL,
+	}, spinner, in, out)
+}
+
+// runScript executes a deploy related [scripts] lifecycle script.
+func (c *DeployCommand) runScript(name, script, manifestFilename string, env []string, spinner text.Spinner, in io.Reader, out io.Writer) error {
+	return runScript(scriptOpts{
+		autoYes:          c.Globals.Flags.AutoYes,
+		env:              append(append([]string{}, c.Globals.Manifest.File.Scripts.EnvVars...), env...),
+		errLog:           c.Globals.ErrLog,
+		in:               in,
+		manifestFilename: manifestFilename,
+		name:             name,
+		nonInteractive:   c.Globals.Flags.NonInteractive,
+		out:              out,
+		script:           script,
+		spinner:          spinner,
+		timeout:          c.Timeout,
+		verbose:          c.Globals.Verbose(),
+	})
 }
 
 // StatusCheck checks the service URL and identifies when it's ready.
@@ -328,6 +454,89 @@ func (c *DeployCommand) StatusCheck(serviceURL string, spinner text.Spinner, out
 	}
 }
 
+// StagedStatusCheck checks the service URL identifies as ready within the
+// configured timeout, returning an error if not (or if the status code doesn't
+// match what was expected) so that the deployment can be rolled back.
+func (c *DeployCommand) StagedStatusCheck(serviceURL string, spinner text.Spinner) error {
+	status, err := checkingServiceAvailability(serviceURL+c.StatusCheckPath, spinner, c)
+	if err != nil {
+		var re fsterr.RemediationError
+		if errors.As(err, &re) {
+			err = re.Inner
+		}
+		return fsterr.RemediationError{
+			Inner:       fmt.Errorf("service availability check failed: %w", err),
+			Remediation: stagedRollbackRemediation,
+		}
+	}
+	if validStatusCodeRange(c.StatusCheckCode) && status != c.StatusCheckCode {
+		return fsterr.RemediationError{
+			Inner:       fmt.Errorf("the service path `%s` responded with a status code (%d) that didn't match what was expected (%d)", c.StatusCheckPath, status, c.StatusCheckCode),
+			Remediation: stagedRollbackRemediation,
+		}
+	}
+	return nil
+}
+
+// stagedRollbackRemediation is the remediation for a failed staged deployment.
+const stagedRollbackRemediation = "The deployment was rolled back. If using a custom domain, please be sure to check your DNS settings. Otherwise, check your application code, or increase the --status-check-timeout if your application takes longer to deploy across our global network."
+
+// ValidateServiceVersion validates the service version prior to activation.
+func (c *DeployCommand) ValidateServiceVersion(serviceID string, serviceVersion int, spinner text.Spinner) error {
+	return spinner.Process(fmt.Sprintf("Validating service (version %d)", serviceVersion), func(_ *text.SpinnerWrapper) error {
+		valid, msg, err := c.Globals.APIClient.ValidateVersion(&fastly.ValidateVersionInput{
+			ServiceID:      serviceID,
+			ServiceVersion: serviceVersion,
+		})
+		if err != nil {
+			errLogService(c.Globals.ErrLog, err, serviceID, serviceVersion)
+			return fmt.Errorf("error validating version: %w", err)
+		}
+		if !valid {
+			return fsterr.RemediationError{
+				Inner:       fmt.Errorf("service version %d is invalid: %s", serviceVersion, msg),
+				Remediation: "The service version has not been activated. Fix the reported problem and deploy again.",
+			}
+		}
+		return nil
+	})
+}
+
+// ActiveServiceVersion returns the currently active service version, or nil
+// if the service has no active version.
+func (c *DeployCommand) ActiveServiceVersion(serviceID string) (*fastly.Version, error) {
+	versions, err := c.Globals.APIClient.ListVersions(&fastly.ListVersionsInput{
+		ServiceID: serviceID,
+	})
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]any{
+			"Service ID": serviceID,
+		})
+		return nil, fmt.Errorf("error listing service versions: %w", err)
+	}
+	v, err := argparser.GetActiveVersion(versions)
+	if err != nil {
+		return nil, nil // nothing to roll back to
+	}
+	return v, nil
+}
+
+// RollbackServiceVersion is executed if a staged deployment fails after the
+// new service version was activated. It re-activates the previous version.
+func (c *DeployCommand) RollbackServiceVersion(serviceID string, previousVersion, failedVersion int, out io.Writer) error {
+	text.Info(out, "\nRolling back service %s from version %d to version %d\n\n", serviceID, failedVersion, previousVersion)
+	_, err := c.Globals.APIClient.ActivateVersion(&fastly.ActivateVersionInput{
+		ServiceID:      serviceID,
+		ServiceVersion: previousVersion,
+	})
+	if err != nil {
+		errLogService(c.Globals.ErrLog, err, serviceID, previousVersion)
+		return fmt.Errorf("error re-activating service version %d: %w", previousVersion, err)
+	}
+	text.Output(out, "Rollback complete (service %s, version %d)", serviceID, previousVersion)
+	return nil
+}
+
 func displayDeployOutput(out io.Writer, manageServiceBaseURL, serviceID, serviceURL string, serviceVersion int) {
 	text.Description(out, "Manage this service at", fmt.Sprintf("%s%s", manageServiceBaseURL, serviceID))
 	text.Description(out, "View this service at", serviceURL)
@@ -797,6 +1006,10 @@ type ServiceResources struct {
 	objectStores *setup.KVStores
 	kvStores     *setup.KVStores
 	secretStores *setup.SecretStores
+
+	// backendUpdates holds every [setup.backends] definition so that existing
+	// backends can be updated when syncing an existing service.
+	backendUpdates *setup.Backends
 }
 
 // ConstructNewServiceResources instantiates multiple [setup] config resources for a
@@ -924,6 +1137,134 @@ func (c *DeployCommand) ConfigureServiceResources(sr ServiceResources, serviceID
 	return nil
 }
 
+// ConstructSyncServiceResources instantiates the [setup] config resources
+// that are missing from an existing Service, and returns the differences
+// between the [setup] config and the Service version.
+func (c *DeployCommand) ConstructSyncServiceResources(
+	sr *ServiceResources,
+	serviceID string,
+	serviceVersion int,
+	in io.Reader,
+	out io.Writer,
+) ([]setup.Drift, error) {
+	drift, err := setup.CheckDrift(c.Globals.APIClient, serviceID, serviceVersion, c.Globals.Manifest.File.Setup)
+	if err != nil {
+		errLogService(c.Globals.ErrLog, err, serviceID, serviceVersion)
+		return nil, fmt.Errorf("error comparing the [setup] configuration with the service: %w", err)
+	}
+
+	c.ConstructNewServiceResources(sr, serviceID, serviceVersion, in, out)
+
+	missing := setup.Missing(c.Globals.Manifest.File.Setup, drift)
+	sr.backends.Setup = missing.Backends
+	sr.configStores.Setup = missing.ConfigStores
+	sr.loggers.Setup = missing.Loggers
+	sr.objectStores.Setup = missing.ObjectStores
+	sr.kvStores.Setup = missing.KVStores
+	sr.secretStores.Setup = missing.SecretStores
+
+	// The backends are kept so that changed backends can be updated.
+	sr.backendUpdates = &setup.Backends{
+		APIClient:      c.Globals.APIClient,
+		ServiceID:      serviceID,
+		ServiceVersion: serviceVersion,
+		Setup:          c.Globals.Manifest.File.Setup.Backends,
+		Stdin:          in,
+		Stdout:         out,
+	}
+
+	return drift, nil
+}
+
+// ConfigureSyncServiceResources calls the .Configure() method for each
+// [setup] resource missing from an existing Service.
+//
+// NOTE: Unlike ConfigureServiceResources, the user isn't prompted for a
+// backend when none are missing, as the service will already have backends.
+func (c *DeployCommand) ConfigureSyncServiceResources(sr ServiceResources, serviceID string, serviceVersion int) error {
+	if sr.backends.Predefined() {
+		if err := sr.backends.Configure(); err != nil {
+			errLogService(c.Globals.ErrLog, err, serviceID, serviceVersion)
+			return fmt.Errorf("error configuring service backends: %w", err)
+		}
+	}
+	if sr.configStores.Predefined() {
+		if err := sr.configStores.Configure(); err != nil {
+			errLogService(c.Globals.ErrLog, err, serviceID, serviceVersion)
+			return fmt.Errorf("error configuring service config stores: %w", err)
+		}
+	}
+	if sr.loggers.Predefined() {
+		_ = sr.loggers.Configure()
+	}
+	if sr.objectStores.Predefined() {
+		if err := sr.objectStores.Configure(); err != nil {
+			errLogService(c.Globals.ErrLog, err, serviceID, serviceVersion)
+			return fmt.Errorf("error configuring service object stores: %w", err)
+		}
+	}
+	if sr.kvStores.Predefined() {
+		if err := sr.kvStores.Configure(); err != nil {
+			errLogService(c.Globals.ErrLog, err, serviceID, serviceVersion)
+			return fmt.Errorf("error configuring service kv stores: %w", err)
+		}
+	}
+	if sr.secretStores.Predefined() {
+		if err := sr.secretStores.Configure(); err != nil {
+			errLogService(c.Globals.ErrLog, err, serviceID, serviceVersion)
+			return fmt.Errorf("error configuring service secret stores: %w", err)
+		}
+	}
+	return nil
+}
+
+// UpdateServiceBackends updates the existing backends whose address or port
+// differ from the [setup.backends] configuration.
+//
+// The user is prompted before each backend is updated, unless --auto-yes is
+// set. In non-interactive mode without --auto-yes the backends are skipped.
+func (c *DeployCommand) UpdateServiceBackends(sr ServiceResources, drift []setup.Drift, in io.Reader, out io.Writer) error {
+	var names []string
+	for _, d := range drift {
+		if d.Resource != setup.DriftBackend || d.Status != setup.DriftMismatch {
+			continue
+		}
+		update := c.Globals.Flags.AutoYes
+		if !update && !c.Globals.Flags.NonInteractive {
+			text.Break(out)
+			text.Output(out, "The backend '%s' differs from the [setup] configuration:", d.Name)
+			for _, detail := range d.Details {
+				text.Output(out, "\t%s", detail)
+			}
+			var err error
+			update, err = text.AskYesNo(out, "Update the backend to match? [y/N] ", in)
+			if err != nil {
+				return err
+			}
+		}
+		if !update {
+			text.Warning(out, "Skipping update of backend '%s'", d.Name)
+			continue
+		}
+		names = append(names, d.Name)
+	}
+	if len(names) == 0 {
+		return nil
+	}
+
+	sr.backendUpdates.Spinner = sr.backends.Spinner
+	if err := sr.backendUpdates.Update(names); err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]any{
+			"Auto-yes":        c.Globals.Flags.AutoYes,
+			"Non-interactive": c.Globals.Flags.NonInteractive,
+			"Service ID":      sr.backendUpdates.ServiceID,
+			"Service Version": sr.backendUpdates.ServiceVersion,
+		})
+		return err
+	}
+	return nil
+}
+
 // CreateServiceResources makes API calls to create resources that have been
 // defined in the fastly.toml [setup] configuration.
 func (c *DeployCommand) CreateServiceResources(
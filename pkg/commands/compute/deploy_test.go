@@ -706,6 +706,257 @@ func TestDeploy(t *testing.T) {
 				"Deployed package (service 123, version 4)",
 			},
 		},
+		{
+			name:      "staged with status check disabled",
+			args:      args("compute deploy --service-id 123 --token 123 --staged --status-check-off"),
+			wantError: "invalid flag combination, --staged and --status-check-off",
+		},
+		{
+			name: "success with staged deployment",
+			args: args("compute deploy --service-id 123 --token 123 --staged"),
+			api: mock.API{
+				ActivateVersionFn:   activateVersionOk,
+				CloneVersionFn:      testutil.CloneVersionResult(4),
+				GetPackageFn:        getPackageOk,
+				GetServiceDetailsFn: getServiceDetailsWasm,
+				GetServiceFn:        getServiceOK,
+				ListDomainsFn:       listDomainsOk,
+				ListVersionsFn:      testutil.ListVersions,
+				UpdatePackageFn:     updatePackageOk,
+				ValidateVersionFn:   validateVersionOk,
+			},
+			httpClientRes: []*http.Response{
+				{
+					Body:       io.NopCloser(strings.NewReader("success")),
+					Status:     http.StatusText(http.StatusOK),
+					StatusCode: http.StatusOK,
+				},
+			},
+			httpClientErr: []error{
+				nil,
+			},
+			wantOutput: []string{
+				"Uploading package",
+				"Validating service (version 4)",
+				"Activating service (version 4)",
+				"Checking service availability",
+				"Deployed package (service 123, version 4)",
+			},
+			dontWantOutput: []string{
+				"Rolling back",
+			},
+		},
+		{
+			name: "staged deployment with invalid version",
+			args: args("compute deploy --service-id 123 --token 123 --staged"),
+			api: mock.API{
+				CloneVersionFn:      testutil.CloneVersionResult(4),
+				GetPackageFn:        getPackageOk,
+				GetServiceDetailsFn: getServiceDetailsWasm,
+				GetServiceFn:        getServiceOK,
+				ListDomainsFn:       listDomainsOk,
+				ListVersionsFn:      testutil.ListVersions,
+				UpdatePackageFn:     updatePackageOk,
+				ValidateVersionFn: func(_ *fastly.ValidateVersionInput) (bool, string, error) {
+					return false, "missing backend", nil
+				},
+			},
+			wantError: "service version 4 is invalid: missing backend",
+			dontWantOutput: []string{
+				"Activating service",
+				"Rolling back",
+			},
+		},
+		{
+			name: "staged deployment is rolled back when the status check fails",
+			args: args("compute deploy --service-id 123 --token 123 --staged --status-check-code 200"),
+			api: mock.API{
+				ActivateVersionFn:   activateVersionOk,
+				CloneVersionFn:      testutil.CloneVersionResult(4),
+				GetPackageFn:        getPackageOk,
+				GetServiceDetailsFn: getServiceDetailsWasm,
+				GetServiceFn:        getServiceOK,
+				ListDomainsFn:       listDomainsOk,
+				ListVersionsFn:      testutil.ListVersions,
+				UpdatePackageFn:     updatePackageOk,
+				ValidateVersionFn:   validateVersionOk,
+			},
+			httpClientRes: []*http.Response{
+				{
+					Body:       io.NopCloser(strings.NewReader("not found")),
+					Status:     http.StatusText(http.StatusNotFound),
+					StatusCode: http.StatusNotFound,
+				},
+			},
+			httpClientErr: []error{
+				nil,
+			},
+			wantError: "responded with a status code (404) that didn't match what was expected (200)",
+			wantOutput: []string{
+				"Activating service (version 4)",
+				"Rolling back service 123 from version 4 to version 1",
+				"Rollback complete (service 123, version 1)",
+			},
+			dontWantOutput: []string{
+				"Deployed package",
+			},
+		},
+		{
+			name: "staged deployment rollback error",
+			args: args("compute deploy --service-id 123 --token 123 --staged --status-check-code 200"),
+			api: mock.API{
+				ActivateVersionFn: func(i *fastly.ActivateVersionInput) (*fastly.Version, error) {
+					if i.ServiceVersion == 1 {
+						return nil, testutil.Err
+					}
+					return activateVersionOk(i)
+				},
+				CloneVersionFn:      testutil.CloneVersionResult(4),
+				GetPackageFn:        getPackageOk,
+				GetServiceDetailsFn: getServiceDetailsWasm,
+				GetServiceFn:        getServiceOK,
+				ListDomainsFn:       listDomainsOk,
+				ListVersionsFn:      testutil.ListVersions,
+				UpdatePackageFn:     updatePackageOk,
+				ValidateVersionFn:   validateVersionOk,
+			},
+			httpClientRes: []*http.Response{
+				{
+					Body:       io.NopCloser(strings.NewReader("not found")),
+					Status:     http.StatusText(http.StatusNotFound),
+					StatusCode: http.StatusNotFound,
+				},
+			},
+			httpClientErr: []error{
+				nil,
+			},
+			wantError: "didn't match what was expected (200)",
+			wantOutput: []string{
+				"Rolling back service 123 from version 4 to version 1",
+				"error re-activating service version 1: test error",
+			},
+		},
+		{
+			name: "success with pre_deploy and post_deploy scripts",
+			args: args("compute deploy --service-id 123 --token 123 --auto-yes --verbose"),
+			api: mock.API{
+				ActivateVersionFn:   activateVersionOk,
+				CloneVersionFn:      testutil.CloneVersionResult(4),
+				GetPackageFn:        getPackageOk,
+				GetServiceDetailsFn: getServiceDetailsWasm,
+				GetServiceFn:        getServiceOK,
+				ListDomainsFn:       listDomainsOk,
+				ListVersionsFn:      testutil.ListVersions,
+				UpdatePackageFn:     updatePackageOk,
+			},
+			manifest: `
+			manifest_version = 2
+			name = "package"
+			[scripts]
+			pre_deploy = "echo pre_deploy service=$FASTLY_SERVICE_ID"
+			post_deploy = "echo post_deploy service=$FASTLY_SERVICE_ID version=$FASTLY_SERVICE_VERSION url=$FASTLY_SERVICE_URL"
+			`,
+			wantOutput: []string{
+				"Running [scripts.pre_deploy]",
+				"pre_deploy service=123",
+				"Deployed package (service 123, version 4)",
+				"Running [scripts.post_deploy]",
+				"post_deploy service=123 version=4 url=https://",
+			},
+		},
+		{
+			name: "pre_deploy script declined",
+			args: args("compute deploy --service-id 123 --token 123"),
+			api: mock.API{
+				GetServiceDetailsFn: getServiceDetailsWasm,
+			},
+			manifest: `
+			manifest_version = 2
+			name = "package"
+			[scripts]
+			pre_deploy = "echo pre_deploy"
+			`,
+			stdin:     []string{"N"},
+			wantError: "[scripts.pre_deploy] stopped by user",
+			wantOutput: []string{
+				"This project has a custom pre_deploy script defined in the fastly.toml manifest",
+			},
+			dontWantOutput: []string{
+				"Uploading package",
+			},
+		},
+		{
+			name: "pre_deploy script error stops the deployment",
+			args: args("compute deploy --service-id 123 --token 123 --non-interactive"),
+			api: mock.API{
+				GetServiceDetailsFn: getServiceDetailsWasm,
+			},
+			manifest: `
+			manifest_version = 2
+			name = "package"
+			[scripts]
+			pre_deploy = "exit 1"
+			`,
+			wantError: "error running [scripts.pre_deploy]",
+			dontWantOutput: []string{
+				"Uploading package",
+			},
+		},
+		{
+			name: "pre_deploy script exceeding --timeout stops the deployment",
+			args: args("compute deploy --service-id 123 --token 123 --non-interactive --timeout 1"),
+			api: mock.API{
+				GetServiceDetailsFn: getServiceDetailsWasm,
+			},
+			manifest: `
+			manifest_version = 2
+			name = "package"
+			[scripts]
+			pre_deploy = "sleep 3"
+			`,
+			wantError: "error running [scripts.pre_deploy]",
+			dontWantOutput: []string{
+				"Uploading package",
+			},
+		},
+		{
+			name: "post_deploy script error doesn't roll back a staged deployment",
+			args: args("compute deploy --service-id 123 --token 123 --staged --auto-yes"),
+			api: mock.API{
+				ActivateVersionFn:   activateVersionOk,
+				CloneVersionFn:      testutil.CloneVersionResult(4),
+				GetPackageFn:        getPackageOk,
+				GetServiceDetailsFn: getServiceDetailsWasm,
+				GetServiceFn:        getServiceOK,
+				ListDomainsFn:       listDomainsOk,
+				ListVersionsFn:      testutil.ListVersions,
+				UpdatePackageFn:     updatePackageOk,
+				ValidateVersionFn:   validateVersionOk,
+			},
+			httpClientRes: []*http.Response{
+				{
+					Body:       io.NopCloser(strings.NewReader("success")),
+					Status:     http.StatusText(http.StatusOK),
+					StatusCode: http.StatusOK,
+				},
+			},
+			httpClientErr: []error{
+				nil,
+			},
+			manifest: `
+			manifest_version = 2
+			name = "package"
+			[scripts]
+			post_deploy = "exit 1"
+			`,
+			wantError: "error running [scripts.post_deploy]",
+			wantOutput: []string{
+				"Deployed package (service 123, version 4)",
+			},
+			dontWantOutput: []string{
+				"Rolling back",
+			},
+		},
 		// The following test doesn't provide a Service ID by either a flag nor the
 		// manifest, so this will result in the deploy script attempting to create
 		// a new service. Our fastly.toml is configured with a [setup] section so
@@ -1222,6 +1473,110 @@ func TestDeploy(t *testing.T) {
 				"Creating config store item 'bar'",
 			},
 		},
+		{
+			name: "success with setup configuration, existing service and --sync-setup",
+			args: args("compute deploy --service-id 123 --token 123 --sync-setup --auto-yes --non-interactive"),
+			api: mock.API{
+				ActivateVersionFn:       activateVersionOk,
+				CloneVersionFn:          testutil.CloneVersionResult(4),
+				CreateBackendFn:         createBackendOK,
+				CreateConfigStoreFn:     createConfigStoreOK,
+				CreateResourceFn:        createResourceOK,
+				GetPackageFn:            getPackageOk,
+				GetServiceDetailsFn:     getServiceDetailsWasm,
+				GetServiceFn:            getServiceOK,
+				ListBackendsFn:          listBackendsChanged,
+				ListConfigStoresFn:      listConfigStoresEmpty,
+				ListDomainsFn:           listDomainsOk,
+				ListResourcesFn:         listResourcesEmpty,
+				ListVersionsFn:          testutil.ListVersions,
+				UpdateBackendFn:         updateBackendOK,
+				UpdateConfigStoreItemFn: updateConfigStoreItemOK,
+				UpdatePackageFn:         updatePackageOk,
+			},
+			httpClientRes: []*http.Response{
+				{
+					Body:       io.NopCloser(strings.NewReader("success")),
+					Status:     http.StatusText(http.StatusOK),
+					StatusCode: http.StatusOK,
+				},
+			},
+			httpClientErr: []error{
+				nil,
+			},
+			manifest: `
+			name = "package"
+			manifest_version = 2
+			language = "rust"
+
+			[setup.backends.fastly]
+			address = "fastly.com"
+			port = 443
+			[setup.backends.google]
+			address = "google.com"
+			port = 443
+
+			[setup.config_stores.example]
+			[setup.config_stores.example.items.foo]
+			value = "my default value for foo"
+			`,
+			wantOutput: []string{
+				"Creating backend 'google' (host: google.com, port: 443)",
+				"Creating config store 'example'",
+				"Creating config store item 'foo'",
+				"Creating resource link between service and config store 'example'",
+				"Updating backend 'fastly' (host: fastly.com, port: 443)",
+				"Uploading package",
+				"Activating service",
+				"SUCCESS: Deployed package (service 123, version 4)",
+			},
+			dontWantOutput: []string{
+				"Processing of the fastly.toml [setup] configuration happens only for a new service",
+				"Creating backend 'fastly'",
+			},
+		},
+		{
+			name: "success with setup configuration, existing service and --sync-setup but no --auto-yes",
+			args: args("compute deploy --service-id 123 --token 123 --sync-setup --non-interactive"),
+			api: mock.API{
+				ActivateVersionFn:   activateVersionOk,
+				CloneVersionFn:      testutil.CloneVersionResult(4),
+				CreateBackendFn:     createBackendOK,
+				GetPackageFn:        getPackageOk,
+				GetServiceDetailsFn: getServiceDetailsWasm,
+				GetServiceFn:        getServiceOK,
+				ListBackendsFn:      listBackendsChanged,
+				ListDomainsFn:       listDomainsOk,
+				ListVersionsFn:      testutil.ListVersions,
+				UpdatePackageFn:     updatePackageOk,
+			},
+			httpClientRes: []*http.Response{
+				{
+					Body:       io.NopCloser(strings.NewReader("success")),
+					Status:     http.StatusText(http.StatusOK),
+					StatusCode: http.StatusOK,
+				},
+			},
+			httpClientErr: []error{
+				nil,
+			},
+			manifest: `
+			name = "package"
+			manifest_version = 2
+			language = "rust"
+
+			[setup.backends.fastly]
+			address = "fastly.com"
+			port = 443
+			`,
+			wantOutput: []string{
+				"Skipping update of backend 'fastly'",
+				"SUCCESS: Deployed package (service 123, version 4)",
+			},
+			dontWantOutput: []string{
+				"Updating backend 'fastly'",
+			},
+		},
 		{
 			name: "success with setup.config_stores configuration and no existing service",
 			args: args("compute deploy --token 123"),
@@ -2298,6 +2653,10 @@ func getPackageIdentical(i *fastly.GetPackageInput) (*fastly.Package, error) {
 	}, nil
 }
 
+func validateVersionOk(_ *fastly.ValidateVersionInput) (bool, string, error) {
+	return true, "", nil
+}
+
 func activateVersionError(_ *fastly.ActivateVersionInput) (*fastly.Version, error) {
 	return nil, testutil.Err
 }
@@ -2309,3 +2668,28 @@ func listDomainsError(_ *fastly.ListDomainsInput) ([]*fastly.Domain, error) {
 func listDomainsNone(_ *fastly.ListDomainsInput) ([]*fastly.Domain, error) {
 	return []*fastly.Domain{}, nil
 }
+
+func listBackendsChanged(i *fastly.ListBackendsInput) ([]*fastly.Backend, error) {
+	return []*fastly.Backend{
+		{
+			Address:        "fastly.net",
+			Name:           "fastly",
+			Port:           443,
+			ServiceID:      i.ServiceID,
+			ServiceVersion: i.ServiceVersion,
+		},
+	}, nil
+}
+
+func updateBackendOK(i *fastly.UpdateBackendInput) (*fastly.Backend, error) {
+	return &fastly.Backend{
+		Address:        *i.Address,
+		Name:           i.Name,
+		ServiceID:      i.ServiceID,
+		ServiceVersion: i.ServiceVersion,
+	}, nil
+}
+
+func listResourcesEmpty(_ *fastly.ListResourcesInput) ([]*fastly.Resource, error) {
+	return []*fastly.Resource{}, nil
+}
@@ -0,0 +1,500 @@
+package compute
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	fsterr "github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/revision"
+	"github.com/fastly/cli/pkg/text"
+)
+
+// HARVersion is the version of the HTTP Archive format that is written.
+// http://www.softwareishard.com/blog/har-12-spec/
+const HARVersion = "1.2"
+
+// harCompareHeaders are the response headers compared when replaying a HAR
+// file. Other headers (e.g. Date) are expected to change between requests.
+var harCompareHeaders = []string{"Content-Type", "Location"}
+
+// HAR represents an HTTP Archive file.
+type HAR struct {
+	Log HARLog `json:"log"`
+}
+
+// HARLog is the root of the HTTP Archive data.
+type HARLog struct {
+	Creator HARCreator `json:"creator"`
+	Entries []HAREntry `json:"entries"`
+	Version string     `json:"version"`
+}
+
+// HARCreator identifies the application that created the HTTP Archive.
+type HARCreator struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
+
+// HAREntry is a recorded request and response.
+type HAREntry struct {
+	Cache           struct{}    `json:"cache"`
+	Request         HARRequest  `json:"request"`
+	Response        HARResponse `json:"response"`
+	StartedDateTime time.Time   `json:"startedDateTime"`
+	Time            float64     `json:"time"`
+	Timings         HARTimings  `json:"timings"`
+}
+
+// HARRequest is a recorded request.
+type HARRequest struct {
+	BodySize    int            `json:"bodySize"`
+	Cookies     []HARNameValue `json:"cookies"`
+	Headers     []HARNameValue `json:"headers"`
+	HeadersSize int            `json:"headersSize"`
+	HTTPVersion string         `json:"httpVersion"`
+	Method      string         `json:"method"`
+	PostData    *HARPostData   `json:"postData,omitempty"`
+	QueryString []HARNameValue `json:"queryString"`
+	URL         string         `json:"url"`
+}
+
+// HARResponse is a recorded response.
+type HARResponse struct {
+	BodySize    int            `json:"bodySize"`
+	Content     HARContent     `json:"content"`
+	Cookies     []HARNameValue `json:"cookies"`
+	Headers     []HARNameValue `json:"headers"`
+	HeadersSize int            `json:"headersSize"`
+	HTTPVersion string         `json:"httpVersion"`
+	RedirectURL string         `json:"redirectURL"`
+	Status      int            `json:"status"`
+	StatusText  string         `json:"statusText"`
+}
+
+// HARNameValue is a header, cookie or query string parameter.
+type HARNameValue struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// HARPostData is a recorded request body.
+type HARPostData struct {
+	Encoding string `json:"encoding,omitempty"`
+	MimeType string `json:"mimeType"`
+	Text     string `json:"text"`
+}
+
+// HARContent is a recorded response body.
+type HARContent struct {
+	Encoding string `json:"encoding,omitempty"`
+	MimeType string `json:"mimeType"`
+	Size     int    `json:"size"`
+	Text     string `json:"text"`
+}
+
+// HARTimings describes the time spent on each phase of the request.
+//
+// NOTE: The time spent sending the request and receiving the response isn't
+// measured separately, so it's all recorded as waiting time.
+type HARTimings struct {
+	Receive float64 `json:"receive"`
+	Send    float64 `json:"send"`
+	Wait    float64 `json:"wait"`
+}
+
+// ReadHAR reads the HTTP Archive file.
+func ReadHAR(path string) (HAR, error) {
+	var h HAR
+
+	// gosec flagged this:
+	// G304 (CWE-22): Potential file inclusion via variable
+	// Disabling as we need to load the HAR file provided by the user.
+	// #nosec
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return h, err
+	}
+	if err := json.Unmarshal(data, &h); err != nil {
+		return h, fmt.Errorf("error parsing HAR file '%s': %w", path, err)
+	}
+	if len(h.Log.Entries) == 0 {
+		return h, fmt.Errorf("no entries recorded in '%s'", path)
+	}
+	return h, nil
+}
+
+// Write persists the HTTP Archive to disk.
+func (h HAR) Write(path string) error {
+	data, err := json.MarshalIndent(h, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0o600)
+}
+
+// harRecorder is a reverse proxy, listening in front of the local server,
+// which records every request and response into a HAR file.
+type harRecorder struct {
+	errLog fsterr.LogInterface
+	har    HAR
+	mu     sync.Mutex
+	path   string
+	server *http.Server
+}
+
+// newHARRecorder starts a reverse proxy listening on addr which forwards
+// requests to the local server listening on target.
+func newHARRecorder(path, addr, target string, errLog fsterr.LogInterface) (*harRecorder, error) {
+	l, err := net.Listen("tcp", addr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to listen on %s: %w", addr, err)
+	}
+
+	r := &harRecorder{
+		errLog: errLog,
+		har: HAR{
+			Log: HARLog{
+				Creator: HARCreator{Name: "fastly", Version: revision.AppVersion},
+				Entries: []HAREntry{},
+				Version: HARVersion,
+			},
+		},
+		path: path,
+	}
+	if err := r.har.Write(path); err != nil {
+		_ = l.Close()
+		return nil, fmt.Errorf("failed to write HAR file '%s': %w", path, err)
+	}
+
+	proxy := httputil.NewSingleHostReverseProxy(&url.URL{Scheme: "http", Host: target})
+	proxy.Transport = r
+	// NOTE: Requests that fail (e.g. the local server is restarting) aren't
+	// recorded and we avoid the proxy logging the error to the user's terminal.
+	proxy.ErrorHandler = func(w http.ResponseWriter, _ *http.Request, err error) {
+		r.errLog.Add(err)
+		w.WriteHeader(http.StatusBadGateway)
+	}
+	r.server = &http.Server{
+		Handler:           proxy,
+		ReadHeaderTimeout: 30 * time.Second,
+	}
+	go func() {
+		if err := r.server.Serve(l); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			r.errLog.Add(err)
+		}
+	}()
+	return r, nil
+}
+
+// RoundTrip implements http.RoundTripper.
+//
+// The request is forwarded to the local server and the exchange is appended to
+// the HAR file (which is rewritten after every request so no data is lost when
+// the user stops the local server).
+func (r *harRecorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	reqBody, err := readBody(&req.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	start := time.Now()
+	resp, err := http.DefaultTransport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := readBody(&resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	elapsed := float64(time.Since(start).Microseconds()) / 1000
+
+	u := url.URL{Scheme: "http", Host: req.Host, Path: req.URL.Path, RawQuery: req.URL.RawQuery}
+	entry := HAREntry{
+		Request: HARRequest{
+			BodySize:    len(reqBody),
+			Cookies:     []HARNameValue{},
+			Headers:     harHeaders(req.Header),
+			HeadersSize: -1,
+			HTTPVersion: req.Proto,
+			Method:      req.Method,
+			QueryString: harQuery(req.URL.Query()),
+			URL:         u.String(),
+		},
+		Response: HARResponse{
+			BodySize:    len(respBody),
+			Content:     harContent(respBody, resp.Header.Get("Content-Type")),
+			Cookies:     []HARNameValue{},
+			Headers:     harHeaders(resp.Header),
+			HeadersSize: -1,
+			HTTPVersion: resp.Proto,
+			RedirectURL: resp.Header.Get("Location"),
+			Status:      resp.StatusCode,
+			StatusText:  http.StatusText(resp.StatusCode),
+		},
+		StartedDateTime: start,
+		Time:            elapsed,
+		Timings:         HARTimings{Wait: elapsed},
+	}
+	if len(reqBody) > 0 {
+		c := harContent(reqBody, req.Header.Get("Content-Type"))
+		entry.Request.PostData = &HARPostData{Encoding: c.Encoding, MimeType: c.MimeType, Text: c.Text}
+	}
+
+	r.mu.Lock()
+	r.har.Log.Entries = append(r.har.Log.Entries, entry)
+	err = r.har.Write(r.path)
+	r.mu.Unlock()
+	if err != nil {
+		r.errLog.Add(err)
+	}
+	return resp, nil
+}
+
+// Close stops the reverse proxy.
+func (r *harRecorder) Close() error {
+	return r.server.Close()
+}
+
+// replayHAR sends each request recorded in the HAR file to the local server
+// and returns a description of how each response differs from the recording
+// (keyed by the entry index).
+func replayHAR(out io.Writer, addr string, h HAR) map[int][]string {
+	client := &http.Client{
+		Timeout: 30 * time.Second,
+		// NOTE: We don't follow redirects so they can be compared.
+		CheckRedirect: func(_ *http.Request, _ []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+
+	diffs := make(map[int][]string)
+	for i, e := range h.Log.Entries {
+		name := fmt.Sprintf("%s %s", e.Request.Method, e.Request.URL)
+		d := replayEntry(client, addr, e)
+		if len(d) == 0 {
+			text.Output(out, "%s %s", text.BoldGreen("SAME"), name)
+			continue
+		}
+		diffs[i] = d
+		text.Output(out, "%s %s", text.BoldRed("DIFF"), name)
+		for _, s := range d {
+			fmt.Fprintf(out, "     %s\n", s)
+		}
+	}
+	return diffs
+}
+
+// replayEntry sends the recorded request and compares the response.
+func replayEntry(client *http.Client, addr string, e HAREntry) []string {
+	u, err := url.Parse(e.Request.URL)
+	if err != nil {
+		return []string{fmt.Sprintf("failed to parse recorded URL: %s", err)}
+	}
+
+	var body []byte
+	if e.Request.PostData != nil {
+		body, err = harDecode(e.Request.PostData.Text, e.Request.PostData.Encoding)
+		if err != nil {
+			return []string{fmt.Sprintf("failed to decode recorded request body: %s", err)}
+		}
+	}
+	req, err := http.NewRequest(e.Request.Method, "http://"+addr+u.RequestURI(), bytes.NewReader(body))
+	if err != nil {
+		return []string{fmt.Sprintf("failed to construct request: %s", err)}
+	}
+	req.Host = u.Host
+	for _, h := range e.Request.Headers {
+		switch http.CanonicalHeaderKey(h.Name) {
+		case "Content-Length", "Host":
+			continue
+		}
+		req.Header.Add(h.Name, h.Value)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return []string{fmt.Sprintf("request failed: %s", err)}
+	}
+	defer resp.Body.Close() // #nosec G307
+
+	have, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return []string{fmt.Sprintf("failed to read response body: %s", err)}
+	}
+	want, err := harDecode(e.Response.Content.Text, e.Response.Content.Encoding)
+	if err != nil {
+		return []string{fmt.Sprintf("failed to decode recorded response body: %s", err)}
+	}
+
+	var diffs []string
+	if resp.StatusCode != e.Response.Status {
+		diffs = append(diffs, fmt.Sprintf("status: recorded %d, replayed %d", e.Response.Status, resp.StatusCode))
+	}
+	recorded := make(http.Header)
+	for _, h := range e.Response.Headers {
+		recorded.Add(h.Name, h.Value)
+	}
+	for _, k := range harCompareHeaders {
+		if w, h := recorded.Get(k), resp.Header.Get(k); w != h {
+			diffs = append(diffs, fmt.Sprintf("header %s: recorded %q, replayed %q", k, w, h))
+		}
+	}
+	if !bytes.Equal(want, have) {
+		diffs = append(diffs, bodyDiff(want, have))
+	}
+	return diffs
+}
+
+// bodyDiff describes the first line where the recorded and replayed response
+// bodies differ.
+func bodyDiff(want, have []byte) string {
+	if !utf8.Valid(want) || !utf8.Valid(have) {
+		return fmt.Sprintf("body: recorded %d bytes, replayed %d bytes of binary data", len(want), len(have))
+	}
+	wl := strings.Split(string(want), "\n")
+	hl := strings.Split(string(have), "\n")
+	for i := 0; i < len(wl) || i < len(hl); i++ {
+		var w, h string
+		if i < len(wl) {
+			w = wl[i]
+		}
+		if i < len(hl) {
+			h = hl[i]
+		}
+		if w != h || i >= len(wl) || i >= len(hl) {
+			return fmt.Sprintf("body (line %d):\n       - %s\n       + %s", i+1, truncate(w), truncate(h))
+		}
+	}
+	return "body differs"
+}
+
+// readBody reads the body and replaces it with a copy so it can be read again.
+func readBody(body *io.ReadCloser) ([]byte, error) {
+	if *body == nil || *body == http.NoBody {
+		return nil, nil
+	}
+	data, err := io.ReadAll(*body)
+	_ = (*body).Close()
+	if err != nil {
+		return nil, err
+	}
+	*body = io.NopCloser(bytes.NewReader(data))
+	return data, nil
+}
+
+// harHeaders converts the headers into a sorted list of name/value pairs.
+//
+// NOTE: The X-Forwarded-For header added by the reverse proxy isn't recorded.
+func harHeaders(h http.Header) []HARNameValue {
+	keys := make([]string, 0, len(h))
+	for k := range h {
+		if k != "X-Forwarded-For" {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+
+	nv := []HARNameValue{}
+	for _, k := range keys {
+		for _, v := range h[k] {
+			nv = append(nv, HARNameValue{Name: k, Value: v})
+		}
+	}
+	return nv
+}
+
+// harQuery converts the query string into a sorted list of name/value pairs.
+func harQuery(q url.Values) []HARNameValue {
+	keys := make([]string, 0, len(q))
+	for k := range q {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	nv := []HARNameValue{}
+	for _, k := range keys {
+		for _, v := range q[k] {
+			nv = append(nv, HARNameValue{Name: k, Value: v})
+		}
+	}
+	return nv
+}
+
+// harContent records a body as text, or base64 encoded if it's binary data.
+func harContent(body []byte, mimeType string) HARContent {
+	c := HARContent{MimeType: mimeType, Size: len(body)}
+	if utf8.Valid(body) {
+		c.Text = string(body)
+	} else {
+		c.Encoding = "base64"
+		c.Text = base64.StdEncoding.EncodeToString(body)
+	}
+	return c
+}
+
+// harDecode returns the recorded body.
+func harDecode(s, encoding string) ([]byte, error) {
+	if encoding == "base64" {
+		return base64.StdEncoding.DecodeString(s)
+	}
+	return []byte(s), nil
+}
+
+// replay runs the local server and compares its responses with those recorded
+// in the HAR file.
+func (c *ServeCommand) replay(spinner text.Spinner, bin, manifestPath string, out io.Writer) error {
+	h, err := ReadHAR(c.replayHAR)
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]any{
+			"HAR file": c.replayHAR,
+		})
+		if errors.Is(err, os.ErrNotExist) {
+			return fsterr.RemediationError{
+				Inner:       fmt.Errorf("failed to read HAR file: %w", err),
+				Remediation: "Record the requests to replay by running `fastly compute serve --record-har <FILE>` and sending requests to the local server.",
+			}
+		}
+		return err
+	}
+
+	addr, err := availableAddr()
+	if err != nil {
+		return fmt.Errorf("failed to find an available port for the local server: %w", err)
+	}
+	srv, err := startViceroy(spinner, c.Globals.ErrLog, bin, manifestPath, addr, c.file, c.startupTimeout)
+	if err != nil {
+		return err
+	}
+
+	text.Break(out)
+	diffs := replayHAR(out, addr, h)
+
+	if err := srv.stop(); err != nil {
+		c.Globals.ErrLog.Add(err)
+	}
+	if c.Globals.Verbose() {
+		text.Break(out)
+		text.Output(out, "%s:\n%s", text.BoldYellow("Viceroy output"), srv.output.String())
+	}
+
+	text.Break(out)
+	if len(diffs) > 0 {
+		return fmt.Errorf("%d of %d responses differ from the recording", len(diffs), len(h.Log.Entries))
+	}
+	text.Success(out, "All %d responses match the recording", len(h.Log.Entries))
+	return nil
+}
@@ -4,6 +4,7 @@ import (
 	"archive/tar"
 	"bytes"
 	"crypto/sha512"
+	"encoding/base64"
 	"errors"
 	"fmt"
 	"io"
@@ -33,6 +34,7 @@ type HashFilesCommand struct {
 	argparser.Base
 
 	// Build fields
+	cache                 argparser.OptionalBool
 	dir                   argparser.OptionalString
 	env                   argparser.OptionalString
 	includeSrc            argparser.OptionalBool
@@ -41,6 +43,7 @@ type HashFilesCommand struct {
 	metadataFilterEnvVars argparser.OptionalString
 	metadataShow          argparser.OptionalBool
 	packageName           argparser.OptionalString
+	signKey               argparser.OptionalString
 	timeout               argparser.OptionalInt
 
 	buildCmd  *BuildCommand
@@ -54,6 +57,7 @@ func NewHashFilesCommand(parent argparser.Registerer, g *global.Data, build *Bui
 	c.buildCmd = build
 	c.Globals = g
 	c.CmdClause = parent.Command("hash-files", "Generate a SHA512 digest from the contents of the Compute package")
+	c.CmdClause.Flag("cache", "Use the build cache to skip compiling a project that hasn't changed since the last build (use --no-cache to force a rebuild)").Action(c.cache.Set).NegatableBoolVar(&c.cache.Value)
 	c.CmdClause.Flag("dir", "Project directory to build (default: current directory)").Short('C').Action(c.dir.Set).StringVar(&c.dir.Value)
 	c.CmdClause.Flag("env", "The manifest environment config to use (e.g. 'stage' will attempt to read 'fastly.stage.toml')").Action(c.env.Set).StringVar(&c.env.Value)
 	c.CmdClause.Flag("include-source", "Include source code in built package").Action(c.includeSrc.Set).BoolVar(&c.includeSrc.Value)
@@ -63,6 +67,7 @@ func NewHashFilesCommand(parent argparser.Registerer, g *global.Data, build *Bui
 	c.CmdClause.Flag("metadata-show", "Inspect the Wasm binary metadata").Action(c.metadataShow.Set).BoolVar(&c.metadataShow.Value)
 	c.CmdClause.Flag("package", "Path to a package tar.gz").Short('p').StringVar(&c.Package)
 	c.CmdClause.Flag("package-name", "Package name").Action(c.packageName.Set).StringVar(&c.packageName.Value)
+	c.CmdClause.Flag("sign-key", "Path to a PEM encoded ed25519 private key used to produce a detached signature of the package (<package>.sig)").Action(c.signKey.Set).StringVar(&c.signKey.Value)
 	c.CmdClause.Flag("skip-build", "Skip the build step").BoolVar(&c.SkipBuild)
 	c.CmdClause.Flag("timeout", "Timeout, in seconds, for the build compilation step").Action(c.timeout.Set).IntVar(&c.timeout.Value)
 
@@ -136,6 +141,17 @@ func (c *HashFilesCommand) Exec(in io.Reader, out io.Writer) (err error) {
 	}
 
 	text.Output(out, hash)
+
+	// NOTE: The signature (see --sign-key) is displayed alongside the hash so
+	// both can be published together. It's computed over the SHA-512 digest
+	// of the package archive rather than the files hash.
+	sig, err := ReadPackageSignature(pkgPath)
+	if err == nil {
+		text.Output(out, "ed25519 signature: %s", base64.StdEncoding.EncodeToString(sig))
+	} else if !errors.Is(err, os.ErrNotExist) {
+		c.Globals.ErrLog.Add(err)
+		return err
+	}
 	return nil
 }
 
@@ -145,6 +161,9 @@ func (c *HashFilesCommand) Build(in io.Reader, out io.Writer) error {
 	if !c.Globals.Verbose() {
 		output = io.Discard
 	}
+	if c.cache.WasSet {
+		c.buildCmd.Flags.NoCache = !c.cache.Value
+	}
 	if c.dir.WasSet {
 		c.buildCmd.Flags.Dir = c.dir.Value
 	}
@@ -160,6 +179,9 @@ func (c *HashFilesCommand) Build(in io.Reader, out io.Writer) error {
 	if c.packageName.WasSet {
 		c.buildCmd.Flags.PackageName = c.packageName.Value
 	}
+	if c.signKey.WasSet {
+		c.buildCmd.Flags.SignKey = c.signKey.Value
+	}
 	if c.timeout.WasSet {
 		c.buildCmd.Flags.Timeout = c.timeout.Value
 	}
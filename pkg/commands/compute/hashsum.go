@@ -22,6 +22,7 @@ type HashsumCommand struct {
 	argparser.Base
 
 	// Build fields
+	cache                 argparser.OptionalBool
 	dir                   argparser.OptionalString
 	env                   argparser.OptionalString
 	includeSrc            argparser.OptionalBool
@@ -30,6 +31,7 @@ type HashsumCommand struct {
 	metadataFilterEnvVars argparser.OptionalString
 	metadataShow          argparser.OptionalBool
 	packageName           argparser.OptionalString
+	signKey               argparser.OptionalString
 	timeout               argparser.OptionalInt
 
 	buildCmd    *BuildCommand
@@ -44,6 +46,7 @@ func NewHashsumCommand(parent argparser.Registerer, g *global.Data, build *Build
 	c.buildCmd = build
 	c.Globals = g
 	c.CmdClause = parent.Command("hashsum", "Generate a SHA512 digest from a Compute package").Hidden()
+	c.CmdClause.Flag("cache", "Use the build cache to skip compiling a project that hasn't changed since the last build (use --no-cache to force a rebuild)").Action(c.cache.Set).NegatableBoolVar(&c.cache.Value)
 	c.CmdClause.Flag("dir", "Project directory to build (default: current directory)").Short('C').Action(c.dir.Set).StringVar(&c.dir.Value)
 	c.CmdClause.Flag("env", "The manifest environment config to use (e.g. 'stage' will attempt to read 'fastly.stage.toml')").Action(c.env.Set).StringVar(&c.env.Value)
 	c.CmdClause.Flag("include-source", "Include source code in built package").Action(c.includeSrc.Set).BoolVar(&c.includeSrc.Value)
@@ -53,6 +56,7 @@ func NewHashsumCommand(parent argparser.Registerer, g *global.Data, build *Build
 	c.CmdClause.Flag("metadata-show", "Inspect the Wasm binary metadata").Action(c.metadataShow.Set).BoolVar(&c.metadataShow.Value)
 	c.CmdClause.Flag("package", "Path to a package tar.gz").Short('p').StringVar(&c.PackagePath)
 	c.CmdClause.Flag("package-name", "Package name").Action(c.packageName.Set).StringVar(&c.packageName.Value)
+	c.CmdClause.Flag("sign-key", "Path to a PEM encoded ed25519 private key used to produce a detached signature of the package (<package>.sig)").Action(c.signKey.Set).StringVar(&c.signKey.Value)
 	c.CmdClause.Flag("skip-build", "Skip the build step").BoolVar(&c.SkipBuild)
 	c.CmdClause.Flag("timeout", "Timeout, in seconds, for the build compilation step").Action(c.timeout.Set).IntVar(&c.timeout.Value)
 
@@ -149,6 +153,9 @@ func (c *HashsumCommand) Build(in io.Reader, out io.Writer) error {
 	} else {
 		text.Break(out)
 	}
+	if c.cache.WasSet {
+		c.buildCmd.Flags.NoCache = !c.cache.Value
+	}
 	if c.dir.WasSet {
 		c.buildCmd.Flags.Dir = c.dir.Value
 	}
@@ -164,6 +171,9 @@ func (c *HashsumCommand) Build(in io.Reader, out io.Writer) error {
 	if c.packageName.WasSet {
 		c.buildCmd.Flags.PackageName = c.packageName.Value
 	}
+	if c.signKey.WasSet {
+		c.buildCmd.Flags.SignKey = c.signKey.Value
+	}
 	if c.timeout.WasSet {
 		c.buildCmd.Flags.Timeout = c.timeout.Value
 	}
@@ -0,0 +1,269 @@
+package compute
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/kennygrant/sanitize"
+
+	"github.com/fastly/cli/pkg/argparser"
+	fsterr "github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/global"
+	"github.com/fastly/cli/pkg/manifest"
+	"github.com/fastly/cli/pkg/text"
+)
+
+// InspectCommand reports the size of the Wasm binary and package.
+type InspectCommand struct {
+	argparser.Base
+	argparser.JSONOutput
+
+	dir     string
+	env     string
+	file    argparser.OptionalString
+	pkgPath string
+	top     int
+}
+
+// NewInspectCommand returns a usable command registered under the parent.
+func NewInspectCommand(parent argparser.Registerer, g *global.Data) *InspectCommand {
+	var c InspectCommand
+	c.Globals = g
+	c.CmdClause = parent.Command("inspect", "Report the size of the Wasm binary and package, broken down by section, crate and function")
+	c.CmdClause.Flag("dir", "Project directory (default: current directory)").Short('C').StringVar(&c.dir)
+	c.CmdClause.Flag("env", "The manifest environment config to use (e.g. 'stage' will attempt to read 'fastly.stage.toml')").StringVar(&c.env)
+	c.CmdClause.Flag("file", "The Wasm file to inspect (default: bin/main.wasm)").Action(c.file.Set).StringVar(&c.file.Value)
+	c.RegisterFlagBool(c.JSONFlag()) // --json
+	c.CmdClause.Flag("package", "Path to a package tar.gz").Short('p').StringVar(&c.pkgPath)
+	c.CmdClause.Flag("top", "Number of crates and functions to list").Default("10").IntVar(&c.top)
+	return &c
+}
+
+// InspectResult is the JSON representation of `compute inspect`.
+type InspectResult struct {
+	SizeReport
+	PackagePath  string      `json:"package_path,omitempty"`
+	PackageLimit int64       `json:"package_limit"`
+	Previous     *SizeReport `json:"previous,omitempty"`
+	WasmPath     string      `json:"wasm_path"`
+}
+
+// Exec implements the command interface.
+func (c *InspectCommand) Exec(_ io.Reader, out io.Writer) error {
+	if c.Globals.Verbose() && c.JSONOutput.Enabled {
+		return fsterr.ErrInvalidVerboseJSONCombo
+	}
+
+	wd, err := os.Getwd()
+	if err != nil {
+		return fmt.Errorf("failed to get current working directory: %w", err)
+	}
+	defer func() {
+		_ = os.Chdir(wd)
+	}()
+	projectDir, err := ChangeProjectDirectory(c.dir)
+	if err != nil {
+		return err
+	}
+	if projectDir != "" && c.Globals.Verbose() {
+		text.Info(out, ProjectDirMsg, projectDir)
+	}
+
+	wasmPath := filepath.Clean(binWasmPath)
+	if c.file.WasSet {
+		wasmPath = c.file.Value
+	}
+	pkgPath := c.packagePath()
+
+	r, err := NewSizeReport(wasmPath, pkgPath)
+	if err != nil {
+		c.Globals.ErrLog.Add(err)
+		if errors.Is(err, os.ErrNotExist) {
+			return fsterr.RemediationError{
+				Inner:       fmt.Errorf("failed to read Wasm binary '%s': %w", wasmPath, err),
+				Remediation: "Run `fastly compute build` to produce the Wasm binary, or use --file to specify its location.",
+			}
+		}
+		return err
+	}
+	if r.PackageSize == 0 {
+		pkgPath = ""
+	}
+
+	// NOTE: A binary that wasn't produced by `compute build` (e.g. --file) isn't
+	// recorded, as it would otherwise be compared with the next build.
+	var previous *SizeReport
+	if !c.file.WasSet {
+		history, err := ReadSizeHistory()
+		if err != nil && !errors.Is(err, os.ErrNotExist) {
+			c.Globals.ErrLog.Add(err)
+		}
+		previous = history.Baseline(r.WasmHash)
+		history.Record(r)
+		if err := history.Write(); err != nil {
+			c.Globals.ErrLog.Add(err)
+		}
+	}
+
+	ok, err := c.WriteJSON(out, InspectResult{
+		SizeReport:   r,
+		PackagePath:  pkgPath,
+		PackageLimit: MaxPackageSize,
+		Previous:     previous,
+		WasmPath:     wasmPath,
+	})
+	if ok {
+		return err
+	}
+
+	c.print(out, r, previous, wasmPath, pkgPath)
+	return nil
+}
+
+// packagePath returns the path of the package archive: the --package flag,
+// otherwise the package named after the project in the manifest, otherwise
+// the only package within the pkg directory.
+func (c *InspectCommand) packagePath() string {
+	if c.pkgPath != "" {
+		return c.pkgPath
+	}
+
+	manifestFilename := EnvironmentManifest(c.env)
+	var m manifest.File
+	m.SetQuiet(true)
+	if err := m.Read(manifestFilename); err == nil && m.Name != "" {
+		return filepath.Join("pkg", fmt.Sprintf("%s.tar.gz", sanitize.BaseName(m.Name)))
+	}
+
+	matches, err := filepath.Glob(filepath.Join("pkg", "*.tar.gz"))
+	if err == nil && len(matches) == 1 {
+		return matches[0]
+	}
+	return ""
+}
+
+// print displays the size report, comparing it with the previous build.
+func (c *InspectCommand) print(out io.Writer, r SizeReport, previous *SizeReport, wasmPath, pkgPath string) {
+	var prevWasm, prevPkg int64
+	if previous != nil {
+		prevWasm, prevPkg = previous.WasmSize, previous.PackageSize
+	}
+
+	text.Output(out, "Wasm binary: %s (%s%s)", wasmPath, formatSize(r.WasmSize), formatChange(r.WasmSize, prevWasm, previous != nil, ", "))
+	if pkgPath == "" {
+		text.Output(out, "Package: not found (run `fastly compute build` to create it)")
+	} else {
+		limit := float64(r.PackageSize) / float64(MaxPackageSize) * 100
+		text.Output(out, "Package: %s (%s%s, %.1f%% of the %s limit)", pkgPath, formatSize(r.PackageSize), formatChange(r.PackageSize, prevPkg, previous != nil && prevPkg > 0, ", "), limit, formatSize(MaxPackageSize))
+	}
+	if previous == nil {
+		text.Info(out, "\nThere is no previous build to compare with.")
+	}
+	text.Break(out)
+
+	printSizeTable(out, "SECTION", r.Sections, sizeMap(previous, func(p *SizeReport) []SizeEntry { return p.Sections }), r.WasmSize, 0)
+	if len(r.CustomSections) > 0 {
+		text.Break(out)
+		printSizeTable(out, "CUSTOM SECTION", r.CustomSections, sizeMap(previous, func(p *SizeReport) []SizeEntry { return p.CustomSections }), r.WasmSize, 0)
+	}
+
+	if len(r.Functions) == 0 {
+		text.Info(out, "\nThe Wasm binary has no \"name\" section, so its size can't be broken down by crate or function.")
+	} else {
+		text.Break(out)
+		printSizeTable(out, "CRATE", r.Crates, sizeMap(previous, func(p *SizeReport) []SizeEntry { return p.Crates }), r.WasmSize, c.top)
+		text.Break(out)
+		printSizeTable(out, "FUNCTION", r.Functions, nil, r.WasmSize, c.top)
+	}
+
+	if pkgPath != "" {
+		switch {
+		case r.PackageSize > MaxPackageSize:
+			text.Warning(out, "\nThe package exceeds the %s package size limit. %s", formatSize(MaxPackageSize), fsterr.PackageSizeRemediation)
+		case float64(r.PackageSize) >= float64(MaxPackageSize)*PackageSizeWarnThreshold:
+			text.Warning(out, "\nThe package is approaching the %s package size limit. %s", formatSize(MaxPackageSize), fsterr.PackageSizeRemediation)
+		}
+	}
+}
+
+// printSizeTable displays the entries (limited to top when non-zero) with
+// their proportion of the total size and, when previous sizes are available,
+// the change since the previous build.
+func printSizeTable(out io.Writer, header string, entries []SizeEntry, previous map[string]int64, total int64, top int) {
+	headers := []any{header, "SIZE", "%"}
+	if previous != nil {
+		headers = append(headers, "CHANGE")
+	}
+
+	tw := text.NewTable(out)
+	tw.AddHeader(headers...)
+	for i, e := range entries {
+		if top > 0 && i == top {
+			break
+		}
+		line := []any{e.Name, formatSize(e.Size), fmt.Sprintf("%.1f", float64(e.Size)/float64(total)*100)}
+		if previous != nil {
+			prev, ok := previous[e.Name]
+			if ok {
+				line = append(line, formatChange(e.Size, prev, true, ""))
+			} else {
+				line = append(line, "new")
+			}
+		}
+		tw.AddLine(line...)
+	}
+	tw.Print()
+
+	if top > 0 && len(entries) > top {
+		text.Output(out, "... and %d more", len(entries)-top)
+	}
+}
+
+// sizeMap returns the entries selected from the previous build keyed by name
+// (nil if there's no previous build).
+func sizeMap(previous *SizeReport, entries func(*SizeReport) []SizeEntry) map[string]int64 {
+	if previous == nil {
+		return nil
+	}
+	m := make(map[string]int64)
+	for _, e := range entries(previous) {
+		m[e.Name] = e.Size
+	}
+	return m
+}
+
+// formatSize returns a human readable size using SI units, which is how the
+// package size limit is expressed.
+func formatSize(n int64) string {
+	const unit = 1000
+	if n < unit && n > -unit {
+		return fmt.Sprintf("%d B", n)
+	}
+	f := float64(n)
+	for _, suffix := range []string{"KB", "MB", "GB"} {
+		f /= unit
+		if f < unit && f > -unit {
+			return fmt.Sprintf("%.1f %s", f, suffix)
+		}
+	}
+	return fmt.Sprintf("%.1f TB", f/unit)
+}
+
+// formatChange returns the difference between the current and previous size
+// (prefixed by sep), or an empty string when there's nothing to compare with.
+func formatChange(current, previous int64, ok bool, sep string) string {
+	if !ok {
+		return ""
+	}
+	diff := current - previous
+	switch {
+	case diff > 0:
+		return sep + "+" + formatSize(diff)
+	case diff < 0:
+		return sep + "-" + formatSize(-diff)
+	}
+	return sep + "unchanged"
+}
@@ -0,0 +1,275 @@
+package compute_test
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/fastly/cli/pkg/app"
+	"github.com/fastly/cli/pkg/commands/compute"
+	"github.com/fastly/cli/pkg/global"
+	"github.com/fastly/cli/pkg/manifest"
+	"github.com/fastly/cli/pkg/testutil"
+)
+
+func TestInspect(t *testing.T) {
+	pwd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	args := testutil.Args
+	scenarios := []struct {
+		name           string
+		args           []string
+		wasm           []byte
+		previousWasm   []byte
+		pkgSize        int
+		maxPackageSize int64
+		wantError      string
+		wantOutput     []string
+		dontWantOutput []string
+	}{
+		{
+			name:    "report",
+			args:    args("compute inspect"),
+			wasm:    testWasmBinary(true, nil),
+			pkgSize: 500,
+			wantOutput: []string{
+				"Wasm binary: bin/main.wasm (",
+				"Package: pkg/package.tar.gz (500 B, 0.0% of the 100.0 MB limit)",
+				"There is no previous build to compare with.",
+				"SECTION",
+				"code",
+				"CUSTOM SECTION",
+				"name",
+				"CRATE",
+				"core",
+				"FUNCTION",
+				"core::fmt::write",
+				"main.main",
+			},
+			dontWantOutput: []string{
+				"CHANGE",
+				"env_log",
+				"WARNING",
+			},
+		},
+		{
+			name:         "compared with previous build",
+			args:         args("compute inspect"),
+			wasm:         testWasmBinary(true, []byte("producers")),
+			previousWasm: testWasmBinary(true, nil),
+			pkgSize:      500,
+			wantOutput: []string{
+				"CHANGE",
+				"(325 B, +14 B)",
+				"producers       14 B",
+				"new",
+				"unchanged",
+			},
+			dontWantOutput: []string{
+				"There is no previous build to compare with.",
+			},
+		},
+		{
+			name: "no name section",
+			args: args("compute inspect --top 1"),
+			wasm: testWasmBinary(false, nil),
+			wantOutput: []string{
+				"Package: not found",
+				"The Wasm binary has no \"name\" section",
+			},
+			dontWantOutput: []string{
+				"FUNCTION",
+			},
+		},
+		{
+			name:           "approaching the package size limit",
+			args:           args("compute inspect --top 1"),
+			wasm:           testWasmBinary(true, nil),
+			pkgSize:        900,
+			maxPackageSize: 1000,
+			wantOutput: []string{
+				"90.0% of the 1.0 KB limit",
+				"... and 1 more",
+				"WARNING: The package is approaching the 1.0 KB package size limit.",
+			},
+		},
+		{
+			name:           "exceeds the package size limit",
+			args:           args("compute inspect"),
+			wasm:           testWasmBinary(true, nil),
+			pkgSize:        1500,
+			maxPackageSize: 1000,
+			wantOutput: []string{
+				"WARNING: The package exceeds the 1.0 KB package size limit.",
+			},
+		},
+		{
+			name:    "json",
+			args:    args("compute inspect --json"),
+			wasm:    testWasmBinary(true, nil),
+			pkgSize: 500,
+			wantOutput: []string{
+				`"package_size": 500`,
+				`"package_path": "pkg/package.tar.gz"`,
+				`"name": "core::fmt::write"`,
+			},
+		},
+		{
+			name:      "missing Wasm binary",
+			args:      args("compute inspect"),
+			wantError: "failed to read Wasm binary 'bin/main.wasm'",
+		},
+		{
+			name:      "invalid Wasm binary",
+			args:      args("compute inspect"),
+			wasm:      []byte("not wasm"),
+			wantError: "not a Wasm module",
+		},
+	}
+
+	for _, testcase := range scenarios {
+		testcase := testcase
+		t.Run(testcase.name, func(t *testing.T) {
+			rootdir := testutil.NewEnv(testutil.EnvOpts{
+				T: t,
+				Write: []testutil.FileIO{
+					{Src: "manifest_version = 2\nname = \"package\"\n", Dst: manifest.Filename},
+				},
+			})
+			defer os.RemoveAll(rootdir)
+
+			if err := os.Chdir(rootdir); err != nil {
+				t.Fatal(err)
+			}
+			defer func() {
+				_ = os.Chdir(pwd)
+			}()
+
+			if testcase.maxPackageSize > 0 {
+				original := compute.MaxPackageSize
+				compute.MaxPackageSize = testcase.maxPackageSize
+				defer func() {
+					compute.MaxPackageSize = original
+				}()
+			}
+
+			if err := os.MkdirAll("bin", 0o750); err != nil {
+				t.Fatal(err)
+			}
+			if testcase.pkgSize > 0 {
+				if err := os.MkdirAll("pkg", 0o750); err != nil {
+					t.Fatal(err)
+				}
+				if err := os.WriteFile(filepath.Join("pkg", "package.tar.gz"), make([]byte, testcase.pkgSize), 0o600); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			// The previous build is recorded by inspecting it.
+			if testcase.previousWasm != nil {
+				if err := os.WriteFile(filepath.Join("bin", "main.wasm"), testcase.previousWasm, 0o600); err != nil {
+					t.Fatal(err)
+				}
+				if err := runInspect(args("compute inspect"), io.Discard); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if testcase.wasm != nil {
+				if err := os.WriteFile(filepath.Join("bin", "main.wasm"), testcase.wasm, 0o600); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			var stdout bytes.Buffer
+			err := runInspect(testcase.args, &stdout)
+
+			t.Log(stdout.String())
+
+			testutil.AssertErrorContains(t, err, testcase.wantError)
+			for _, s := range testcase.wantOutput {
+				testutil.AssertStringContains(t, stdout.String(), s)
+			}
+			for _, s := range testcase.dontWantOutput {
+				testutil.AssertStringDoesntContain(t, stdout.String(), s)
+			}
+
+			if testcase.wantError == "" {
+				if _, err := os.Stat(compute.SizeHistoryFile); err != nil {
+					t.Errorf("expected size history to be recorded: %v", err)
+				}
+			}
+		})
+	}
+}
+
+func runInspect(args []string, out io.Writer) error {
+	opts := testutil.MockGlobalData(args, out)
+	app.Init = func(_ []string, _ io.Reader) (*global.Data, error) {
+		return opts, nil
+	}
+	return app.Run(args, nil)
+}
+
+// testWasmBinary returns a Wasm module importing one function and defining
+// two (a mangled Rust function and a Go function), optionally with a "name"
+// section and an additional custom section.
+func testWasmBinary(names bool, custom []byte) []byte {
+	uleb := func(n int) []byte {
+		var b []byte
+		for {
+			c := byte(n & 0x7f)
+			n >>= 7
+			if n != 0 {
+				b = append(b, c|0x80)
+				continue
+			}
+			return append(b, c)
+		}
+	}
+	str := func(s string) []byte {
+		return append(uleb(len(s)), s...)
+	}
+	section := func(id byte, content []byte) []byte {
+		return append(append([]byte{id}, uleb(len(content))...), content...)
+	}
+
+	wasm := []byte{0x00, 0x61, 0x73, 0x6d, 0x01, 0x00, 0x00, 0x00}
+	wasm = append(wasm, section(1, []byte{0x01, 0x60, 0x00, 0x00})...)
+	imports := append([]byte{0x01}, str("env")...)
+	imports = append(imports, str("log")...)
+	imports = append(imports, 0x00, 0x00)
+	wasm = append(wasm, section(2, imports)...)
+	wasm = append(wasm, section(3, []byte{0x02, 0x00, 0x00})...)
+
+	// The first function body is 200 bytes (nop instructions) so its size
+	// prefix is a multi-byte integer.
+	large := append([]byte{0x00}, bytes.Repeat([]byte{0x01}, 198)...)
+	large = append(large, 0x0b)
+	code := []byte{0x02}
+	code = append(code, uleb(len(large))...)
+	code = append(code, large...)
+	code = append(code, 0x02, 0x00, 0x0b)
+	wasm = append(wasm, section(10, code)...)
+
+	if names {
+		funcNames := []byte{0x03}
+		for i, name := range []string{"env_log", "_ZN4core3fmt5write17h0123456789abcdefE", "main.main"} {
+			funcNames = append(funcNames, uleb(i)...)
+			funcNames = append(funcNames, str(name)...)
+		}
+		content := str("name")
+		content = append(content, 0x01)
+		content = append(content, uleb(len(funcNames))...)
+		content = append(content, funcNames...)
+		wasm = append(wasm, section(0, content)...)
+	}
+	if custom != nil {
+		wasm = append(wasm, section(0, append(str(string(custom)), 0x00, 0x00))...)
+	}
+	return wasm
+}
@@ -19,6 +19,7 @@ import (
 // PackCommand takes a .wasm and builds the required tar/gzip package ready to be uploaded.
 type PackCommand struct {
 	argparser.Base
+	signKey    string
 	wasmBinary string
 }
 
@@ -27,6 +28,7 @@ func NewPackCommand(parent argparser.Registerer, g *global.Data) *PackCommand {
 	var c PackCommand
 	c.Globals = g
 	c.CmdClause = parent.Command("pack", "Package a pre-compiled Wasm binary for a Fastly Compute service")
+	c.CmdClause.Flag("sign-key", "Path to a PEM encoded ed25519 private key used to produce a detached signature of the package (<package>.sig)").StringVar(&c.signKey)
 	c.CmdClause.Flag("wasm-binary", "Path to a pre-compiled Wasm binary").Short('w').Required().StringVar(&c.wasmBinary)
 
 	return &c
@@ -116,7 +118,7 @@ func (c *PackCommand) Exec(_ io.Reader, out io.Writer) (err error) {
 		return err
 	}
 
-	return spinner.Process("Creating package.tar.gz file", func(_ *text.SpinnerWrapper) error {
+	err = spinner.Process("Creating package.tar.gz file", func(_ *text.SpinnerWrapper) error {
 		tar := archiver.NewTarGz()
 		tar.OverwriteExisting = true
 		{
@@ -133,4 +135,18 @@ func (c *PackCommand) Exec(_ io.Reader, out io.Writer) (err error) {
 		}
 		return nil
 	})
+	if err != nil {
+		return err
+	}
+
+	// NOTE: A signature from a previous package no longer matches the package.
+	pkg := "pkg/package.tar.gz"
+	_ = os.Remove(SignatureFilePath(pkg))
+	if c.signKey == "" {
+		return nil
+	}
+	return spinner.Process("Signing package", func(_ *text.SpinnerWrapper) error {
+		_, err := SignPackage(pkg, c.signKey)
+		return err
+	})
 }
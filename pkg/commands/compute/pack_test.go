@@ -2,14 +2,22 @@ package compute_test
 
 import (
 	"bytes"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
 	"io"
 	"os"
 	"path/filepath"
 	"testing"
 
+	"github.com/fastly/go-fastly/v8/fastly"
+
 	"github.com/fastly/cli/pkg/app"
+	"github.com/fastly/cli/pkg/commands/compute"
 	"github.com/fastly/cli/pkg/global"
 	"github.com/fastly/cli/pkg/manifest"
+	"github.com/fastly/cli/pkg/mock"
 	"github.com/fastly/cli/pkg/testutil"
 )
 
@@ -105,3 +113,163 @@ func TestPack(t *testing.T) {
 		})
 	}
 }
+
+func TestPackSigning(t *testing.T) {
+	pwd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	otherPub, _, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rootdir := testutil.NewEnv(testutil.EnvOpts{
+		T: t,
+		Copy: []testutil.FileIO{
+			{Src: filepath.Join("testdata", "pack", "main.wasm"), Dst: "main.wasm"},
+		},
+		Write: []testutil.FileIO{
+			{Src: "manifest_version = 2\nname = \"package\"\nservice_id = \"123\"\n", Dst: manifest.Filename},
+			{Src: string(encodePEM(t, "PRIVATE KEY", priv)), Dst: "signing-key.pem"},
+			{Src: string(encodePEM(t, "PUBLIC KEY", pub)), Dst: "verify-key.pem"},
+			{Src: string(encodePEM(t, "PUBLIC KEY", otherPub)), Dst: "other-key.pem"},
+			{Src: "not a key", Dst: "invalid-key.pem"},
+		},
+	})
+	defer os.RemoveAll(rootdir)
+
+	if err := os.Chdir(rootdir); err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		_ = os.Chdir(pwd)
+	}()
+
+	args := testutil.Args
+	sigPath := compute.SignatureFilePath(filepath.Join("pkg", "package.tar.gz"))
+	scenarios := []struct {
+		name            string
+		args            []string
+		modifyPackage   bool
+		removeSignature bool
+		wantError       string
+		wantOutput      []string
+	}{
+		{
+			name:      "invalid signing key",
+			args:      args("compute pack --wasm-binary ./main.wasm --sign-key invalid-key.pem"),
+			wantError: "invalid key 'invalid-key.pem': no PEM data found",
+		},
+		{
+			name: "sign package",
+			args: args("compute pack --wasm-binary ./main.wasm --sign-key signing-key.pem"),
+			wantOutput: []string{
+				"Creating package.tar.gz file",
+				"Signing package",
+			},
+		},
+		{
+			name: "hash-files displays the signature",
+			args: args("compute hash-files --package pkg/package.tar.gz --metadata-disable"),
+			wantOutput: []string{
+				"ed25519 signature: ",
+			},
+		},
+		{
+			name: "deploy verifies the signature",
+			args: args("compute deploy --verify-key verify-key.pem --token 123"),
+			wantOutput: []string{
+				"Verifying package signature",
+			},
+			// The deploy continues past the verification.
+			wantError: "test error",
+		},
+		{
+			name:      "deploy with the wrong key",
+			args:      args("compute deploy --verify-key other-key.pem --token 123"),
+			wantError: "doesn't match the package",
+		},
+		{
+			name:          "deploy with a modified package",
+			args:          args("compute deploy --verify-key verify-key.pem --token 123"),
+			modifyPackage: true,
+			wantError:     "doesn't match the package",
+		},
+		{
+			name:            "deploy without a signature",
+			args:            args("compute deploy --verify-key verify-key.pem --token 123"),
+			removeSignature: true,
+			wantError:       "the package signature '" + sigPath + "' wasn't found",
+		},
+	}
+
+	for _, testcase := range scenarios {
+		testcase := testcase
+		t.Run(testcase.name, func(t *testing.T) {
+			if testcase.modifyPackage {
+				// NOTE: Trailing data after the gzip stream leaves the package
+				// content (and so its files hash) unchanged.
+				f, err := os.OpenFile(filepath.Join("pkg", "package.tar.gz"), os.O_APPEND|os.O_WRONLY, 0o644)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if _, err := f.Write(make([]byte, 512)); err != nil {
+					t.Fatal(err)
+				}
+				if err := f.Close(); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if testcase.removeSignature {
+				if err := os.Remove(sigPath); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			var stdout bytes.Buffer
+			opts := testutil.MockGlobalData(testcase.args, &stdout)
+			opts.APIClientFactory = mock.APIClient(mock.API{
+				ListVersionsFn: func(_ *fastly.ListVersionsInput) ([]*fastly.Version, error) {
+					return nil, testutil.Err
+				},
+				GetServiceDetailsFn: func(_ *fastly.GetServiceInput) (*fastly.ServiceDetail, error) {
+					return nil, testutil.Err
+				},
+			})
+			app.Init = func(_ []string, _ io.Reader) (*global.Data, error) {
+				return opts, nil
+			}
+			err := app.Run(testcase.args, nil)
+
+			t.Log(stdout.String())
+
+			testutil.AssertErrorContains(t, err, testcase.wantError)
+			for _, s := range testcase.wantOutput {
+				testutil.AssertStringContains(t, stdout.String(), s)
+			}
+		})
+	}
+}
+
+// encodePEM returns the key PEM encoded in the format produced by openssl.
+func encodePEM(t *testing.T, blockType string, key any) []byte {
+	var (
+		der []byte
+		err error
+	)
+	if blockType == "PRIVATE KEY" {
+		der, err = x509.MarshalPKCS8PrivateKey(key)
+	} else {
+		der, err = x509.MarshalPKIXPublicKey(key)
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der})
+}
@@ -17,6 +17,7 @@ type PublishCommand struct {
 	deploy *DeployCommand
 
 	// Build fields
+	cache                 argparser.OptionalBool
 	dir                   argparser.OptionalString
 	includeSrc            argparser.OptionalBool
 	lang                  argparser.OptionalString
@@ -24,6 +25,7 @@ type PublishCommand struct {
 	metadataFilterEnvVars argparser.OptionalString
 	metadataShow          argparser.OptionalBool
 	packageName           argparser.OptionalString
+	signKey               argparser.OptionalString
 	timeout               argparser.OptionalInt
 
 	// Deploy fields
@@ -33,10 +35,13 @@ type PublishCommand struct {
 	pkg                argparser.OptionalString
 	serviceName        argparser.OptionalServiceNameID
 	serviceVersion     argparser.OptionalServiceVersion
+	staged             bool
 	statusCheckCode    int
 	statusCheckOff     bool
 	statusCheckPath    string
 	statusCheckTimeout int
+	syncSetup          bool
+	verifyKey          argparser.OptionalString
 }
 
 // NewPublishCommand returns a usable command registered under the parent.
@@ -47,6 +52,7 @@ func NewPublishCommand(parent argparser.Registerer, g *global.Data, build *Build
 	c.deploy = deploy
 	c.CmdClause = parent.Command("publish", "Build and deploy a Compute package to a Fastly service")
 
+	c.CmdClause.Flag("cache", "Use the build cache to skip compiling a project that hasn't changed since the last build (use --no-cache to force a rebuild)").Action(c.cache.Set).NegatableBoolVar(&c.cache.Value)
 	c.CmdClause.Flag("comment", "Human-readable comment").Action(c.comment.Set).StringVar(&c.comment.Value)
 	c.CmdClause.Flag("dir", "Project directory to build (default: current directory)").Short('C').Action(c.dir.Set).StringVar(&c.dir.Value)
 	c.CmdClause.Flag("domain", "The name of the domain associated to the package").Action(c.domain.Set).StringVar(&c.domain.Value)
@@ -70,17 +76,21 @@ func NewPublishCommand(parent argparser.Registerer, g *global.Data, build *Build
 		Description: argparser.FlagServiceDesc,
 		Dst:         &c.serviceName.Value,
 	})
+	c.CmdClause.Flag("sign-key", "Path to a PEM encoded ed25519 private key used to produce a detached signature of the package (<package>.sig)").Action(c.signKey.Set).StringVar(&c.signKey.Value)
+	c.CmdClause.Flag("staged", "Validate the new version before activating it, then re-activate the previous version if the service availability check fails within the --status-check-timeout window").BoolVar(&c.staged)
 	c.CmdClause.Flag("status-check-code", "Set the expected status response for the service availability check to the root path").IntVar(&c.statusCheckCode)
 	c.CmdClause.Flag("status-check-off", "Disable the service availability check").BoolVar(&c.statusCheckOff)
 	c.CmdClause.Flag("status-check-path", "Specify the URL path for the service availability check").Default("/").StringVar(&c.statusCheckPath)
 	c.CmdClause.Flag("status-check-timeout", "Set a timeout (in seconds) for the service availability check").Default("120").IntVar(&c.statusCheckTimeout)
+	c.CmdClause.Flag("sync-setup", "Create the [setup] resources missing from an existing service, and update backends whose address or port has changed").BoolVar(&c.syncSetup)
 	c.RegisterFlag(argparser.StringFlagOpts{
 		Name:        argparser.FlagVersionName,
 		Description: argparser.FlagVersionDesc,
 		Dst:         &c.serviceVersion.Value,
 		Action:      c.serviceVersion.Set,
 	})
-	c.CmdClause.Flag("timeout", "Timeout, in seconds, for the build compilation step").Action(c.timeout.Set).IntVar(&c.timeout.Value)
+	c.CmdClause.Flag("timeout", "Timeout, in seconds, for the build compilation step and the pre_deploy and post_deploy scripts").Action(c.timeout.Set).IntVar(&c.timeout.Value)
+	c.CmdClause.Flag("verify-key", "Path to a PEM encoded ed25519 public key used to verify the package signature (<package>.sig) before it's uploaded").Action(c.verifyKey.Set).StringVar(&c.verifyKey.Value)
 
 	return &c
 }
@@ -94,6 +104,9 @@ func NewPublishCommand(parent argparser.Registerer, g *global.Data, build *Build
 // the progress indicator.
 func (c *PublishCommand) Exec(in io.Reader, out io.Writer) (err error) {
 	// Reset the fields on the BuildCommand based on PublishCommand values.
+	if c.cache.WasSet {
+		c.build.Flags.NoCache = !c.cache.Value
+	}
 	if c.dir.WasSet {
 		c.build.Flags.Dir = c.dir.Value
 	}
@@ -109,6 +122,9 @@ func (c *PublishCommand) Exec(in io.Reader, out io.Writer) (err error) {
 	if c.packageName.WasSet {
 		c.build.Flags.PackageName = c.packageName.Value
 	}
+	if c.signKey.WasSet {
+		c.build.Flags.SignKey = c.signKey.Value
+	}
 	if c.timeout.WasSet {
 		c.build.Flags.Timeout = c.timeout.Value
 	}
@@ -130,6 +146,12 @@ func (c *PublishCommand) Exec(in io.Reader, out io.Writer) (err error) {
 
 	text.Break(out)
 
+	// NOTE: The key is resolved before changing to the project directory.
+	verifyKey, err := absPath(c.verifyKey.Value)
+	if err != nil {
+		return err
+	}
+
 	wd, err := os.Getwd()
 	if err != nil {
 		return fmt.Errorf("failed to get current working directory: %w", err)
@@ -170,6 +192,9 @@ func (c *PublishCommand) Exec(in io.Reader, out io.Writer) (err error) {
 	if c.comment.WasSet {
 		c.deploy.Comment = c.comment
 	}
+	if c.staged {
+		c.deploy.Staged = c.staged
+	}
 	if c.statusCheckCode > 0 {
 		c.deploy.StatusCheckCode = c.statusCheckCode
 	}
@@ -180,6 +205,15 @@ func (c *PublishCommand) Exec(in io.Reader, out io.Writer) (err error) {
 		c.deploy.StatusCheckTimeout = c.statusCheckTimeout
 	}
 	c.deploy.StatusCheckPath = c.statusCheckPath
+	if c.syncSetup {
+		c.deploy.SyncSetup = c.syncSetup
+	}
+	if c.timeout.WasSet {
+		c.deploy.Timeout = c.timeout.Value
+	}
+	if c.verifyKey.WasSet {
+		c.deploy.VerifyKey = verifyKey
+	}
 
 	err = c.deploy.Exec(in, out)
 	if err != nil {
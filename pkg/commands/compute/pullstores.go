@@ -0,0 +1,318 @@
+package compute
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/fastly/go-fastly/v8/fastly"
+
+	"github.com/fastly/cli/pkg/argparser"
+	"github.com/fastly/cli/pkg/commands/compute/setup"
+	"github.com/fastly/cli/pkg/manifest"
+	"github.com/fastly/cli/pkg/text"
+)
+
+// LocalStoresDir is the directory (relative to the project directory) that
+// `compute serve --pull-stores` writes the remote store contents to.
+const LocalStoresDir = "local-stores"
+
+// SecretPlaceholder is the value assigned to pulled [local_server.secret_stores]
+// entries, as secret values can't be read back from the Fastly API.
+const SecretPlaceholder = "PLACEHOLDER"
+
+// pulledStores is the content of the stores linked to a service.
+type pulledStores struct {
+	configStores map[string]manifest.LocalConfigStore
+	kvStores     map[string][]manifest.LocalKVStore
+	secretStores map[string][]manifest.LocalSecretStore
+	// placeholders is the number of secret store entries assigned a placeholder.
+	placeholders int
+}
+
+// PullStores downloads the contents of the config stores and KV stores linked
+// to the service version into LocalStoresDir and updates the [local_server]
+// section of the manifest to reference the downloaded files.
+//
+// Secret store entries are assigned SecretPlaceholder, unless the manifest
+// already defines a local value for the entry.
+//
+// NOTE: Stores defined in [local_server] that aren't linked to the service are
+// left untouched.
+func (c *ServeCommand) PullStores(manifestPath string, spinner text.Spinner, out io.Writer) error {
+	serviceID, source, flag, err := argparser.ServiceID(c.serviceName, *c.Globals.Manifest, c.Globals.APIClient, c.Globals.ErrLog)
+	if err != nil {
+		return err
+	}
+	if c.Globals.Verbose() {
+		argparser.DisplayServiceID(serviceID, flag, source, out)
+	}
+
+	serviceVersion, err := c.serviceVersion.Parse(serviceID, c.Globals.APIClient)
+	if err != nil {
+		return err
+	}
+
+	// NOTE: We read the manifest from disk rather than use the in-memory data.
+	// This avoids persisting in-memory modifications (e.g. the default
+	// override_host assigned to [local_server.backends]) back to the manifest.
+	var m manifest.File
+	m.SetErrLog(c.Globals.ErrLog)
+	m.SetOutput(out)
+	m.SetQuiet(true)
+	if err := m.Read(manifestPath); err != nil {
+		return fmt.Errorf("failed to parse manifest '%s': %w", manifestPath, err)
+	}
+
+	var links []*fastly.Resource
+	err = spinner.Process(fmt.Sprintf("Listing resources linked to service %s (version %d)", serviceID, serviceVersion.Number), func(_ *text.SpinnerWrapper) error {
+		links, err = c.Globals.APIClient.ListResources(&fastly.ListResourcesInput{
+			ServiceID:      serviceID,
+			ServiceVersion: serviceVersion.Number,
+		})
+		if err != nil {
+			c.Globals.ErrLog.AddWithContext(err, map[string]any{
+				"Service ID":      serviceID,
+				"Service Version": serviceVersion.Number,
+			})
+			return fmt.Errorf("error listing linked resources: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	projectDir := filepath.Dir(manifestPath)
+	ps := pulledStores{
+		configStores: make(map[string]manifest.LocalConfigStore),
+		kvStores:     make(map[string][]manifest.LocalKVStore),
+		secretStores: make(map[string][]manifest.LocalSecretStore),
+	}
+	if len(links) > 0 {
+		if err := c.pullConfigStores(links, projectDir, &ps, spinner); err != nil {
+			return err
+		}
+		if err := c.pullKVStores(links, projectDir, &ps, spinner); err != nil {
+			return err
+		}
+		if err := c.pullSecretStores(links, m.LocalServer.SecretStores, &ps, spinner); err != nil {
+			return err
+		}
+	}
+
+	total := len(ps.configStores) + len(ps.kvStores) + len(ps.secretStores)
+	if total == 0 {
+		text.Info(out, "Service %s (version %d) has no linked config, KV or secret stores.\n\n", serviceID, serviceVersion.Number)
+		return nil
+	}
+
+	ls := &m.LocalServer
+	if ls.ConfigStores == nil {
+		ls.ConfigStores = make(map[string]manifest.LocalConfigStore)
+	}
+	if ls.KVStores == nil {
+		ls.KVStores = make(map[string][]manifest.LocalKVStore)
+	}
+	if ls.SecretStores == nil {
+		ls.SecretStores = make(map[string][]manifest.LocalSecretStore)
+	}
+	for name, store := range ps.configStores {
+		ls.ConfigStores[name] = store
+	}
+	for name, entries := range ps.kvStores {
+		ls.KVStores[name] = entries
+	}
+	for name, entries := range ps.secretStores {
+		ls.SecretStores[name] = entries
+	}
+
+	if err := m.Write(manifestPath); err != nil {
+		c.Globals.ErrLog.Add(err)
+		return fmt.Errorf("error saving fastly.toml: %w", err)
+	}
+
+	// Keep the in-memory manifest consistent with what was written to disk.
+	c.Globals.Manifest.File.LocalServer.ConfigStores = ls.ConfigStores
+	c.Globals.Manifest.File.LocalServer.KVStores = ls.KVStores
+	c.Globals.Manifest.File.LocalServer.SecretStores = ls.SecretStores
+
+	text.Success(out, "Pulled %d config store(s), %d KV store(s) and %d secret store(s) into the [local_server] section of %s", len(ps.configStores), len(ps.kvStores), len(ps.secretStores), filepath.Base(manifestPath))
+	if ps.placeholders > 0 {
+		text.Warning(out, "Secret values can't be read from the Fastly API. %d secret store entries were assigned the value '%s', update them in the [local_server.secret_stores] section of %s to test with real values.", ps.placeholders, SecretPlaceholder, filepath.Base(manifestPath))
+	}
+	text.Break(out)
+	return nil
+}
+
+// pullConfigStores writes the items of each linked config store to a JSON file.
+func (c *ServeCommand) pullConfigStores(links []*fastly.Resource, projectDir string, ps *pulledStores, spinner text.Spinner) error {
+	linked := setup.LinkedStores(links, setup.StoreTypeConfig)
+	for _, name := range setup.SortedKeys(linked) {
+		storeID := linked[name]
+		err := spinner.Process(fmt.Sprintf("Pulling config store '%s'", name), func(_ *text.SpinnerWrapper) error {
+			items, err := c.Globals.APIClient.ListConfigStoreItems(&fastly.ListConfigStoreItemsInput{StoreID: storeID})
+			if err != nil {
+				c.Globals.ErrLog.AddWithContext(err, map[string]any{
+					"Store ID": storeID,
+				})
+				return fmt.Errorf("error listing items for config store '%s': %w", name, err)
+			}
+			contents := make(map[string]string, len(items))
+			for _, item := range items {
+				contents[item.Key] = item.Value
+			}
+			data, err := json.MarshalIndent(contents, "", "  ")
+			if err != nil {
+				return err
+			}
+
+			file := path.Join(LocalStoresDir, "config_stores", localStoreFilename(name)+".json")
+			if err := writeLocalStoreFile(projectDir, file, data); err != nil {
+				c.Globals.ErrLog.Add(err)
+				return fmt.Errorf("error writing config store '%s' contents: %w", name, err)
+			}
+			ps.configStores[name] = manifest.LocalConfigStore{
+				File:   file,
+				Format: "json",
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// pullKVStores writes the value of each key in a linked KV store to a file.
+//
+// NOTE: Each value is written to its own file as values can be binary data.
+func (c *ServeCommand) pullKVStores(links []*fastly.Resource, projectDir string, ps *pulledStores, spinner text.Spinner) error {
+	linked := setup.LinkedStores(links, setup.StoreTypeKV)
+	for _, name := range setup.SortedKeys(linked) {
+		storeID := linked[name]
+		err := spinner.Process(fmt.Sprintf("Pulling KV store '%s'", name), func(_ *text.SpinnerWrapper) error {
+			storeDir := path.Join(LocalStoresDir, "kv_stores", localStoreFilename(name))
+
+			// Remove previously pulled keys that may no longer exist.
+			if err := os.RemoveAll(filepath.Join(projectDir, filepath.FromSlash(storeDir))); err != nil {
+				c.Globals.ErrLog.Add(err)
+				return fmt.Errorf("error removing previously pulled kv store '%s' contents: %w", name, err)
+			}
+
+			var keys []string
+			p := c.Globals.APIClient.NewListKVStoreKeysPaginator(&fastly.ListKVStoreKeysInput{ID: storeID})
+			for p.Next() {
+				keys = append(keys, p.Keys()...)
+			}
+			if err := p.Err(); err != nil {
+				c.Globals.ErrLog.AddWithContext(err, map[string]any{
+					"Store ID": storeID,
+				})
+				return fmt.Errorf("error listing keys for kv store '%s': %w", name, err)
+			}
+			sort.Strings(keys)
+
+			entries := make([]manifest.LocalKVStore, 0, len(keys))
+			for _, key := range keys {
+				value, err := c.Globals.APIClient.GetKVStoreKey(&fastly.GetKVStoreKeyInput{ID: storeID, Key: key})
+				if err != nil {
+					c.Globals.ErrLog.AddWithContext(err, map[string]any{
+						"Store ID": storeID,
+						"Key":      key,
+					})
+					return fmt.Errorf("error getting key '%s' from kv store '%s': %w", key, name, err)
+				}
+				file := path.Join(storeDir, localStoreFilename(key))
+				if err := writeLocalStoreFile(projectDir, file, []byte(value)); err != nil {
+					c.Globals.ErrLog.Add(err)
+					return fmt.Errorf("error writing key '%s' from kv store '%s': %w", key, name, err)
+				}
+				entries = append(entries, manifest.LocalKVStore{Key: key, File: file})
+			}
+			ps.kvStores[name] = entries
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// pullSecretStores assigns placeholder values to the entries of each linked
+// secret store, preserving any values already defined locally.
+func (c *ServeCommand) pullSecretStores(links []*fastly.Resource, existing map[string][]manifest.LocalSecretStore, ps *pulledStores, spinner text.Spinner) error {
+	linked := setup.LinkedStores(links, setup.StoreTypeSecret)
+	for _, name := range setup.SortedKeys(linked) {
+		storeID := linked[name]
+		err := spinner.Process(fmt.Sprintf("Pulling secret store '%s'", name), func(_ *text.SpinnerWrapper) error {
+			local := make(map[string]manifest.LocalSecretStore)
+			for _, entry := range existing[name] {
+				local[entry.Key] = entry
+			}
+
+			var (
+				cursor  string
+				entries []manifest.LocalSecretStore
+			)
+			for {
+				o, err := c.Globals.APIClient.ListSecrets(&fastly.ListSecretsInput{ID: storeID, Cursor: cursor})
+				if err != nil {
+					c.Globals.ErrLog.AddWithContext(err, map[string]any{
+						"Store ID": storeID,
+					})
+					return fmt.Errorf("error listing secrets for secret store '%s': %w", name, err)
+				}
+				for _, s := range o.Data {
+					if entry, ok := local[s.Name]; ok {
+						entries = append(entries, entry)
+						continue
+					}
+					entries = append(entries, manifest.LocalSecretStore{Key: s.Name, Data: SecretPlaceholder})
+					ps.placeholders++
+				}
+				if cursor = o.Meta.NextCursor; cursor == "" {
+					break
+				}
+			}
+			sort.Slice(entries, func(i, j int) bool {
+				return entries[i].Key < entries[j].Key
+			})
+			ps.secretStores[name] = entries
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// localStoreFilename escapes a store name or key so it's safe to use as a
+// single path segment (e.g. a key containing a forward slash or a key named
+// '..' can't escape LocalStoresDir).
+func localStoreFilename(s string) string {
+	s = url.PathEscape(s)
+	if strings.HasPrefix(s, ".") {
+		s = "%2E" + s[1:]
+	}
+	return s
+}
+
+// writeLocalStoreFile writes data to the slash-separated file path relative to
+// the project directory.
+func writeLocalStoreFile(projectDir, file string, data []byte) error {
+	p := filepath.Join(projectDir, filepath.FromSlash(file))
+	if err := os.MkdirAll(filepath.Dir(p), 0o750); err != nil {
+		return err
+	}
+	return os.WriteFile(p, data, 0o600)
+}
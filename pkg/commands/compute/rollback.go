@@ -0,0 +1,216 @@
+package compute
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/fastly/go-fastly/v8/fastly"
+
+	"github.com/fastly/cli/pkg/argparser"
+	fsterr "github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/global"
+	"github.com/fastly/cli/pkg/text"
+)
+
+// RollbackCommand re-activates a previously active service version.
+type RollbackCommand struct {
+	argparser.Base
+
+	comment     argparser.OptionalString
+	serviceName argparser.OptionalServiceNameID
+	to          argparser.OptionalInt
+}
+
+// NewRollbackCommand returns a usable command registered under the parent.
+func NewRollbackCommand(parent argparser.Registerer, g *global.Data) *RollbackCommand {
+	var c RollbackCommand
+	c.Globals = g
+	c.CmdClause = parent.Command("rollback", "Re-activate the previously active version of a Compute service")
+
+	c.CmdClause.Flag("comment", "Human-readable comment to set on the re-activated version (e.g. the reason for the rollback)").Action(c.comment.Set).StringVar(&c.comment.Value)
+	c.RegisterFlag(argparser.StringFlagOpts{
+		Name:        argparser.FlagServiceIDName,
+		Description: argparser.FlagServiceIDDesc,
+		Dst:         &g.Manifest.Flag.ServiceID,
+		Short:       's',
+	})
+	c.RegisterFlag(argparser.StringFlagOpts{
+		Action:      c.serviceName.Set,
+		Name:        argparser.FlagServiceName,
+		Description: argparser.FlagServiceDesc,
+		Dst:         &c.serviceName.Value,
+	})
+	c.CmdClause.Flag("to", "The service version to activate (default: the previously active version)").Action(c.to.Set).IntVar(&c.to.Value)
+	return &c
+}
+
+// Exec implements the command interface.
+func (c *RollbackCommand) Exec(in io.Reader, out io.Writer) error {
+	serviceID, source, flag, err := argparser.ServiceID(c.serviceName, *c.Globals.Manifest, c.Globals.APIClient, c.Globals.ErrLog)
+	if err != nil {
+		return err
+	}
+	if c.Globals.Verbose() {
+		argparser.DisplayServiceID(serviceID, flag, source, out)
+	}
+
+	versions, err := c.Globals.APIClient.ListVersions(&fastly.ListVersionsInput{
+		ServiceID: serviceID,
+	})
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]any{
+			"Service ID": serviceID,
+		})
+		return fmt.Errorf("error listing service versions: %w", err)
+	}
+
+	// NOTE: The service might not have an active version (e.g. it was
+	// deactivated), in which case the --to flag is required.
+	active, _ := argparser.GetActiveVersion(versions)
+
+	target, err := c.targetVersion(versions, active)
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]any{
+			"Service ID": serviceID,
+			"To":         c.to.Value,
+		})
+		return err
+	}
+
+	if active != nil {
+		text.Output(out, "Rolling back service %s from version %d to version %d", serviceID, active.Number, target.Number)
+	} else {
+		text.Output(out, "Activating version %d of service %s (no version is currently active)", target.Number, serviceID)
+	}
+	text.Break(out)
+	var activeHash string
+	if active != nil {
+		activeHash = c.displayPackage(out, serviceID, active.Number, "active")
+	}
+	targetHash := c.displayPackage(out, serviceID, target.Number, "target")
+	if activeHash != "" && activeHash == targetHash {
+		text.Break(out)
+		text.Warning(out, "The package of version %d is identical to the active version.", target.Number)
+	}
+	text.Break(out)
+
+	if !c.Globals.Flags.AutoYes && !c.Globals.Flags.NonInteractive {
+		cont, err := text.AskYesNo(out, fmt.Sprintf("Activate version %d? [y/N] ", target.Number), in)
+		if err != nil {
+			return err
+		}
+		if !cont {
+			return nil
+		}
+		text.Break(out)
+	}
+
+	if c.comment.WasSet {
+		_, err := c.Globals.APIClient.UpdateVersion(&fastly.UpdateVersionInput{
+			ServiceID:      serviceID,
+			ServiceVersion: target.Number,
+			Comment:        &c.comment.Value,
+		})
+		if err != nil {
+			c.Globals.ErrLog.AddWithContext(err, map[string]any{
+				"Service ID":      serviceID,
+				"Service Version": target.Number,
+			})
+			return fmt.Errorf("error setting comment for service version %d: %w", target.Number, err)
+		}
+	}
+
+	spinner, err := text.NewSpinner(out)
+	if err != nil {
+		return err
+	}
+	err = spinner.Process(fmt.Sprintf("Activating service (version %d)", target.Number), func(_ *text.SpinnerWrapper) error {
+		_, err := c.Globals.APIClient.ActivateVersion(&fastly.ActivateVersionInput{
+			ServiceID:      serviceID,
+			ServiceVersion: target.Number,
+		})
+		if err != nil {
+			c.Globals.ErrLog.AddWithContext(err, map[string]any{
+				"Service ID":      serviceID,
+				"Service Version": target.Number,
+			})
+			return fmt.Errorf("error activating version: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	text.Success(out, "Rolled back service %s to version %d", serviceID, target.Number)
+	return nil
+}
+
+// targetVersion returns the version to activate, which is either the version
+// specified by the --to flag or the previously active version.
+func (c *RollbackCommand) targetVersion(versions []*fastly.Version, active *fastly.Version) (*fastly.Version, error) {
+	if c.to.WasSet {
+		for _, v := range versions {
+			if v.Number != c.to.Value {
+				continue
+			}
+			if v.Active {
+				return nil, fmt.Errorf("service version %d is already active", v.Number)
+			}
+			return v, nil
+		}
+		return nil, fmt.Errorf("service version %d not found", c.to.Value)
+	}
+
+	if active == nil {
+		return nil, fsterr.RemediationError{
+			Inner:       fmt.Errorf("no active service version found"),
+			Remediation: "Use the --to flag to specify the service version to activate.",
+		}
+	}
+	if v := PreviousVersion(versions, active.Number); v != nil {
+		return v, nil
+	}
+	return nil, fsterr.RemediationError{
+		Inner:       fmt.Errorf("no version activated prior to version %d found", active.Number),
+		Remediation: "Use the --to flag to specify the service version to activate.",
+	}
+}
+
+// displayPackage displays the hashes of the package uploaded to the given
+// service version, and returns the hash of the package files.
+func (c *RollbackCommand) displayPackage(out io.Writer, serviceID string, version int, label string) string {
+	p, err := c.Globals.APIClient.GetPackage(&fastly.GetPackageInput{
+		ServiceID:      serviceID,
+		ServiceVersion: version,
+	})
+	// IMPORTANT: Skip error as a version might not have a package.
+	// e.g. the service was created outside of the CLI.
+	if err != nil {
+		text.Output(out, "Version %d (%s): no package found", version, label)
+		return ""
+	}
+	text.Output(out, "Version %d (%s):", version, label)
+	text.Output(out, "  Hashsum: %s", p.Metadata.HashSum)
+	text.Output(out, "  Files hash: %s", p.Metadata.FilesHash)
+	return p.Metadata.FilesHash
+}
+
+// PreviousVersion returns the version most likely to have been active before
+// the given version, or nil if there is none.
+//
+// NOTE: The API doesn't expose the activation history of a service, but a
+// version is locked once activated, so we use the most recent locked version
+// prior to the given version.
+func PreviousVersion(versions []*fastly.Version, version int) *fastly.Version {
+	var previous *fastly.Version
+	for _, v := range versions {
+		if v.Number >= version || !v.Locked || v.Active {
+			continue
+		}
+		if previous == nil || v.Number > previous.Number {
+			previous = v
+		}
+	}
+	return previous
+}
@@ -0,0 +1,269 @@
+package compute_test
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/fastly/go-fastly/v8/fastly"
+
+	"github.com/fastly/cli/pkg/app"
+	"github.com/fastly/cli/pkg/commands/compute"
+	"github.com/fastly/cli/pkg/global"
+	"github.com/fastly/cli/pkg/manifest"
+	"github.com/fastly/cli/pkg/mock"
+	"github.com/fastly/cli/pkg/testutil"
+)
+
+func TestRollback(t *testing.T) {
+	pwd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rootdir := testutil.NewEnv(testutil.EnvOpts{
+		T: t,
+		Write: []testutil.FileIO{
+			{
+				Src: `manifest_version = 2
+name = "package"
+service_id = "123"
+`,
+				Dst: manifest.Filename,
+			},
+		},
+	})
+	defer os.RemoveAll(rootdir)
+
+	if err := os.Chdir(rootdir); err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		_ = os.Chdir(pwd)
+	}()
+
+	args := testutil.Args
+	scenarios := []struct {
+		testutil.TestScenario
+		stdin          string
+		wantActivated  int
+		wantComment    string
+		dontWantOutput string
+	}{
+		{
+			TestScenario: testutil.TestScenario{
+				Name: "success with previously active version",
+				Args: args("compute rollback --auto-yes"),
+				API: mock.API{
+					ListVersionsFn: listVersionsRollback,
+					GetPackageFn:   getPackageRollback,
+				},
+				WantOutputs: []string{
+					"Rolling back service 123 from version 4 to version 2",
+					"Version 4 (active):",
+					"Files hash: files-4",
+					"Version 2 (target):",
+					"Files hash: files-2",
+					"Activating service (version 2)",
+					"SUCCESS: Rolled back service 123 to version 2",
+				},
+			},
+			wantActivated: 2,
+		},
+		{
+			TestScenario: testutil.TestScenario{
+				Name: "success with --to and --comment",
+				Args: args("compute rollback --to 1 --comment bad-deploy --non-interactive"),
+				API: mock.API{
+					ListVersionsFn: listVersionsRollback,
+					GetPackageFn: func(i *fastly.GetPackageInput) (*fastly.Package, error) {
+						if i.ServiceVersion == 1 {
+							return nil, testutil.Err
+						}
+						return getPackageRollback(i)
+					},
+				},
+				WantOutputs: []string{
+					"Rolling back service 123 from version 4 to version 1",
+					"Version 1 (target): no package found",
+					"SUCCESS: Rolled back service 123 to version 1",
+				},
+			},
+			wantActivated: 1,
+			wantComment:   "bad-deploy",
+		},
+		{
+			TestScenario: testutil.TestScenario{
+				Name: "identical packages",
+				Args: args("compute rollback --auto-yes"),
+				API: mock.API{
+					ListVersionsFn: listVersionsRollback,
+					GetPackageFn: func(i *fastly.GetPackageInput) (*fastly.Package, error) {
+						return &fastly.Package{Metadata: fastly.PackageMetadata{FilesHash: "same"}}, nil
+					},
+				},
+				WantOutput: "The package of version 2 is identical to the active version.",
+			},
+			wantActivated: 2,
+		},
+		{
+			TestScenario: testutil.TestScenario{
+				Name: "declined prompt",
+				Args: args("compute rollback"),
+				API: mock.API{
+					ListVersionsFn: listVersionsRollback,
+					GetPackageFn:   getPackageRollback,
+				},
+				WantOutput: "Activate version 2? [y/N]",
+			},
+			stdin:          "n",
+			dontWantOutput: "Rolled back",
+		},
+		{
+			TestScenario: testutil.TestScenario{
+				Name: "--to the active version",
+				Args: args("compute rollback --to 4 --auto-yes"),
+				API: mock.API{
+					ListVersionsFn: listVersionsRollback,
+				},
+				WantError: "service version 4 is already active",
+			},
+		},
+		{
+			TestScenario: testutil.TestScenario{
+				Name: "--to an unknown version",
+				Args: args("compute rollback --to 9 --auto-yes"),
+				API: mock.API{
+					ListVersionsFn: listVersionsRollback,
+				},
+				WantError: "service version 9 not found",
+			},
+		},
+		{
+			TestScenario: testutil.TestScenario{
+				Name: "no previously active version",
+				Args: args("compute rollback --auto-yes"),
+				API: mock.API{
+					ListVersionsFn: func(i *fastly.ListVersionsInput) ([]*fastly.Version, error) {
+						return []*fastly.Version{
+							{ServiceID: i.ServiceID, Number: 1, Active: true, Locked: true},
+							{ServiceID: i.ServiceID, Number: 2},
+						}, nil
+					},
+				},
+				WantError: "no version activated prior to version 1 found",
+			},
+		},
+		{
+			TestScenario: testutil.TestScenario{
+				Name: "list versions error",
+				Args: args("compute rollback --auto-yes"),
+				API: mock.API{
+					ListVersionsFn: testutil.ListVersionsError,
+				},
+				WantError: "error listing service versions: test error",
+			},
+		},
+		{
+			TestScenario: testutil.TestScenario{
+				Name: "activate version error",
+				Args: args("compute rollback --auto-yes"),
+				API: mock.API{
+					ListVersionsFn: listVersionsRollback,
+					GetPackageFn:   getPackageRollback,
+					ActivateVersionFn: func(_ *fastly.ActivateVersionInput) (*fastly.Version, error) {
+						return nil, testutil.Err
+					},
+				},
+				WantError: "error activating version: test error",
+			},
+		},
+	}
+
+	for testcaseIdx := range scenarios {
+		testcase := &scenarios[testcaseIdx]
+		t.Run(testcase.Name, func(t *testing.T) {
+			var (
+				activated int
+				comment   string
+			)
+			if testcase.API.ActivateVersionFn == nil {
+				testcase.API.ActivateVersionFn = func(i *fastly.ActivateVersionInput) (*fastly.Version, error) {
+					activated = i.ServiceVersion
+					return &fastly.Version{ServiceID: i.ServiceID, Number: i.ServiceVersion, Active: true}, nil
+				}
+			}
+			testcase.API.UpdateVersionFn = func(i *fastly.UpdateVersionInput) (*fastly.Version, error) {
+				comment = *i.Comment
+				return &fastly.Version{ServiceID: i.ServiceID, Number: i.ServiceVersion}, nil
+			}
+
+			var stdout bytes.Buffer
+			opts := testutil.MockGlobalData(testcase.Args, &stdout)
+			opts.APIClientFactory = mock.APIClient(testcase.API)
+			opts.Input = strings.NewReader(testcase.stdin)
+
+			app.Init = func(_ []string, _ io.Reader) (*global.Data, error) {
+				return opts, nil
+			}
+			err := app.Run(testcase.Args, nil)
+
+			t.Log(stdout.String())
+
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.WantOutput)
+			for _, s := range testcase.WantOutputs {
+				testutil.AssertStringContains(t, stdout.String(), s)
+			}
+			if testcase.dontWantOutput != "" {
+				testutil.AssertStringDoesntContain(t, stdout.String(), testcase.dontWantOutput)
+			}
+			testutil.AssertEqual(t, testcase.wantActivated, activated)
+			testutil.AssertString(t, testcase.wantComment, comment)
+		})
+	}
+}
+
+func TestPreviousVersion(t *testing.T) {
+	versions, _ := listVersionsRollback(&fastly.ListVersionsInput{ServiceID: "123"})
+	for _, testcase := range []struct {
+		version int
+		want    int
+	}{
+		{version: 4, want: 2},
+		{version: 2, want: 1},
+		{version: 1, want: 0},
+	} {
+		var have int
+		if v := compute.PreviousVersion(versions, testcase.version); v != nil {
+			have = v.Number
+		}
+		testutil.AssertEqual(t, testcase.want, have)
+	}
+}
+
+// listVersionsRollback returns versions where 4 is active, 1 and 2 were
+// previously activated (i.e. locked) and 3 was never activated.
+func listVersionsRollback(i *fastly.ListVersionsInput) ([]*fastly.Version, error) {
+	return []*fastly.Version{
+		{ServiceID: i.ServiceID, Number: 1, Locked: true},
+		{ServiceID: i.ServiceID, Number: 2, Locked: true},
+		{ServiceID: i.ServiceID, Number: 3},
+		{ServiceID: i.ServiceID, Number: 4, Active: true, Locked: true},
+		{ServiceID: i.ServiceID, Number: 5},
+	}, nil
+}
+
+func getPackageRollback(i *fastly.GetPackageInput) (*fastly.Package, error) {
+	return &fastly.Package{
+		ServiceID:      i.ServiceID,
+		ServiceVersion: i.ServiceVersion,
+		Metadata: fastly.PackageMetadata{
+			FilesHash: fmt.Sprintf("files-%d", i.ServiceVersion),
+			HashSum:   fmt.Sprintf("hashsum-%d", i.ServiceVersion),
+		},
+	}, nil
+}
@@ -0,0 +1,160 @@
+package compute
+
+import (
+	"fmt"
+	"io"
+
+	fsterr "github.com/fastly/cli/pkg/errors"
+	fstexec "github.com/fastly/cli/pkg/exec"
+	"github.com/fastly/cli/pkg/manifest"
+	"github.com/fastly/cli/pkg/text"
+)
+
+// CustomScriptMessage is the message displayed to a user when there is a
+// lifecycle script (e.g. pre_build, test, pre_deploy) defined.
+const CustomScriptMessage = "This project has a custom %s script defined in the %s manifest"
+
+// Environment variables exposed to the [scripts.pre_deploy] and
+// [scripts.post_deploy] scripts.
+const (
+	// ScriptEnvServiceID is the ID of the service being deployed to.
+	// NOTE: For pre_deploy it's empty when a new service is to be created.
+	ScriptEnvServiceID = "FASTLY_SERVICE_ID"
+	// ScriptEnvServiceVersion is the service version that was deployed.
+	ScriptEnvServiceVersion = "FASTLY_SERVICE_VERSION"
+	// ScriptEnvServiceURL is the URL of the deployed service.
+	ScriptEnvServiceURL = "FASTLY_SERVICE_URL"
+)
+
+// scriptOpts represents the inputs for `runScript()`.
+type scriptOpts struct {
+	// autoYes is the --auto-yes flag.
+	autoYes bool
+	// env is environment variables to be set.
+	env []string
+	// errLog is an abstraction for recording errors to disk.
+	errLog fsterr.LogInterface
+	// in is the user's terminal stdin stream.
+	in io.Reader
+	// manifestFilename is the name of the manifest file.
+	manifestFilename string
+	// name is the name of the script within the [scripts] section.
+	name string
+	// nonInteractive is the --non-interactive flag.
+	nonInteractive bool
+	// out is the user's terminal stdout stream.
+	out io.Writer
+	// script is the shell command to execute.
+	script string
+	// spinner is a terminal progress status indicator.
+	spinner text.Spinner
+	// timeout is the script execution threshold (zero indicates no timeout).
+	timeout int
+	// verbose indicates if the user set --verbose.
+	verbose bool
+}
+
+// runScript executes a [scripts] lifecycle script in a sub shell, the same
+// way as the [scripts.build] script is executed. It's a no-op when the script
+// isn't defined.
+//
+// NOTE: As with [scripts.post_build], the user is prompted to confirm the
+// script is safe to execute unless --auto-yes or --non-interactive is set.
+func runScript(opts scriptOpts) error {
+	if opts.script == "" {
+		return nil
+	}
+
+	manifestFilename := opts.manifestFilename
+	if manifestFilename == "" {
+		manifestFilename = manifest.Filename
+	}
+
+	if !opts.autoYes && !opts.nonInteractive {
+		text.Info(opts.out, "%s:\n", fmt.Sprintf(CustomScriptMessage, opts.name, manifestFilename))
+		text.Indent(opts.out, 4, "%s", opts.script)
+
+		label := "\nDo you want to run this now? [y/N] "
+		answer, err := text.AskYesNo(opts.out, label, opts.in)
+		if err != nil {
+			return err
+		}
+		if !answer {
+			return fsterr.RemediationError{
+				Inner:       fmt.Errorf("[scripts.%s] stopped by user", opts.name),
+				Remediation: fmt.Sprintf("Check the [scripts.%s] in the %s manifest is safe to execute or skip this prompt using either `--auto-yes` or `--non-interactive`.", opts.name, manifestFilename),
+			}
+		}
+		text.Break(opts.out)
+	}
+
+	if opts.verbose {
+		text.Description(opts.out, fmt.Sprintf("Script to execute ([scripts.%s])", opts.name), FilterSecretsFromString(opts.script))
+	}
+
+	msg := fmt.Sprintf("Running [scripts.%s]", opts.name)
+
+	// If we're in verbose mode, the script output is shown.
+	// So in that case we don't want to have a spinner as it'll interweave output.
+	// In non-verbose mode we have a spinner running while the script is running.
+	if !opts.verbose {
+		if err := opts.spinner.Start(); err != nil {
+			return err
+		}
+		opts.spinner.Message(msg + "...")
+	}
+
+	cmd, args := Shell{}.Build(opts.script)
+	// gosec flagged this:
+	// G204 (CWE-78): Subprocess launched with function call as argument or cmd arguments
+	// Disabling as we require the user to provide this command.
+	// #nosec
+	// nosemgrep: go.lang.security.audit.dangerous-exec-command.dangerous-exec-command
+	err := fstexec.Command(fstexec.CommandOpts{
+		Args:           args,
+		Command:        cmd,
+		Env:            opts.env,
+		ErrLog:         opts.errLog,
+		Output:         opts.out,
+		Spinner:        opts.spinner,
+		SpinnerMessage: msg,
+		Timeout:        opts.timeout,
+		Verbose:        opts.verbose,
+	})
+	if err != nil {
+		// In verbose mode we'll have the failure status AFTER the error output.
+		// But we can't just call StopFailMessage() without first starting the spinner.
+		if opts.verbose {
+			text.Break(opts.out)
+			spinErr := opts.spinner.Start()
+			if spinErr != nil {
+				return fmt.Errorf(text.SpinnerErrWrapper, spinErr, err)
+			}
+			opts.spinner.Message(msg + "...")
+			opts.spinner.StopFailMessage(msg)
+			spinErr = opts.spinner.StopFail()
+			if spinErr != nil {
+				return fmt.Errorf(text.SpinnerErrWrapper, spinErr, err)
+			}
+		}
+		// WARNING: Don't try to add 'StopFailMessage/StopFail' calls here.
+		// It is handled internally by fstexec.Streaming.Exec().
+		return fsterr.RemediationError{
+			Inner:       fmt.Errorf("error running [scripts.%s]: %w", opts.name, err),
+			Remediation: fmt.Sprintf("Check the [scripts.%s] script defined in the %s manifest.", opts.name, manifestFilename),
+		}
+	}
+
+	// In verbose mode we'll have the status AFTER the script output.
+	// But we can't just call StopMessage() without first starting the spinner.
+	if opts.verbose {
+		if err := opts.spinner.Start(); err != nil {
+			return err
+		}
+		opts.spinner.Message(msg + "...")
+		text.Break(opts.out)
+	}
+
+	opts.spinner.StopMessage(msg)
+	return opts.spinner.Stop()
+}
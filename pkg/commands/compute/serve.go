@@ -44,6 +44,7 @@ type ServeCommand struct {
 	build *BuildCommand
 
 	// Build fields
+	cache                 argparser.OptionalBool
 	dir                   argparser.OptionalString
 	includeSrc            argparser.OptionalBool
 	lang                  argparser.OptionalString
@@ -65,9 +66,16 @@ type ServeCommand struct {
 	file            string
 	profileGuest    bool
 	profileGuestDir argparser.OptionalString
+	pullStores      bool
+	recordHAR       string
+	replayHAR       string
+	serviceName     argparser.OptionalServiceNameID
+	serviceVersion  argparser.OptionalServiceVersion
 	skipBuild       bool
+	startupTimeout  int
 	watch           bool
 	watchDir        argparser.OptionalString
+	workspace       bool
 }
 
 // NewServeCommand returns a usable command registered under the parent.
@@ -78,8 +86,28 @@ func NewServeCommand(parent argparser.Registerer, g *global.Data, build *BuildCo
 	c.ViceroyVersioner = g.Versioners.Viceroy
 	c.CmdClause = parent.Command("serve", "Build and run a Compute package locally")
 
+	// NOTE: The service flags are only used by --pull-stores.
+	c.RegisterFlag(argparser.StringFlagOpts{
+		Name:        argparser.FlagServiceIDName,
+		Description: argparser.FlagServiceIDDesc,
+		Dst:         &c.Globals.Manifest.Flag.ServiceID,
+	})
+	c.RegisterFlag(argparser.StringFlagOpts{
+		Action:      c.serviceName.Set,
+		Name:        argparser.FlagServiceName,
+		Description: argparser.FlagServiceDesc,
+		Dst:         &c.serviceName.Value,
+	})
+	c.RegisterFlag(argparser.StringFlagOpts{
+		Action:      c.serviceVersion.Set,
+		Description: argparser.FlagVersionDesc,
+		Dst:         &c.serviceVersion.Value,
+		Name:        argparser.FlagVersionName,
+	})
+
 	c.CmdClause.Flag("addr", "The IPv4 address and port to listen on").Default("127.0.0.1:7676").StringVar(&c.addr)
 	c.CmdClause.Flag("debug", "Run the server in Debug Adapter mode").Hidden().BoolVar(&c.debug)
+	c.CmdClause.Flag("cache", "Use the build cache to skip compiling a project that hasn't changed since the last build (use --no-cache to force a rebuild)").Action(c.cache.Set).NegatableBoolVar(&c.cache.Value)
 	c.CmdClause.Flag("dir", "Project directory to build (default: current directory)").Short('C').Action(c.dir.Set).StringVar(&c.dir.Value)
 	c.CmdClause.Flag("env", "The manifest environment config to use (e.g. 'stage' will attempt to read 'fastly.stage.toml')").Action(c.env.Set).StringVar(&c.env.Value)
 	c.CmdClause.Flag("file", "The Wasm file to run").Default("bin/main.wasm").StringVar(&c.file)
@@ -91,12 +119,17 @@ func NewServeCommand(parent argparser.Registerer, g *global.Data, build *BuildCo
 	c.CmdClause.Flag("package-name", "Package name").Action(c.packageName.Set).StringVar(&c.packageName.Value)
 	c.CmdClause.Flag("profile-guest", "Profile the Wasm guest under Viceroy (requires Viceroy 0.9.1 or higher). View profiles at https://profiler.firefox.com/.").BoolVar(&c.profileGuest)
 	c.CmdClause.Flag("profile-guest-dir", "The directory where the per-request profiles are saved to. Defaults to guest-profiles.").Action(c.profileGuestDir.Set).StringVar(&c.profileGuestDir.Value)
+	c.CmdClause.Flag("pull-stores", fmt.Sprintf("Download the contents of the config, KV and secret stores linked to the service into ./%s and reference them from [local_server] in the manifest (requires an API token)", LocalStoresDir)).BoolVar(&c.pullStores)
+	c.CmdClause.Flag("record-har", "Record every request and response sent through the local server into the given HAR file").PlaceHolder("FILE").StringVar(&c.recordHAR)
+	c.CmdClause.Flag("replay-har", "Send the requests recorded in the given HAR file (see --record-har) to the local server and compare the responses with the recording").PlaceHolder("FILE").StringVar(&c.replayHAR)
 	c.CmdClause.Flag("skip-build", "Skip the build step").BoolVar(&c.skipBuild)
+	c.CmdClause.Flag("startup-timeout", "Timeout, in seconds, for the local server to start accepting requests (used by --replay-har)").Default("30").IntVar(&c.startupTimeout)
 	c.CmdClause.Flag("timeout", "Timeout, in seconds, for the build compilation step").Action(c.timeout.Set).IntVar(&c.timeout.Value)
 	c.CmdClause.Flag("viceroy-check", "Force the CLI to check for a newer version of the Viceroy binary").BoolVar(&c.ForceCheckViceroyLatest)
 	c.CmdClause.Flag("viceroy-path", "The path to a user installed version of the Viceroy binary").StringVar(&c.ViceroyBinPath)
 	c.CmdClause.Flag("watch", "Watch for file changes, then rebuild project and restart local server").BoolVar(&c.watch)
 	c.CmdClause.Flag("watch-dir", "The directory to watch files from (can be relative or absolute). Defaults to current directory.").Action(c.watchDir.Set).StringVar(&c.watchDir.Value)
+	c.CmdClause.Flag("workspace", fmt.Sprintf("Build and run every project listed in the %s file, routing the backends between them to their local servers", WorkspaceFilename)).BoolVar(&c.workspace)
 
 	return &c
 }
@@ -106,6 +139,12 @@ func (c *ServeCommand) Exec(in io.Reader, out io.Writer) (err error) {
 	if c.skipBuild && c.watch {
 		return fsterr.ErrIncompatibleServeFlags
 	}
+	if c.replayHAR != "" && (c.recordHAR != "" || c.watch) {
+		return fsterr.RemediationError{
+			Inner:       errors.New("--replay-har can't be used with --record-har or --watch"),
+			Remediation: fsterr.ComputeServeRemediation,
+		}
+	}
 
 	if runtime.GOARCH == "386" {
 		return fsterr.RemediationError{
@@ -114,6 +153,10 @@ func (c *ServeCommand) Exec(in io.Reader, out io.Writer) (err error) {
 		}
 	}
 
+	if c.workspace {
+		return c.serveWorkspace(in, out)
+	}
+
 	if !c.skipBuild {
 		err = c.Build(in, out)
 		if err != nil {
@@ -138,6 +181,15 @@ func (c *ServeCommand) Exec(in io.Reader, out io.Writer) (err error) {
 	}()
 	manifestPath := filepath.Join(wd, manifestFilename)
 
+	// NOTE: The HAR file paths are relative to the directory the user ran the
+	// command from (not the project directory).
+	if c.recordHAR != "" && !filepath.IsAbs(c.recordHAR) {
+		c.recordHAR = filepath.Join(wd, c.recordHAR)
+	}
+	if c.replayHAR != "" && !filepath.IsAbs(c.replayHAR) {
+		c.replayHAR = filepath.Join(wd, c.replayHAR)
+	}
+
 	projectDir, err := ChangeProjectDirectory(c.dir.Value)
 	if err != nil {
 		return err
@@ -176,11 +228,38 @@ func (c *ServeCommand) Exec(in io.Reader, out io.Writer) (err error) {
 		}
 	}
 
+	if c.pullStores {
+		if err := c.PullStores(manifestPath, spinner, out); err != nil {
+			return err
+		}
+	}
+
 	bin, err := c.GetViceroy(spinner, out, manifestPath)
 	if err != nil {
 		return err
 	}
 
+	if c.replayHAR != "" {
+		return c.replay(spinner, bin, manifestPath, out)
+	}
+
+	// NOTE: When recording, the reverse proxy listens on --addr and Viceroy
+	// listens on a random port behind it.
+	addr := c.addr
+	if c.recordHAR != "" {
+		addr, err = availableAddr()
+		if err != nil {
+			return fmt.Errorf("failed to find an available port for the local server: %w", err)
+		}
+		rec, err := newHARRecorder(c.recordHAR, c.addr, addr, c.Globals.ErrLog)
+		if err != nil {
+			c.Globals.ErrLog.Add(err)
+			return err
+		}
+		defer rec.Close()
+		text.Info(out, "Recording requests sent to http://%s into %s\n\n", c.addr, c.recordHAR)
+	}
+
 	err = spinner.Start()
 	if err != nil {
 		return err
@@ -201,7 +280,7 @@ func (c *ServeCommand) Exec(in io.Reader, out io.Writer) (err error) {
 	var restart bool
 	for {
 		err = local(localOpts{
-			addr:            c.addr,
+			addr:            addr,
 			bin:             bin,
 			debug:           c.debug,
 			errLog:          c.Globals.ErrLog,
@@ -241,6 +320,9 @@ func (c *ServeCommand) Exec(in io.Reader, out io.Writer) (err error) {
 // Build constructs and executes the build logic.
 func (c *ServeCommand) Build(in io.Reader, out io.Writer) error {
 	// Reset the fields on the BuildCommand based on ServeCommand values.
+	if c.cache.WasSet {
+		c.build.Flags.NoCache = !c.cache.Value
+	}
 	if c.dir.WasSet {
 		c.build.Flags.Dir = c.dir.Value
 	}
@@ -817,8 +899,6 @@ func watchFiles(root string, gi *ignore.GitIgnore, verbose bool, s *fstexec.Stre
 //
 // NOTE: We also ignore the .git directory.
 func ignoreFiles(watchDir argparser.OptionalString) *ignore.GitIgnore {
-	var patterns []string
-
 	root := ""
 	if watchDir.WasSet {
 		root = watchDir.Value
@@ -827,16 +907,7 @@ func ignoreFiles(watchDir argparser.OptionalString) *ignore.GitIgnore {
 		}
 	}
 
-	fastlyIgnore := root + ".fastlyignore"
-
-	// NOTE: Using a loop to allow for future ignore files to be respected.
-	for _, file := range []string{fastlyIgnore} {
-		patterns = append(patterns, readIgnoreFile(file)...)
-	}
-
-	patterns = append(patterns, ".git/")
-
-	return ignore.CompileIgnoreLines(patterns...)
+	return compileIgnoreFiles(root, ".fastlyignore")
 }
 
 // readIgnoreFile reads path and splits content into lines.
@@ -2,20 +2,31 @@ package compute_test
 
 import (
 	"bytes"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
 	"os"
 	"path/filepath"
+	"runtime"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/fastly/go-fastly/v8/fastly"
+
+	"github.com/fastly/cli/pkg/app"
 	"github.com/fastly/cli/pkg/argparser"
 	"github.com/fastly/cli/pkg/commands/compute"
 	"github.com/fastly/cli/pkg/config"
 	fsterr "github.com/fastly/cli/pkg/errors"
 	"github.com/fastly/cli/pkg/github"
 	"github.com/fastly/cli/pkg/global"
+	"github.com/fastly/cli/pkg/manifest"
 	"github.com/fastly/cli/pkg/mock"
 	"github.com/fastly/cli/pkg/testutil"
 	"github.com/fastly/cli/pkg/text"
+	"github.com/fastly/cli/pkg/threadsafe"
 )
 
 // TestGetViceroy validates that Viceroy is installed to the appropriate
@@ -121,3 +132,554 @@ func TestGetViceroy(t *testing.T) {
 		t.Fatalf("binary was not moved to the install directory: %s", err)
 	}
 }
+
+func TestServePullStores(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("the fake Viceroy binary is a bash script")
+	}
+
+	args := testutil.Args
+	scenarios := []struct {
+		name           string
+		api            mock.API
+		wantError      string
+		wantOutput     []string
+		wantManifest   []string
+		wantFiles      map[string]string
+		dontWantOutput []string
+	}{
+		{
+			name: "success",
+			api: mock.API{
+				ListVersionsFn:  testutil.ListVersions,
+				ListResourcesFn: listResourcesPullStores,
+				ListConfigStoreItemsFn: func(i *fastly.ListConfigStoreItemsInput) ([]*fastly.ConfigStoreItem, error) {
+					return []*fastly.ConfigStoreItem{
+						{StoreID: i.StoreID, Key: "greeting", Value: "hello"},
+					}, nil
+				},
+				NewListKVStoreKeysPaginatorFn: func(_ *fastly.ListKVStoreKeysInput) fastly.PaginatorKVStoreEntries {
+					return &mockKVStoreKeysPaginator{next: true, keys: []string{"b/key", "a"}}
+				},
+				GetKVStoreKeyFn: func(i *fastly.GetKVStoreKeyInput) (string, error) {
+					return "value of " + i.Key, nil
+				},
+				ListSecretsFn: func(_ *fastly.ListSecretsInput) (*fastly.Secrets, error) {
+					return &fastly.Secrets{Data: []fastly.Secret{{Name: "api_key"}, {Name: "existing"}}}, nil
+				},
+			},
+			wantError: "exit status 1",
+			wantOutput: []string{
+				"Pulling config store 'my_config'",
+				"Pulling KV store 'my_kv'",
+				"Pulling secret store 'my_secrets'",
+				"Pulled 1 config store(s), 1 KV store(s) and 1 secret store(s)",
+				"1 secret store entries were assigned the value 'PLACEHOLDER'",
+			},
+			wantManifest: []string{
+				`file = "local-stores/config_stores/my_config.json"`,
+				`format = "json"`,
+				`file = "local-stores/kv_stores/my_kv/a"`,
+				`file = "local-stores/kv_stores/my_kv/b%2Fkey"`,
+				`key = "api_key"`,
+				`data = "PLACEHOLDER"`,
+				`data = "local value"`,
+				`key = "local_only"`,
+				`url = "http://127.0.0.1:8080"`,
+			},
+			wantFiles: map[string]string{
+				"local-stores/config_stores/my_config.json": `"greeting": "hello"`,
+				"local-stores/kv_stores/my_kv/a":            "value of a",
+				"local-stores/kv_stores/my_kv/b%2Fkey":      "value of b/key",
+			},
+		},
+		{
+			name: "no linked stores",
+			api: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				ListResourcesFn: func(_ *fastly.ListResourcesInput) ([]*fastly.Resource, error) {
+					return nil, nil
+				},
+			},
+			wantError: "exit status 1",
+			wantOutput: []string{
+				"Service 123 (version 1) has no linked config, KV or secret stores.",
+			},
+			dontWantOutput: []string{
+				"Pulled",
+			},
+		},
+		{
+			name: "list resources error",
+			api: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				ListResourcesFn: func(_ *fastly.ListResourcesInput) ([]*fastly.Resource, error) {
+					return nil, testutil.Err
+				},
+			},
+			wantError: "error listing linked resources: test error",
+		},
+	}
+
+	for _, testcase := range scenarios {
+		t.Run(testcase.name, func(t *testing.T) {
+			pwd, err := os.Getwd()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			rootdir := testutil.NewEnv(testutil.EnvOpts{
+				T: t,
+				Write: []testutil.FileIO{
+					{Src: `manifest_version = 2
+name = "package"
+service_id = "123"
+
+[local_server]
+  [local_server.backends]
+    [local_server.backends.origin]
+      url = "http://127.0.0.1:8080"
+  [local_server.kv_stores]
+    [[local_server.kv_stores.local_only]]
+      key = "local_only"
+      data = "unchanged"
+  [local_server.secret_stores]
+    [[local_server.secret_stores.my_secrets]]
+      key = "existing"
+      data = "local value"
+`, Dst: manifest.Filename},
+					{Src: "\x00asm\x01\x00\x00\x00", Dst: filepath.Join("bin", "main.wasm")},
+					{Src: "#!/usr/bin/env bash\nexit 1\n", Dst: "viceroy", Executable: true},
+				},
+			})
+			defer os.RemoveAll(rootdir)
+
+			if err := os.Chdir(rootdir); err != nil {
+				t.Fatal(err)
+			}
+			defer func() {
+				_ = os.Chdir(pwd)
+			}()
+
+			runArgs := args("compute serve --skip-build --pull-stores --metadata-disable --token 123 --viceroy-path ./viceroy")
+			var stdout threadsafe.Buffer
+			app.Init = func(_ []string, _ io.Reader) (*global.Data, error) {
+				opts := testutil.MockGlobalData(runArgs, &stdout)
+				opts.APIClientFactory = mock.APIClient(testcase.api)
+				opts.Versioners = global.Versioners{
+					Viceroy: mock.AssetVersioner{},
+				}
+				return opts, nil
+			}
+			err = app.Run(runArgs, nil)
+
+			t.Log(stdout.String())
+
+			testutil.AssertErrorContains(t, err, testcase.wantError)
+			for _, s := range testcase.wantOutput {
+				testutil.AssertStringContains(t, stdout.String(), s)
+			}
+			for _, s := range testcase.dontWantOutput {
+				testutil.AssertStringDoesntContain(t, stdout.String(), s)
+			}
+
+			data, err := os.ReadFile(filepath.Join(rootdir, manifest.Filename))
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, s := range testcase.wantManifest {
+				testutil.AssertStringContains(t, string(data), s)
+			}
+			for file, want := range testcase.wantFiles {
+				data, err := os.ReadFile(filepath.Join(rootdir, filepath.FromSlash(file)))
+				if err != nil {
+					t.Fatal(err)
+				}
+				testutil.AssertStringContains(t, string(data), want)
+			}
+		})
+	}
+}
+
+// listResourcesPullStores returns a link to a config, KV and secret store, as
+// well as a link to an unrelated resource.
+func listResourcesPullStores(i *fastly.ListResourcesInput) ([]*fastly.Resource, error) {
+	return []*fastly.Resource{
+		{Name: "my_config", ResourceID: "cs-1", ResourceType: "config", ServiceID: i.ServiceID},
+		{Name: "my_kv", ResourceID: "kv-1", ResourceType: "kv-store", ServiceID: i.ServiceID},
+		{Name: "my_secrets", ResourceID: "ss-1", ResourceType: "secret-store", ServiceID: i.ServiceID},
+		{Name: "unrelated", ResourceID: "other", ResourceType: "other", ServiceID: i.ServiceID},
+	}, nil
+}
+
+type mockKVStoreKeysPaginator struct {
+	next bool
+	keys []string
+}
+
+func (m *mockKVStoreKeysPaginator) Next() bool {
+	ret := m.next
+	m.next = false // allow one page of keys
+	return ret
+}
+
+func (m *mockKVStoreKeysPaginator) Keys() []string {
+	return m.keys
+}
+
+func (m *mockKVStoreKeysPaginator) Err() error {
+	return nil
+}
+
+func TestServeWorkspace(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("the fake Viceroy binary is a bash script")
+	}
+
+	// The fake Viceroy binary captures the manifest it was given, then exits
+	// once every instance has started.
+	viceroy := `#!/usr/bin/env bash
+dir="$(dirname "$3")"
+cp "$3" "$dir/captured.toml"
+echo "listening on $5"
+for i in $(seq 50); do
+  [ -f "$dir/../frontend/captured.toml" ] && [ -f "$dir/../api/captured.toml" ] && exit 1
+  sleep 0.1
+done
+exit 1
+`
+	workspace := `
+[[service]]
+dir = "frontend"
+backends = { origin = "api" }
+
+[[service]]
+dir = "api"
+addr = "127.0.0.1:9000"
+`
+	frontendManifest := `manifest_version = 2
+name = "frontend"
+language = "other"
+
+[scripts]
+build = 'mkdir -p bin && printf "\0asm\1\0\0\0" > bin/main.wasm && echo frontend > built'
+post_build = "touch post_build_ran"
+
+[local_server.backends.api]
+url = "https://api.example.com"
+cert_host = "api.example.com"
+use_sni = true
+
+[local_server.backends.origin]
+url = "https://origin.example.com"
+
+[local_server.backends.other]
+url = "http://other.example.com"
+`
+	apiManifest := `manifest_version = 2
+name = "api"
+language = "other"
+
+[scripts]
+build = 'mkdir -p bin && printf "\0asm\1\0\0\0" > bin/main.wasm && echo api > built'
+`
+
+	args := testutil.Args
+	scenarios := []struct {
+		name             string
+		args             []string
+		workspace        string
+		wantError        string
+		wantOutput       []string
+		wantManifest     []string
+		dontWantManifest []string
+		wantFiles        map[string]string
+		dontWantFiles    []string
+	}{
+		{
+			name:      "success",
+			args:      args("compute serve --workspace --skip-build --metadata-disable --viceroy-path ./viceroy"),
+			workspace: workspace,
+			wantError: "stopped unexpectedly",
+			wantOutput: []string{
+				"frontend: http://127.0.0.1:7676",
+				"api: http://127.0.0.1:9000",
+				"frontend | listening on 127.0.0.1:7676",
+				"api | listening on 127.0.0.1:9000",
+			},
+			wantManifest: []string{
+				`[local_server.backends.api]
+      url = "http://127.0.0.1:9000"`,
+				`[local_server.backends.origin]
+      url = "http://127.0.0.1:9000"`,
+				`url = "http://other.example.com"`,
+			},
+			dontWantManifest: []string{
+				"cert_host",
+				"use_sni",
+			},
+		},
+		{
+			name:      "builds each project with its own [scripts]",
+			args:      args("compute serve --workspace --auto-yes --metadata-disable --viceroy-path ./viceroy"),
+			workspace: workspace,
+			wantError: "stopped unexpectedly",
+			wantOutput: []string{
+				"Built package (pkg/frontend.tar.gz)",
+				"Built package (pkg/api.tar.gz)",
+			},
+			wantFiles: map[string]string{
+				filepath.Join("frontend", "built"):          "frontend",
+				filepath.Join("frontend", "post_build_ran"): "",
+				filepath.Join("api", "built"):               "api",
+			},
+			// The api project has no post_build script, so the one belonging to the
+			// frontend project mustn't carry over to its build.
+			dontWantFiles: []string{
+				filepath.Join("api", "post_build_ran"),
+			},
+		},
+		{
+			name:      "missing workspace file",
+			args:      args("compute serve --workspace --skip-build --metadata-disable --viceroy-path ./viceroy"),
+			wantError: "failed to read workspace file",
+		},
+		{
+			name:      "unknown backend service",
+			args:      args("compute serve --workspace --skip-build --metadata-disable --viceroy-path ./viceroy"),
+			workspace: "[[service]]\ndir = \"frontend\"\nbackends = { origin = \"missing\" }\n",
+			wantError: "the backend 'origin' of service 'frontend' references an unknown workspace service 'missing'",
+		},
+		{
+			name:      "incompatible flags",
+			args:      args("compute serve --workspace --pull-stores --metadata-disable --viceroy-path ./viceroy"),
+			workspace: workspace,
+			wantError: "--workspace can't be used with --watch, --dir, --pull-stores, --record-har or --replay-har",
+		},
+	}
+
+	for _, testcase := range scenarios {
+		t.Run(testcase.name, func(t *testing.T) {
+			pwd, err := os.Getwd()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			write := []testutil.FileIO{
+				{Src: frontendManifest, Dst: filepath.Join("frontend", manifest.Filename)},
+				{Src: apiManifest, Dst: filepath.Join("api", manifest.Filename)},
+				{Src: viceroy, Dst: "viceroy", Executable: true},
+			}
+			if testcase.workspace != "" {
+				write = append(write, testutil.FileIO{Src: testcase.workspace, Dst: compute.WorkspaceFilename})
+			}
+			rootdir := testutil.NewEnv(testutil.EnvOpts{
+				T:     t,
+				Dirs:  []string{"frontend", "api"},
+				Write: write,
+			})
+			defer os.RemoveAll(rootdir)
+
+			if err := os.Chdir(rootdir); err != nil {
+				t.Fatal(err)
+			}
+			defer func() {
+				_ = os.Chdir(pwd)
+			}()
+
+			var stdout threadsafe.Buffer
+			app.Init = func(_ []string, _ io.Reader) (*global.Data, error) {
+				opts := testutil.MockGlobalData(testcase.args, &stdout)
+				opts.Versioners = global.Versioners{
+					Viceroy:   mock.AssetVersioner{},
+					WasmTools: mock.AssetVersioner{},
+				}
+				return opts, nil
+			}
+			err = app.Run(testcase.args, nil)
+
+			t.Log(stdout.String())
+
+			testutil.AssertErrorContains(t, err, testcase.wantError)
+			for _, s := range testcase.wantOutput {
+				testutil.AssertStringContains(t, stdout.String(), s)
+			}
+			for path, content := range testcase.wantFiles {
+				data, err := os.ReadFile(filepath.Join(rootdir, path))
+				if err != nil {
+					t.Fatal(err)
+				}
+				testutil.AssertStringContains(t, string(data), content)
+			}
+			for _, path := range testcase.dontWantFiles {
+				if _, err := os.Stat(filepath.Join(rootdir, path)); !os.IsNotExist(err) {
+					t.Errorf("expected %s not to exist", path)
+				}
+			}
+
+			if len(testcase.wantManifest) > 0 {
+				data, err := os.ReadFile(filepath.Join(rootdir, "frontend", "captured.toml"))
+				if err != nil {
+					t.Fatal(err)
+				}
+				for _, s := range testcase.wantManifest {
+					testutil.AssertStringContains(t, string(data), s)
+				}
+				for _, s := range testcase.dontWantManifest {
+					testutil.AssertStringDoesntContain(t, string(data), s)
+				}
+			}
+
+			// The generated manifests are removed once the local servers stop.
+			for _, dir := range []string{"frontend", "api"} {
+				if _, err := os.Stat(filepath.Join(rootdir, dir, compute.WorkspaceManifestFilename)); !os.IsNotExist(err) {
+					t.Errorf("expected the generated manifest in %s to be removed", dir)
+				}
+			}
+		})
+	}
+}
+
+func TestServeHAR(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("the fake Viceroy binary is a bash script")
+	}
+
+	testBin, err := os.Executable()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The recording Viceroy binary runs the fake local server (see
+	// TestHelperViceroy) until a 'stop' file is created.
+	recordViceroy := fmt.Sprintf(`#!/usr/bin/env bash
+FASTLY_TEST_HELPER_VICEROY=1 %q -test.run='^TestHelperViceroy$' -- "$@" &
+pid=$!
+while [ ! -f stop ]; do sleep 0.1; done
+kill $pid
+exit 1
+`, testBin)
+	replayViceroy := fmt.Sprintf(`#!/usr/bin/env bash
+FASTLY_TEST_HELPER_VICEROY=1 exec %q -test.run='^TestHelperViceroy$' -- "$@"
+`, testBin)
+
+	pwd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	rootdir := testutil.NewEnv(testutil.EnvOpts{
+		T: t,
+		Write: []testutil.FileIO{
+			{Src: "manifest_version = 2\nname = \"package\"\n", Dst: manifest.Filename},
+			{Src: "\x00asm\x01\x00\x00\x00", Dst: filepath.Join("bin", "main.wasm")},
+			{Src: recordViceroy, Dst: "record-viceroy", Executable: true},
+			{Src: replayViceroy, Dst: "replay-viceroy", Executable: true},
+		},
+	})
+	defer os.RemoveAll(rootdir)
+
+	if err := os.Chdir(rootdir); err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		_ = os.Chdir(pwd)
+	}()
+
+	run := func(args []string) (string, error) {
+		var stdout threadsafe.Buffer
+		app.Init = func(_ []string, _ io.Reader) (*global.Data, error) {
+			opts := testutil.MockGlobalData(args, &stdout)
+			opts.Versioners = global.Versioners{
+				Viceroy: mock.AssetVersioner{},
+			}
+			return opts, nil
+		}
+		err := app.Run(args, nil)
+		t.Log(stdout.String())
+		return stdout.String(), err
+	}
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr := l.Addr().String()
+	_ = l.Close()
+
+	// Record requests sent through the local server.
+	done := make(chan error, 1)
+	go func() {
+		_, err := run(testutil.Args("compute serve --skip-build --metadata-disable --record-har session.har --viceroy-path ./record-viceroy --addr " + addr))
+		done <- err
+	}()
+
+	deadline := time.Now().Add(30 * time.Second)
+	for {
+		resp, err := http.Get("http://" + addr + "/")
+		if err == nil {
+			_ = resp.Body.Close()
+			if resp.StatusCode == http.StatusOK {
+				break
+			}
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("timed out waiting for the local server")
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+	req, err := http.NewRequest(http.MethodPost, "http://"+addr+"/?q=1", strings.NewReader("some data"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Host = "example.com"
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_ = resp.Body.Close()
+	resp, err = http.Get("http://" + addr + "/missing")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_ = resp.Body.Close()
+
+	if err := os.WriteFile("stop", nil, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	testutil.AssertErrorContains(t, <-done, "exit status 1")
+
+	h, err := compute.ReadHAR(filepath.Join(rootdir, "session.har"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	testutil.AssertEqual(t, 3, len(h.Log.Entries))
+	post := h.Log.Entries[1]
+	testutil.AssertString(t, "POST", post.Request.Method)
+	testutil.AssertString(t, "http://example.com/?q=1", post.Request.URL)
+	testutil.AssertString(t, "some data", post.Request.PostData.Text)
+	testutil.AssertEqual(t, 200, post.Response.Status)
+	testutil.AssertString(t, "Hello from example.com", post.Response.Content.Text)
+	testutil.AssertEqual(t, 404, h.Log.Entries[2].Response.Status)
+
+	// Replay the recording against an unchanged local server.
+	out, err := run(testutil.Args("compute serve --skip-build --metadata-disable --replay-har session.har --viceroy-path ./replay-viceroy"))
+	testutil.AssertNoError(t, err)
+	testutil.AssertStringContains(t, out, "SAME POST http://example.com/?q=1")
+	testutil.AssertStringContains(t, out, "All 3 responses match the recording")
+
+	// Replay a recording whose responses differ from the local server.
+	h.Log.Entries[1].Response.Content.Text = "Hello from somewhere else"
+	h.Log.Entries[2].Response.Status = 200
+	if err := h.Write(filepath.Join(rootdir, "changed.har")); err != nil {
+		t.Fatal(err)
+	}
+	out, err = run(testutil.Args("compute serve --skip-build --metadata-disable --replay-har changed.har --viceroy-path ./replay-viceroy"))
+	testutil.AssertErrorContains(t, err, "2 of 3 responses differ from the recording")
+	testutil.AssertStringContains(t, out, "DIFF POST http://example.com/?q=1")
+	testutil.AssertStringContains(t, out, "- Hello from somewhere else")
+	testutil.AssertStringContains(t, out, "+ Hello from example.com")
+	testutil.AssertStringContains(t, out, "status: recorded 200, replayed 404")
+
+	// A missing recording.
+	_, err = run(testutil.Args("compute serve --skip-build --metadata-disable --replay-har missing.har --viceroy-path ./replay-viceroy"))
+	testutil.AssertErrorContains(t, err, "failed to read HAR file")
+}
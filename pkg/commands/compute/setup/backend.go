@@ -120,6 +120,58 @@ func (b *Backends) Create() error {
 	return nil
 }
 
+// Update calls the relevant API to update the address and port of the named
+// existing backends so they match the [setup.backends] configuration.
+func (b *Backends) Update(names []string) error {
+	if b.Spinner == nil {
+		return errors.RemediationError{
+			Inner:       fmt.Errorf("internal logic error: no spinner configured for setup.Backends"),
+			Remediation: errors.BugRemediation,
+		}
+	}
+
+	for _, name := range names {
+		settings, ok := b.Setup[name]
+		if !ok || settings == nil {
+			continue
+		}
+
+		opts := &fastly.UpdateBackendInput{
+			ServiceID:      b.ServiceID,
+			ServiceVersion: b.ServiceVersion,
+			Name:           name,
+		}
+		if settings.Address != "" {
+			overrideHost, sslSNIHostname, sslCertHostname := backend.SetBackendHostDefaults(settings.Address)
+			opts.Address = &settings.Address
+			if overrideHost != "" {
+				opts.OverrideHost = &overrideHost
+			}
+			if sslCertHostname != "" {
+				opts.SSLCertHostname = &sslCertHostname
+			}
+			if sslSNIHostname != "" {
+				opts.SSLSNIHostname = &sslSNIHostname
+			}
+		}
+		if settings.Port > 0 {
+			opts.Port = &settings.Port
+		}
+
+		err := b.Spinner.Process(fmt.Sprintf("Updating backend '%s' (host: %s, port: %d)", name, settings.Address, settings.Port), func(_ *text.SpinnerWrapper) error {
+			if _, err := b.APIClient.UpdateBackend(opts); err != nil {
+				return fmt.Errorf("error updating backend '%s': %w", name, err)
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // Predefined indicates if the service resource has been specified within the
 // fastly.toml file using a [setup] configuration block.
 func (b *Backends) Predefined() bool {
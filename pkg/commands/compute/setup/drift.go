@@ -0,0 +1,429 @@
+package setup
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/fastly/go-fastly/v8/fastly"
+
+	"github.com/fastly/cli/pkg/api"
+	"github.com/fastly/cli/pkg/manifest"
+	"github.com/fastly/cli/pkg/serviceconfig"
+)
+
+// DriftStatus describes how a resource differs between the fastly.toml
+// [setup] configuration and a service.
+type DriftStatus string
+
+const (
+	// DriftMissing indicates the resource is defined in the [setup]
+	// configuration but doesn't exist on the service.
+	DriftMissing DriftStatus = "missing"
+	// DriftExtra indicates the resource exists on the service but isn't
+	// defined in the [setup] configuration.
+	DriftExtra DriftStatus = "extra"
+	// DriftMismatch indicates the resource exists in both places but its
+	// configuration differs.
+	DriftMismatch DriftStatus = "mismatch"
+)
+
+// Resource types reported by CheckDrift.
+const (
+	DriftBackend     = "backend"
+	DriftConfigStore = "config_store"
+	DriftKVStore     = "kv_store"
+	DriftLogEndpoint = "log_endpoint"
+	DriftSecretStore = "secret_store"
+)
+
+// Drift is a single difference between the [setup] configuration and a
+// service version.
+type Drift struct {
+	Resource string      `json:"resource"`
+	Name     string      `json:"name"`
+	Status   DriftStatus `json:"status"`
+	Details  []string    `json:"details,omitempty"`
+}
+
+// CheckDrift compares the [setup] configuration with the resources of the
+// given service version.
+//
+// Only the resource types defined in the [setup] configuration are compared.
+// For example, log endpoints on the service aren't reported as extra unless
+// the [setup] configuration defines at least one log endpoint.
+//
+// NOTE: KV store values and secrets aren't compared as the [setup]
+// configuration may reference a file, and secret values are never stored.
+func CheckDrift(c api.Interface, serviceID string, serviceVersion int, s manifest.Setup) ([]Drift, error) {
+	var drift []Drift
+
+	if len(s.Backends) > 0 {
+		d, err := backendDrift(c, serviceID, serviceVersion, s.Backends)
+		if err != nil {
+			return nil, err
+		}
+		drift = append(drift, d...)
+	}
+
+	kvStores := make(map[string]*manifest.SetupKVStore)
+	for name, store := range s.ObjectStores {
+		kvStores[name] = store
+	}
+	for name, store := range s.KVStores {
+		kvStores[name] = store
+	}
+
+	if len(s.ConfigStores) > 0 || len(kvStores) > 0 || len(s.SecretStores) > 0 {
+		links, err := c.ListResources(&fastly.ListResourcesInput{
+			ServiceID:      serviceID,
+			ServiceVersion: serviceVersion,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error listing linked resources: %w", err)
+		}
+		if len(s.ConfigStores) > 0 {
+			d, err := configStoreDrift(c, links, s.ConfigStores)
+			if err != nil {
+				return nil, err
+			}
+			drift = append(drift, d...)
+		}
+		if len(kvStores) > 0 {
+			d, err := kvStoreDrift(c, links, kvStores)
+			if err != nil {
+				return nil, err
+			}
+			drift = append(drift, d...)
+		}
+		if len(s.SecretStores) > 0 {
+			d, err := secretStoreDrift(c, links, s.SecretStores)
+			if err != nil {
+				return nil, err
+			}
+			drift = append(drift, d...)
+		}
+	}
+
+	if len(s.Loggers) > 0 {
+		d, err := loggerDrift(c, serviceID, serviceVersion, s.Loggers)
+		if err != nil {
+			return nil, err
+		}
+		drift = append(drift, d...)
+	}
+
+	return drift, nil
+}
+
+func backendDrift(c api.Interface, serviceID string, serviceVersion int, setup map[string]*manifest.SetupBackend) ([]Drift, error) {
+	backends, err := c.ListBackends(&fastly.ListBackendsInput{
+		ServiceID:      serviceID,
+		ServiceVersion: serviceVersion,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error listing backends: %w", err)
+	}
+	actual := make(map[string]*fastly.Backend, len(backends))
+	for _, b := range backends {
+		actual[b.Name] = b
+	}
+
+	var drift []Drift
+	for _, name := range SortedKeys(setup) {
+		want := setup[name]
+		have, ok := actual[name]
+		if !ok {
+			drift = append(drift, Drift{Resource: DriftBackend, Name: name, Status: DriftMissing})
+			continue
+		}
+		var details []string
+		if want != nil && want.Address != "" && want.Address != have.Address {
+			details = append(details, fieldDiff("address", want.Address, have.Address))
+		}
+		if want != nil && want.Port > 0 && want.Port != have.Port {
+			details = append(details, fieldDiff("port", want.Port, have.Port))
+		}
+		if len(details) > 0 {
+			drift = append(drift, Drift{Resource: DriftBackend, Name: name, Status: DriftMismatch, Details: details})
+		}
+	}
+	for _, name := range SortedKeys(actual) {
+		if _, ok := setup[name]; !ok {
+			drift = append(drift, Drift{Resource: DriftBackend, Name: name, Status: DriftExtra})
+		}
+	}
+	return drift, nil
+}
+
+func configStoreDrift(c api.Interface, links []*fastly.Resource, setup map[string]*manifest.SetupConfigStore) ([]Drift, error) {
+	linked := LinkedStores(links, StoreTypeConfig)
+
+	var drift []Drift
+	for _, name := range SortedKeys(setup) {
+		storeID, ok := linked[name]
+		if !ok {
+			drift = append(drift, Drift{Resource: DriftConfigStore, Name: name, Status: DriftMissing})
+			continue
+		}
+		items, err := c.ListConfigStoreItems(&fastly.ListConfigStoreItemsInput{StoreID: storeID})
+		if err != nil {
+			return nil, fmt.Errorf("error listing items for config store '%s': %w", name, err)
+		}
+		actual := make(map[string]string, len(items))
+		for _, item := range items {
+			actual[item.Key] = item.Value
+		}
+		var want map[string]manifest.SetupConfigStoreItems
+		if setup[name] != nil {
+			want = setup[name].Items
+		}
+		var details []string
+		for _, key := range SortedKeys(want) {
+			value, ok := actual[key]
+			switch {
+			case !ok:
+				details = append(details, fmt.Sprintf("item '%s' is missing", key))
+			case want[key].Value != "" && want[key].Value != value:
+				details = append(details, fmt.Sprintf("item '%s': %s", key, fieldDiff("value", want[key].Value, value)))
+			}
+		}
+		details = append(details, extraKeys("item", want, actual)...)
+		if len(details) > 0 {
+			drift = append(drift, Drift{Resource: DriftConfigStore, Name: name, Status: DriftMismatch, Details: details})
+		}
+	}
+	drift = append(drift, extraStores(DriftConfigStore, setup, linked)...)
+	return drift, nil
+}
+
+func kvStoreDrift(c api.Interface, links []*fastly.Resource, setup map[string]*manifest.SetupKVStore) ([]Drift, error) {
+	linked := LinkedStores(links, StoreTypeKV)
+
+	var drift []Drift
+	for _, name := range SortedKeys(setup) {
+		storeID, ok := linked[name]
+		if !ok {
+			drift = append(drift, Drift{Resource: DriftKVStore, Name: name, Status: DriftMissing})
+			continue
+		}
+		actual := make(map[string]bool)
+		var cursor string
+		for {
+			o, err := c.ListKVStoreKeys(&fastly.ListKVStoreKeysInput{ID: storeID, Cursor: cursor})
+			if err != nil {
+				return nil, fmt.Errorf("error listing keys for kv store '%s': %w", name, err)
+			}
+			for _, key := range o.Data {
+				actual[key] = true
+			}
+			if cursor = o.Meta["next_cursor"]; cursor == "" {
+				break
+			}
+		}
+		var want map[string]manifest.SetupKVStoreItems
+		if setup[name] != nil {
+			want = setup[name].Items
+		}
+		var details []string
+		for _, key := range SortedKeys(want) {
+			if !actual[key] {
+				details = append(details, fmt.Sprintf("key '%s' is missing", key))
+			}
+		}
+		details = append(details, extraKeys("key", want, actual)...)
+		if len(details) > 0 {
+			drift = append(drift, Drift{Resource: DriftKVStore, Name: name, Status: DriftMismatch, Details: details})
+		}
+	}
+	drift = append(drift, extraStores(DriftKVStore, setup, linked)...)
+	return drift, nil
+}
+
+func secretStoreDrift(c api.Interface, links []*fastly.Resource, setup map[string]*manifest.SetupSecretStore) ([]Drift, error) {
+	linked := LinkedStores(links, StoreTypeSecret)
+
+	var drift []Drift
+	for _, name := range SortedKeys(setup) {
+		storeID, ok := linked[name]
+		if !ok {
+			drift = append(drift, Drift{Resource: DriftSecretStore, Name: name, Status: DriftMissing})
+			continue
+		}
+		actual := make(map[string]bool)
+		var cursor string
+		for {
+			o, err := c.ListSecrets(&fastly.ListSecretsInput{ID: storeID, Cursor: cursor})
+			if err != nil {
+				return nil, fmt.Errorf("error listing secrets for secret store '%s': %w", name, err)
+			}
+			for _, s := range o.Data {
+				actual[s.Name] = true
+			}
+			if cursor = o.Meta.NextCursor; cursor == "" {
+				break
+			}
+		}
+		var want map[string]manifest.SetupSecretStoreEntry
+		if setup[name] != nil {
+			want = setup[name].Entries
+		}
+		var details []string
+		for _, key := range SortedKeys(want) {
+			if !actual[key] {
+				details = append(details, fmt.Sprintf("entry '%s' is missing", key))
+			}
+		}
+		details = append(details, extraKeys("entry", want, actual)...)
+		if len(details) > 0 {
+			drift = append(drift, Drift{Resource: DriftSecretStore, Name: name, Status: DriftMismatch, Details: details})
+		}
+	}
+	drift = append(drift, extraStores(DriftSecretStore, setup, linked)...)
+	return drift, nil
+}
+
+// loggerDrift compares log endpoints across every logging provider.
+//
+// The logging families are those defined by the serviceconfig package, which
+// are named after the provider (e.g. "logging_s3").
+func loggerDrift(c api.Interface, serviceID string, serviceVersion int, setup map[string]*manifest.SetupLogger) ([]Drift, error) {
+	const prefix = "logging_"
+
+	actual := make(map[string]string) // name -> provider
+	for _, f := range serviceconfig.Families {
+		if !strings.HasPrefix(f.Name, prefix) {
+			continue
+		}
+		rs, err := f.Fetch(c, serviceID, serviceVersion)
+		if err != nil {
+			return nil, fmt.Errorf("error listing %s log endpoints: %w", strings.TrimPrefix(f.Name, prefix), err)
+		}
+		for _, r := range rs {
+			actual[r.Name()] = strings.TrimPrefix(f.Name, prefix)
+		}
+	}
+
+	var drift []Drift
+	for _, name := range SortedKeys(setup) {
+		provider, ok := actual[name]
+		if !ok {
+			drift = append(drift, Drift{Resource: DriftLogEndpoint, Name: name, Status: DriftMissing})
+			continue
+		}
+		if want := setup[name]; want != nil && want.Provider != "" && want.Provider != provider {
+			drift = append(drift, Drift{
+				Resource: DriftLogEndpoint,
+				Name:     name,
+				Status:   DriftMismatch,
+				Details:  []string{fieldDiff("provider", want.Provider, provider)},
+			})
+		}
+	}
+	for _, name := range SortedKeys(actual) {
+		if _, ok := setup[name]; !ok {
+			drift = append(drift, Drift{Resource: DriftLogEndpoint, Name: name, Status: DriftExtra})
+		}
+	}
+	return drift, nil
+}
+
+// Store types of a resource link (see fastly.Resource.ResourceType).
+const (
+	StoreTypeConfig = "config"
+	StoreTypeKV     = "kv-store"
+	StoreTypeSecret = "secret-store"
+)
+
+// storeTypeAliases maps legacy resource types to their current store type.
+//
+// NOTE: KV stores were previously named object stores.
+var storeTypeAliases = map[string]string{
+	"object-store": StoreTypeKV,
+}
+
+// LinkedStores returns the resource links (name -> store ID) to stores of the
+// given type (e.g. StoreTypeKV).
+func LinkedStores(links []*fastly.Resource, storeType string) map[string]string {
+	m := make(map[string]string)
+	for _, l := range links {
+		t := l.ResourceType
+		if alias, ok := storeTypeAliases[t]; ok {
+			t = alias
+		}
+		if t == storeType {
+			m[l.Name] = l.ResourceID
+		}
+	}
+	return m
+}
+
+// extraStores returns a Drift for each linked store not defined in setup.
+func extraStores[T any](resource string, setup map[string]T, linked map[string]string) []Drift {
+	var drift []Drift
+	for _, name := range SortedKeys(linked) {
+		if _, ok := setup[name]; !ok {
+			drift = append(drift, Drift{Resource: resource, Name: name, Status: DriftExtra})
+		}
+	}
+	return drift
+}
+
+// extraKeys describes the keys in actual that aren't defined in want.
+func extraKeys[W, A any](kind string, want map[string]W, actual map[string]A) []string {
+	var details []string
+	for _, key := range SortedKeys(actual) {
+		if _, ok := want[key]; !ok {
+			details = append(details, fmt.Sprintf("%s '%s' is not defined in the manifest", kind, key))
+		}
+	}
+	return details
+}
+
+func fieldDiff(field string, want, have any) string {
+	return fmt.Sprintf("%s: %v (manifest) != %v (service)", field, want, have)
+}
+
+// SortedKeys returns the keys of m in a deterministic order.
+func SortedKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// Missing returns the subset of the [setup] configuration whose resources are
+// reported by drift as missing from the service.
+func Missing(s manifest.Setup, drift []Drift) manifest.Setup {
+	missing := make(map[string]map[string]bool)
+	for _, d := range drift {
+		if d.Status != DriftMissing {
+			continue
+		}
+		if missing[d.Resource] == nil {
+			missing[d.Resource] = make(map[string]bool)
+		}
+		missing[d.Resource][d.Name] = true
+	}
+	return manifest.Setup{
+		Backends:     filterSetup(s.Backends, missing[DriftBackend]),
+		ConfigStores: filterSetup(s.ConfigStores, missing[DriftConfigStore]),
+		Loggers:      filterSetup(s.Loggers, missing[DriftLogEndpoint]),
+		ObjectStores: filterSetup(s.ObjectStores, missing[DriftKVStore]),
+		KVStores:     filterSetup(s.KVStores, missing[DriftKVStore]),
+		SecretStores: filterSetup(s.SecretStores, missing[DriftSecretStore]),
+	}
+}
+
+// filterSetup returns the entries of m whose name is in names.
+func filterSetup[T any](m map[string]T, names map[string]bool) map[string]T {
+	filtered := make(map[string]T)
+	for name, v := range m {
+		if names[name] {
+			filtered[name] = v
+		}
+	}
+	return filtered
+}
@@ -0,0 +1,206 @@
+package compute
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/fastly/cli/pkg/argparser"
+	"github.com/fastly/cli/pkg/commands/compute/setup"
+	fsterr "github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/global"
+	"github.com/fastly/cli/pkg/manifest"
+	"github.com/fastly/cli/pkg/text"
+)
+
+// SetupRootCommand is the parent command for the subcommands which manage
+// the fastly.toml [setup] configuration.
+type SetupRootCommand struct {
+	argparser.Base
+	// no flags
+}
+
+// NewSetupRootCommand returns a new command registered in the parent.
+func NewSetupRootCommand(parent argparser.Registerer, g *global.Data) *SetupRootCommand {
+	var c SetupRootCommand
+	c.Globals = g
+	c.CmdClause = parent.Command("setup", "Manage the [setup] configuration of a Compute package")
+	return &c
+}
+
+// Exec implements the command interface.
+func (c *SetupRootCommand) Exec(_ io.Reader, _ io.Writer) error {
+	panic("unreachable")
+}
+
+// SetupCheckCommand compares the fastly.toml [setup] configuration with the
+// resources of a service version.
+type SetupCheckCommand struct {
+	argparser.Base
+	argparser.JSONOutput
+
+	dir            string
+	env            string
+	serviceName    argparser.OptionalServiceNameID
+	serviceVersion argparser.OptionalServiceVersion
+}
+
+// NewSetupCheckCommand returns a usable command registered under the parent.
+func NewSetupCheckCommand(parent argparser.Registerer, g *global.Data) *SetupCheckCommand {
+	var c SetupCheckCommand
+	c.Globals = g
+	c.CmdClause = parent.Command("check", "Report differences between the fastly.toml [setup] configuration and a service version").Alias("drift")
+
+	c.CmdClause.Flag("dir", "Project directory (default: current directory)").Short('C').StringVar(&c.dir)
+	c.CmdClause.Flag("env", "The manifest environment config to use (e.g. 'stage' will attempt to read 'fastly.stage.toml')").StringVar(&c.env)
+	c.RegisterFlagBool(c.JSONFlag()) // --json
+	c.RegisterFlag(argparser.StringFlagOpts{
+		Name:        argparser.FlagServiceIDName,
+		Description: argparser.FlagServiceIDDesc,
+		Dst:         &g.Manifest.Flag.ServiceID,
+		Short:       's',
+	})
+	c.RegisterFlag(argparser.StringFlagOpts{
+		Action:      c.serviceName.Set,
+		Name:        argparser.FlagServiceName,
+		Description: argparser.FlagServiceDesc,
+		Dst:         &c.serviceName.Value,
+	})
+	c.RegisterFlag(argparser.StringFlagOpts{
+		Action:      c.serviceVersion.Set,
+		Description: argparser.FlagVersionDesc + " (default: the active version)",
+		Dst:         &c.serviceVersion.Value,
+		Name:        argparser.FlagVersionName,
+	})
+	return &c
+}
+
+// SetupCheckResult is the JSON representation of a [setup] check.
+type SetupCheckResult struct {
+	ServiceID string        `json:"service_id"`
+	Version   int           `json:"version"`
+	Drift     []setup.Drift `json:"drift"`
+}
+
+// Exec implements the command interface.
+func (c *SetupCheckCommand) Exec(_ io.Reader, out io.Writer) error {
+	if c.Globals.Verbose() && c.JSONOutput.Enabled {
+		return fsterr.ErrInvalidVerboseJSONCombo
+	}
+
+	manifestFilename, err := c.readManifest(out)
+	if err != nil {
+		c.Globals.ErrLog.Add(err)
+		return err
+	}
+
+	s := c.Globals.Manifest.File.Setup
+	if !s.Defined() {
+		text.Info(out, "No [setup] configuration found in %s", manifestFilename)
+		return nil
+	}
+
+	serviceID, serviceVersion, err := argparser.ServiceDetails(argparser.ServiceDetailsOpts{
+		AllowActiveLocked:  true,
+		APIClient:          c.Globals.APIClient,
+		Manifest:           *c.Globals.Manifest,
+		Out:                out,
+		ServiceNameFlag:    c.serviceName,
+		ServiceVersionFlag: c.serviceVersion,
+		VerboseMode:        c.Globals.Flags.Verbose,
+	})
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]any{
+			"Service ID":      serviceID,
+			"Service Version": fsterr.ServiceVersion(serviceVersion),
+		})
+		return err
+	}
+
+	drift, err := setup.CheckDrift(c.Globals.APIClient, serviceID, serviceVersion.Number, s)
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]any{
+			"Service ID":      serviceID,
+			"Service Version": serviceVersion.Number,
+		})
+		return err
+	}
+
+	ok, err := c.WriteJSON(out, SetupCheckResult{
+		ServiceID: serviceID,
+		Version:   serviceVersion.Number,
+		Drift:     append([]setup.Drift{}, drift...),
+	})
+	if ok && err != nil {
+		return err
+	}
+
+	if !ok {
+		if len(drift) == 0 {
+			text.Success(out, "The [setup] configuration in %s matches service %s version %d", manifestFilename, serviceID, serviceVersion.Number)
+			return nil
+		}
+		text.Output(out, "Comparing the [setup] configuration in %s with service %s version %d:", manifestFilename, serviceID, serviceVersion.Number)
+		text.Break(out)
+		printDrift(out, drift)
+		text.Break(out)
+	}
+
+	if len(drift) > 0 {
+		return fsterr.RemediationError{
+			Inner:       fmt.Errorf("found %d difference(s) between the [setup] configuration and service %s version %d", len(drift), serviceID, serviceVersion.Number),
+			Remediation: fmt.Sprintf("Update the [setup] configuration in %s or the service so that they match.", manifestFilename),
+		}
+	}
+	return nil
+}
+
+// readManifest reads the manifest file, taking into account the --dir and
+// --env flags, and returns its filename.
+func (c *SetupCheckCommand) readManifest(out io.Writer) (string, error) {
+	manifestFilename := EnvironmentManifest(c.env)
+	if c.env != "" && c.Globals.Verbose() {
+		text.Info(out, EnvManifestMsg, manifestFilename, manifest.Filename)
+	}
+
+	var err error
+	if c.dir != "" || c.env != "" {
+		path := manifestFilename
+		if c.dir != "" {
+			path = filepath.Join(c.dir, manifestFilename)
+		}
+		err = c.Globals.Manifest.File.Read(path)
+	} else {
+		err = c.Globals.Manifest.File.ReadError()
+	}
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			err = fsterr.ErrReadingManifest
+		}
+		return "", err
+	}
+	return manifestFilename, nil
+}
+
+// printDrift displays each difference, prefixed by a symbol indicating
+// whether the resource is missing from the service (+), not defined in the
+// manifest (-) or differs (~).
+func printDrift(out io.Writer, drift []setup.Drift) {
+	for _, d := range drift {
+		resource := strings.ReplaceAll(d.Resource, "_", " ")
+		switch d.Status {
+		case setup.DriftMissing:
+			text.Output(out, "+ %s '%s' is missing from the service", resource, d.Name)
+		case setup.DriftExtra:
+			text.Output(out, "- %s '%s' is not defined in the manifest", resource, d.Name)
+		case setup.DriftMismatch:
+			text.Output(out, "~ %s '%s' differs:", resource, d.Name)
+			for _, detail := range d.Details {
+				text.Output(out, "    %s", detail)
+			}
+		}
+	}
+}
@@ -0,0 +1,221 @@
+package compute_test
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/fastly/go-fastly/v8/fastly"
+
+	"github.com/fastly/cli/pkg/app"
+	"github.com/fastly/cli/pkg/global"
+	"github.com/fastly/cli/pkg/manifest"
+	"github.com/fastly/cli/pkg/mock"
+	"github.com/fastly/cli/pkg/testutil"
+)
+
+func TestSetupCheck(t *testing.T) {
+	pwd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rootdir := testutil.NewEnv(testutil.EnvOpts{T: t})
+	defer os.RemoveAll(rootdir)
+
+	if err := os.Chdir(rootdir); err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		_ = os.Chdir(pwd)
+	}()
+
+	setupManifest := `manifest_version = 2
+name = "package"
+service_id = "123"
+
+[setup.backends.origin]
+address = "example.com"
+port = 443
+
+[setup.config_stores.settings.items.mode]
+value = "production"
+`
+
+	args := testutil.Args
+	scenarios := []struct {
+		testutil.TestScenario
+		manifest string
+	}{
+		{
+			TestScenario: testutil.TestScenario{
+				Name:       "no [setup] configuration",
+				Args:       args("compute setup check"),
+				WantOutput: "No [setup] configuration found in fastly.toml",
+			},
+			manifest: `manifest_version = 2
+name = "package"
+service_id = "123"
+`,
+		},
+		{
+			TestScenario: testutil.TestScenario{
+				Name: "no drift",
+				Args: args("compute setup check"),
+				API: mock.API{
+					ListVersionsFn:         testutil.ListVersions,
+					ListBackendsFn:         listBackendsSetupOK,
+					ListResourcesFn:        listResourcesSetupOK,
+					ListConfigStoreItemsFn: listConfigStoreItemsSetupOK,
+				},
+				WantOutput: "SUCCESS: The [setup] configuration in fastly.toml matches service 123 version 1",
+			},
+			manifest: setupManifest,
+		},
+		{
+			TestScenario: testutil.TestScenario{
+				Name: "drift",
+				Args: args("compute setup check --version 3"),
+				API: mock.API{
+					ListVersionsFn: testutil.ListVersions,
+					ListBackendsFn: func(i *fastly.ListBackendsInput) ([]*fastly.Backend, error) {
+						return []*fastly.Backend{
+							{Name: "origin", Address: "example.org", Port: 443},
+							{Name: "legacy", Address: "legacy.example.com", Port: 80},
+						}, nil
+					},
+					ListResourcesFn: listResourcesSetupOK,
+					ListConfigStoreItemsFn: func(i *fastly.ListConfigStoreItemsInput) ([]*fastly.ConfigStoreItem, error) {
+						return []*fastly.ConfigStoreItem{
+							{Key: "debug", Value: "true"},
+						}, nil
+					},
+				},
+				WantOutputs: []string{
+					"Comparing the [setup] configuration in fastly.toml with service 123 version 3:",
+					"~ backend 'origin' differs:",
+					"address: example.com (manifest) != example.org (service)",
+					"- backend 'legacy' is not defined in the manifest",
+					"~ config store 'settings' differs:",
+					"item 'mode' is missing",
+				},
+				WantError: "found 3 difference(s) between the [setup] configuration and service 123 version 3",
+			},
+			manifest: setupManifest,
+		},
+		{
+			TestScenario: testutil.TestScenario{
+				Name: "missing resources",
+				Args: args("compute setup check --json"),
+				API: mock.API{
+					ListVersionsFn: testutil.ListVersions,
+					ListBackendsFn: func(i *fastly.ListBackendsInput) ([]*fastly.Backend, error) {
+						return []*fastly.Backend{}, nil
+					},
+					ListResourcesFn: func(i *fastly.ListResourcesInput) ([]*fastly.Resource, error) {
+						return []*fastly.Resource{}, nil
+					},
+				},
+				WantOutputs: []string{
+					`"service_id": "123"`,
+					`"resource": "backend"`,
+					`"resource": "config_store"`,
+					`"status": "missing"`,
+				},
+				WantError: "found 2 difference(s)",
+			},
+			manifest: setupManifest,
+		},
+		{
+			TestScenario: testutil.TestScenario{
+				Name: "only [setup.object_stores]",
+				Args: args("compute setup check"),
+				API: mock.API{
+					ListVersionsFn: testutil.ListVersions,
+					ListResourcesFn: func(i *fastly.ListResourcesInput) ([]*fastly.Resource, error) {
+						return []*fastly.Resource{}, nil
+					},
+				},
+				WantOutputs: []string{
+					"Comparing the [setup] configuration in fastly.toml with service 123 version 1:",
+					"'store' is missing",
+				},
+				WantError: "found 1 difference(s)",
+			},
+			manifest: `manifest_version = 2
+name = "package"
+service_id = "123"
+
+[setup.object_stores.store]
+`,
+		},
+		{
+			TestScenario: testutil.TestScenario{
+				Name: "list backends error",
+				Args: args("compute setup check"),
+				API: mock.API{
+					ListVersionsFn: testutil.ListVersions,
+					ListBackendsFn: func(i *fastly.ListBackendsInput) ([]*fastly.Backend, error) {
+						return nil, testutil.Err
+					},
+				},
+				WantError: "error listing backends: test error",
+			},
+			manifest: setupManifest,
+		},
+		{
+			TestScenario: testutil.TestScenario{
+				Name:      "validate --verbose and --json",
+				Args:      args("compute setup check --verbose --json"),
+				WantError: "invalid flag combination, --verbose and --json",
+			},
+			manifest: setupManifest,
+		},
+	}
+
+	for testcaseIdx := range scenarios {
+		testcase := &scenarios[testcaseIdx]
+		t.Run(testcase.Name, func(t *testing.T) {
+			if err := os.WriteFile(filepath.Join(rootdir, manifest.Filename), []byte(testcase.manifest), 0o600); err != nil {
+				t.Fatal(err)
+			}
+
+			var stdout bytes.Buffer
+			opts := testutil.MockGlobalData(testcase.Args, &stdout)
+			opts.APIClientFactory = mock.APIClient(testcase.API)
+
+			app.Init = func(_ []string, _ io.Reader) (*global.Data, error) {
+				return opts, nil
+			}
+			err := app.Run(testcase.Args, nil)
+
+			t.Log(stdout.String())
+
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.WantOutput)
+			for _, s := range testcase.WantOutputs {
+				testutil.AssertStringContains(t, stdout.String(), s)
+			}
+		})
+	}
+}
+
+func listBackendsSetupOK(_ *fastly.ListBackendsInput) ([]*fastly.Backend, error) {
+	return []*fastly.Backend{
+		{Name: "origin", Address: "example.com", Port: 443},
+	}, nil
+}
+
+func listResourcesSetupOK(_ *fastly.ListResourcesInput) ([]*fastly.Resource, error) {
+	return []*fastly.Resource{
+		{Name: "settings", ResourceID: "cs-123", ResourceType: "config"},
+	}, nil
+}
+
+func listConfigStoreItemsSetupOK(_ *fastly.ListConfigStoreItemsInput) ([]*fastly.ConfigStoreItem, error) {
+	return []*fastly.ConfigStoreItem{
+		{Key: "mode", Value: "production"},
+	}, nil
+}
@@ -0,0 +1,196 @@
+package compute
+
+import (
+	"crypto/ed25519"
+	"crypto/sha512"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	fsterr "github.com/fastly/cli/pkg/errors"
+)
+
+// SignatureFileExtension is appended to the package path to produce the path
+// of its detached signature (e.g. pkg/package.tar.gz.sig).
+const SignatureFileExtension = ".sig"
+
+// SigningKeyRemediation explains how to generate a signing key pair.
+var SigningKeyRemediation = strings.Join([]string{
+	"Provide a PEM encoded ed25519 key, which can be generated using:",
+	"`openssl genpkey -algorithm ed25519 -out signing-key.pem` (private key, for --sign-key) and",
+	"`openssl pkey -in signing-key.pem -pubout -out verify-key.pem` (public key, for --verify-key).",
+}, " ")
+
+// SignatureFilePath returns the path of the detached signature of a package.
+func SignatureFilePath(pkgPath string) string {
+	return pkgPath + SignatureFileExtension
+}
+
+// SignPackage signs the package with the ed25519 private key and writes the
+// detached signature alongside the package. It returns the signature path.
+//
+// The signature is computed over the SHA-512 digest of the package archive
+// (see packageDigest), so any change to the archive invalidates it.
+func SignPackage(pkgPath, keyPath string) (string, error) {
+	key, err := readSigningKey(keyPath)
+	if err != nil {
+		return "", err
+	}
+	digest, err := packageDigest(pkgPath)
+	if err != nil {
+		return "", err
+	}
+
+	sig := ed25519.Sign(key, digest)
+	sigPath := SignatureFilePath(pkgPath)
+	data := base64.StdEncoding.EncodeToString(sig) + "\n"
+	if err := os.WriteFile(sigPath, []byte(data), 0o644); err != nil { // #nosec G306
+		return "", fmt.Errorf("error writing package signature '%s': %w", sigPath, err)
+	}
+	return sigPath, nil
+}
+
+// VerifyPackage checks the detached signature of the package with the ed25519
+// public key.
+func VerifyPackage(pkgPath, keyPath string) error {
+	key, err := readVerifyKey(keyPath)
+	if err != nil {
+		return err
+	}
+
+	sigPath := SignatureFilePath(pkgPath)
+	sig, err := ReadPackageSignature(pkgPath)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return fsterr.RemediationError{
+				Inner:       fmt.Errorf("the package signature '%s' wasn't found", sigPath),
+				Remediation: "Sign the package using `fastly compute build --sign-key` or `fastly compute pack --sign-key`.",
+			}
+		}
+		return err
+	}
+
+	digest, err := packageDigest(pkgPath)
+	if err != nil {
+		return err
+	}
+	if !ed25519.Verify(key, digest, sig) {
+		return fsterr.RemediationError{
+			Inner:       fmt.Errorf("the package signature '%s' doesn't match the package '%s'", sigPath, pkgPath),
+			Remediation: "Ensure the package hasn't been modified since it was signed, and that --verify-key is the public key of the key it was signed with.",
+		}
+	}
+	return nil
+}
+
+// packageDigest returns the SHA-512 digest of the package archive.
+func packageDigest(pkgPath string) ([]byte, error) {
+	// gosec flagged this:
+	// G304 (CWE-22): Potential file inclusion via variable
+	// Disabling as the package is provided by the user.
+	// #nosec
+	data, err := os.ReadFile(pkgPath)
+	if err != nil {
+		return nil, fmt.Errorf("error reading package '%s': %w", pkgPath, err)
+	}
+	digest := sha512.Sum512(data)
+	return digest[:], nil
+}
+
+// ReadPackageSignature reads the detached signature of the package.
+func ReadPackageSignature(pkgPath string) ([]byte, error) {
+	sigPath := SignatureFilePath(pkgPath)
+	// gosec flagged this:
+	// G304 (CWE-22): Potential file inclusion via variable
+	// Disabling as the signature is stored alongside the user's package.
+	// #nosec
+	data, err := os.ReadFile(sigPath)
+	if err != nil {
+		return nil, err
+	}
+	sig, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(data)))
+	if err != nil || len(sig) != ed25519.SignatureSize {
+		return nil, fmt.Errorf("the package signature '%s' isn't a base64 encoded ed25519 signature", sigPath)
+	}
+	return sig, nil
+}
+
+// readSigningKey reads a PEM encoded (PKCS #8) ed25519 private key.
+func readSigningKey(path string) (ed25519.PrivateKey, error) {
+	block, err := readPEMKey(path)
+	if err != nil {
+		return nil, err
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, invalidKeyError(path, err)
+	}
+	k, ok := key.(ed25519.PrivateKey)
+	if !ok {
+		return nil, invalidKeyError(path, errors.New("not an ed25519 private key"))
+	}
+	return k, nil
+}
+
+// readVerifyKey reads a PEM encoded (PKIX) ed25519 public key.
+func readVerifyKey(path string) (ed25519.PublicKey, error) {
+	block, err := readPEMKey(path)
+	if err != nil {
+		return nil, err
+	}
+	key, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, invalidKeyError(path, err)
+	}
+	k, ok := key.(ed25519.PublicKey)
+	if !ok {
+		return nil, invalidKeyError(path, errors.New("not an ed25519 public key"))
+	}
+	return k, nil
+}
+
+// readPEMKey reads the first PEM block of the key file.
+func readPEMKey(path string) (*pem.Block, error) {
+	// gosec flagged this:
+	// G304 (CWE-22): Potential file inclusion via variable
+	// Disabling as the key is provided by the user.
+	// #nosec
+	data, err := os.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return nil, fsterr.RemediationError{
+			Inner:       fmt.Errorf("failed to read key '%s': %w", path, err),
+			Remediation: SigningKeyRemediation,
+		}
+	}
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, invalidKeyError(path, errors.New("no PEM data found"))
+	}
+	return block, nil
+}
+
+// invalidKeyError reports a key that couldn't be parsed.
+func invalidKeyError(path string, err error) error {
+	return fsterr.RemediationError{
+		Inner:       fmt.Errorf("invalid key '%s': %w", path, err),
+		Remediation: SigningKeyRemediation,
+	}
+}
+
+// absPath resolves a path provided by the user relative to the directory the
+// CLI was run from, as commands change to the project directory (--dir).
+func absPath(path string) (string, error) {
+	if path == "" || filepath.IsAbs(path) {
+		return path, nil
+	}
+	p, err := filepath.Abs(path)
+	if err != nil {
+		return "", fmt.Errorf("failed to construct absolute path to '%s': %w", path, err)
+	}
+	return p, nil
+}
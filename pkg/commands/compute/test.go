@@ -0,0 +1,609 @@
+package compute
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+	"time"
+
+	toml "github.com/pelletier/go-toml"
+
+	"github.com/fastly/cli/pkg/argparser"
+	fsterr "github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/global"
+	"github.com/fastly/cli/pkg/text"
+)
+
+// TestsFilename is the default file describing the `compute test` cases.
+const TestsFilename = "fastly-tests.toml"
+
+// TestFile is the content of a file describing the `compute test` cases.
+//
+// Example:
+//
+//	[[test]]
+//	name = "homepage"
+//	[test.request]
+//	method = "GET"
+//	path = "/"
+//	[test.expect]
+//	status = 200
+//	headers = { "Content-Type" = "text/html" }
+//	body_contains = "Welcome"
+type TestFile struct {
+	Tests []TestCase `toml:"test"`
+}
+
+// TestCase is a request to send to the local server and the response that is
+// expected in return.
+type TestCase struct {
+	Name    string      `toml:"name"`
+	Request TestRequest `toml:"request"`
+	Expect  TestExpect  `toml:"expect"`
+}
+
+// TestRequest describes the HTTP request sent for a test case.
+type TestRequest struct {
+	Body    string            `toml:"body,omitempty"`
+	Headers map[string]string `toml:"headers,omitempty"`
+	Method  string            `toml:"method,omitempty"`
+	Path    string            `toml:"path"`
+}
+
+// TestExpect describes the expected HTTP response for a test case.
+//
+// Only the defined fields are checked, so a test case can (for example) only
+// validate the status code.
+type TestExpect struct {
+	Body         string            `toml:"body,omitempty"`
+	BodyContains string            `toml:"body_contains,omitempty"`
+	Headers      map[string]string `toml:"headers,omitempty"`
+	Status       int               `toml:"status,omitempty"`
+}
+
+// ReadTestFile reads and validates the test cases defined in path.
+func ReadTestFile(path string) (TestFile, error) {
+	var tf TestFile
+
+	// gosec flagged this:
+	// G304 (CWE-22): Potential file inclusion via variable
+	// Disabling as we need to load the tests file from the user's project.
+	// #nosec
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return tf, err
+	}
+	if err := toml.Unmarshal(data, &tf); err != nil {
+		return tf, fmt.Errorf("error parsing tests file '%s': %w", path, err)
+	}
+	if len(tf.Tests) == 0 {
+		return tf, fmt.Errorf("no tests defined in '%s'", path)
+	}
+	for i, tc := range tf.Tests {
+		if !strings.HasPrefix(tc.Request.Path, "/") {
+			return tf, fmt.Errorf("test %d in '%s': request path must begin with a forward slash (got %q)", i+1, path, tc.Request.Path)
+		}
+		if tc.Request.Method == "" {
+			tf.Tests[i].Request.Method = http.MethodGet
+		}
+		if tc.Name == "" {
+			tf.Tests[i].Name = fmt.Sprintf("%s %s", tf.Tests[i].Request.Method, tc.Request.Path)
+		}
+	}
+	return tf, nil
+}
+
+// Check returns a description of each way the response doesn't match the
+// expectation (an empty list indicates the response matches).
+func (e TestExpect) Check(status int, header http.Header, body []byte) []string {
+	var failures []string
+	if e.Status != 0 && e.Status != status {
+		failures = append(failures, fmt.Sprintf("expected status %d, got %d", e.Status, status))
+	}
+
+	keys := make([]string, 0, len(e.Headers))
+	for k := range e.Headers {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		if _, ok := header[http.CanonicalHeaderKey(k)]; !ok {
+			failures = append(failures, fmt.Sprintf("expected header %s: %q, but the header was missing", k, e.Headers[k]))
+			continue
+		}
+		if have := header.Get(k); have != e.Headers[k] {
+			failures = append(failures, fmt.Sprintf("expected header %s: %q, got %q", k, e.Headers[k], have))
+		}
+	}
+
+	if e.Body != "" && e.Body != string(body) {
+		failures = append(failures, fmt.Sprintf("expected body %q, got %q", e.Body, truncate(string(body))))
+	}
+	if e.BodyContains != "" && !bytes.Contains(body, []byte(e.BodyContains)) {
+		failures = append(failures, fmt.Sprintf("expected body to contain %q, got %q", e.BodyContains, truncate(string(body))))
+	}
+	return failures
+}
+
+// TestResult is the outcome of running a single test case.
+type TestResult struct {
+	Duration time.Duration
+	Failures []string
+	Name     string
+}
+
+// TestCommand builds a package and runs the test cases against it under
+// Viceroy (the local server).
+type TestCommand struct {
+	argparser.Base
+	build *BuildCommand
+	serve *ServeCommand
+
+	// Build fields
+	cache                 argparser.OptionalBool
+	dir                   argparser.OptionalString
+	env                   argparser.OptionalString
+	includeSrc            argparser.OptionalBool
+	lang                  argparser.OptionalString
+	metadataDisable       argparser.OptionalBool
+	metadataFilterEnvVars argparser.OptionalString
+	metadataShow          argparser.OptionalBool
+	packageName           argparser.OptionalString
+	timeout               argparser.OptionalInt
+
+	// Test fields
+	addr                    string
+	file                    string
+	forceCheckViceroyLatest bool
+	junit                   string
+	skipBuild               bool
+	startupTimeout          int
+	testsFile               string
+	viceroyBinPath          string
+}
+
+// NewTestCommand returns a usable command registered under the parent.
+func NewTestCommand(parent argparser.Registerer, g *global.Data, build *BuildCommand, serve *ServeCommand) *TestCommand {
+	var c TestCommand
+	c.build = build
+	c.serve = serve
+	c.Globals = g
+	c.CmdClause = parent.Command("test", "Build a Compute package and run HTTP tests against it using a local server")
+
+	c.CmdClause.Flag("addr", "The IPv4 address and port for the local server to listen on (default: a random available port)").StringVar(&c.addr)
+	c.CmdClause.Flag("cache", "Use the build cache to skip compiling a project that hasn't changed since the last build (use --no-cache to force a rebuild)").Action(c.cache.Set).NegatableBoolVar(&c.cache.Value)
+	c.CmdClause.Flag("dir", "Project directory to build (default: current directory)").Short('C').Action(c.dir.Set).StringVar(&c.dir.Value)
+	c.CmdClause.Flag("env", "The manifest environment config to use (e.g. 'stage' will attempt to read 'fastly.stage.toml')").Action(c.env.Set).StringVar(&c.env.Value)
+	c.CmdClause.Flag("file", "The Wasm file to run").Default("bin/main.wasm").StringVar(&c.file)
+	c.CmdClause.Flag("include-source", "Include source code in built package").Action(c.includeSrc.Set).BoolVar(&c.includeSrc.Value)
+	c.CmdClause.Flag("junit", "Write the test results as JUnit XML to the given file path").StringVar(&c.junit)
+	c.CmdClause.Flag("language", "Language type").Action(c.lang.Set).StringVar(&c.lang.Value)
+	c.CmdClause.Flag("metadata-disable", "Disable Wasm binary metadata annotations").Action(c.metadataDisable.Set).BoolVar(&c.metadataDisable.Value)
+	c.CmdClause.Flag("metadata-filter-envvars", "Redact specified environment variables from [scripts.env_vars] using comma-separated list").Action(c.metadataFilterEnvVars.Set).StringVar(&c.metadataFilterEnvVars.Value)
+	c.CmdClause.Flag("metadata-show", "Inspect the Wasm binary metadata").Action(c.metadataShow.Set).BoolVar(&c.metadataShow.Value)
+	c.CmdClause.Flag("package-name", "Package name").Action(c.packageName.Set).StringVar(&c.packageName.Value)
+	c.CmdClause.Flag("skip-build", "Skip the build step").BoolVar(&c.skipBuild)
+	c.CmdClause.Flag("startup-timeout", "Timeout, in seconds, for the local server to start accepting requests").Default("30").IntVar(&c.startupTimeout)
+	c.CmdClause.Flag("tests", "Path to the file describing the test cases (relative to the project directory)").Default(TestsFilename).StringVar(&c.testsFile)
+	c.CmdClause.Flag("timeout", "Timeout, in seconds, for the build compilation step").Action(c.timeout.Set).IntVar(&c.timeout.Value)
+	c.CmdClause.Flag("viceroy-check", "Force the CLI to check for a newer version of the Viceroy binary").BoolVar(&c.forceCheckViceroyLatest)
+	c.CmdClause.Flag("viceroy-path", "The path to a user installed version of the Viceroy binary").StringVar(&c.viceroyBinPath)
+
+	return &c
+}
+
+// Exec implements the command interface.
+func (c *TestCommand) Exec(in io.Reader, out io.Writer) (err error) {
+	if runtime.GOARCH == "386" {
+		return fsterr.RemediationError{
+			Inner:       errors.New("this command doesn't support the '386' architecture"),
+			Remediation: "Although the Fastly CLI supports '386', the `compute test` command requires https://github.com/fastly/Viceroy which does not.",
+		}
+	}
+
+	if !c.skipBuild {
+		err = c.Build(in, out)
+		if err != nil {
+			return err
+		}
+		text.Break(out)
+	}
+
+	manifestFilename := EnvironmentManifest(c.env.Value)
+	wd, err := os.Getwd()
+	if err != nil {
+		c.Globals.ErrLog.Add(err)
+		return fmt.Errorf("failed to get current working directory: %w", err)
+	}
+	defer func() {
+		_ = os.Chdir(wd)
+	}()
+	manifestPath := filepath.Join(wd, manifestFilename)
+
+	projectDir, err := ChangeProjectDirectory(c.dir.Value)
+	if err != nil {
+		return err
+	}
+	if projectDir != "" {
+		if c.Globals.Verbose() {
+			text.Info(out, ProjectDirMsg, projectDir)
+		}
+		manifestPath = filepath.Join(projectDir, manifestFilename)
+	}
+
+	// NOTE: We read the manifest again to catch a skip-build scenario.
+	// See the equivalent logic in `compute serve` for more details.
+	if c.skipBuild {
+		if err := c.Globals.Manifest.File.Read(manifestPath); err != nil {
+			return fmt.Errorf("failed to parse manifest '%s': %w", manifestPath, err)
+		}
+		c.serve.ViceroyVersioner.SetRequestedVersion(c.Globals.Manifest.File.LocalServer.ViceroyVersion)
+	}
+
+	tests, err := ReadTestFile(c.testsFile)
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]any{
+			"Tests file": c.testsFile,
+		})
+		if errors.Is(err, os.ErrNotExist) {
+			return fsterr.RemediationError{
+				Inner:       fmt.Errorf("failed to read tests file: %w", err),
+				Remediation: fmt.Sprintf("Create a %s file describing the requests to send and the responses to expect, or use the --tests flag to reference a different file.", TestsFilename),
+			}
+		}
+		return err
+	}
+
+	if _, err := os.Stat(c.file); err != nil {
+		var skipBuildMsg string
+		if c.skipBuild {
+			skipBuildMsg = " (or avoid using --skip-build)"
+		}
+		return fsterr.RemediationError{
+			Inner:       fmt.Errorf("failed to locate Wasm binary: %w", err),
+			Remediation: fmt.Sprintf("Run `fastly compute build` to produce the Wasm binary%s, or use the --file flag to reference a different Wasm binary.", skipBuildMsg),
+		}
+	}
+
+	spinner, err := text.NewSpinner(out)
+	if err != nil {
+		return err
+	}
+
+	c.serve.ForceCheckViceroyLatest = c.forceCheckViceroyLatest
+	c.serve.ViceroyBinPath = c.viceroyBinPath
+	bin, err := c.serve.GetViceroy(spinner, out, manifestPath)
+	if err != nil {
+		return err
+	}
+
+	addr := c.addr
+	if addr == "" {
+		addr, err = availableAddr()
+		if err != nil {
+			return fmt.Errorf("failed to find an available port for the local server: %w", err)
+		}
+	}
+
+	srv, err := startViceroy(spinner, c.Globals.ErrLog, bin, manifestPath, addr, c.file, c.startupTimeout)
+	if err != nil {
+		return err
+	}
+
+	results := c.runTests(out, addr, tests.Tests)
+
+	if err := srv.stop(); err != nil {
+		c.Globals.ErrLog.Add(err)
+	}
+	if c.Globals.Verbose() {
+		text.Break(out)
+		text.Output(out, "%s:\n%s", text.BoldYellow("Viceroy output"), srv.output.String())
+	}
+
+	if c.junit != "" {
+		if !filepath.IsAbs(c.junit) {
+			c.junit = filepath.Join(wd, c.junit)
+		}
+		if err := WriteJUnit(c.junit, c.Globals.Manifest.File.Name, results); err != nil {
+			c.Globals.ErrLog.AddWithContext(err, map[string]any{
+				"JUnit file": c.junit,
+			})
+			return fmt.Errorf("failed to write JUnit report: %w", err)
+		}
+		if c.Globals.Verbose() {
+			text.Info(out, "JUnit report written to %s", c.junit)
+		}
+	}
+
+	var failed int
+	for _, r := range results {
+		if len(r.Failures) > 0 {
+			failed++
+		}
+	}
+	text.Break(out)
+	if failed > 0 {
+		return fmt.Errorf("%d of %d tests failed", failed, len(results))
+	}
+	text.Success(out, "All %d tests passed", len(results))
+	return nil
+}
+
+// Build constructs and executes the build logic.
+func (c *TestCommand) Build(in io.Reader, out io.Writer) error {
+	// Reset the fields on the BuildCommand based on TestCommand values.
+	if c.cache.WasSet {
+		c.build.Flags.NoCache = !c.cache.Value
+	}
+	if c.dir.WasSet {
+		c.build.Flags.Dir = c.dir.Value
+	}
+	if c.env.WasSet {
+		c.build.Flags.Env = c.env.Value
+	}
+	if c.includeSrc.WasSet {
+		c.build.Flags.IncludeSrc = c.includeSrc.Value
+	}
+	if c.lang.WasSet {
+		c.build.Flags.Lang = c.lang.Value
+	}
+	if c.packageName.WasSet {
+		c.build.Flags.PackageName = c.packageName.Value
+	}
+	if c.timeout.WasSet {
+		c.build.Flags.Timeout = c.timeout.Value
+	}
+	if c.metadataDisable.WasSet {
+		c.build.MetadataDisable = c.metadataDisable.Value
+	}
+	if c.metadataFilterEnvVars.WasSet {
+		c.build.MetadataFilterEnvVars = c.metadataFilterEnvVars.Value
+	}
+	if c.metadataShow.WasSet {
+		c.build.MetadataShow = c.metadataShow.Value
+	}
+
+	return c.build.Exec(in, out)
+}
+
+// viceroyProcess is a running instance of the local server.
+type viceroyProcess struct {
+	cmd    *exec.Cmd
+	done   chan error
+	output *bytes.Buffer
+}
+
+// stop kills the local server and waits for it to exit.
+func (p *viceroyProcess) stop() error {
+	if err := p.cmd.Process.Kill(); err != nil && !errors.Is(err, os.ErrProcessDone) {
+		return fmt.Errorf("failed to stop the local server: %w", err)
+	}
+	<-p.done
+	return nil
+}
+
+// startViceroy starts the local server and waits (up to startupTimeout
+// seconds) for it to accept connections.
+func startViceroy(spinner text.Spinner, errLog fsterr.LogInterface, bin, manifestPath, addr, file string, startupTimeout int) (*viceroyProcess, error) {
+	p := &viceroyProcess{
+		done:   make(chan error, 1),
+		output: new(bytes.Buffer),
+	}
+
+	// NOTE: Viceroy only displays errors in verbose mode (see `compute serve`).
+	//
+	// gosec flagged this:
+	// G204 (CWE-78): Subprocess launched with variable
+	// Disabling as we trust the source of the variables.
+	// #nosec
+	// nosemgrep: go.lang.security.audit.dangerous-exec-command.dangerous-exec-command
+	p.cmd = exec.Command(bin, "-v", "-C", manifestPath, "--addr", addr, file)
+	p.cmd.Env = os.Environ()
+	p.cmd.Stdout = p.output
+	p.cmd.Stderr = p.output
+
+	err := spinner.Process(fmt.Sprintf("Starting local server (%s)", addr), func(_ *text.SpinnerWrapper) error {
+		if err := p.cmd.Start(); err != nil {
+			errLog.Add(err)
+			return fmt.Errorf("failed to start the local server: %w", err)
+		}
+		go func() {
+			p.done <- p.cmd.Wait()
+		}()
+
+		deadline := time.Now().Add(time.Duration(startupTimeout) * time.Second)
+		for {
+			conn, err := net.DialTimeout("tcp", addr, time.Second)
+			if err == nil {
+				_ = conn.Close()
+				return nil
+			}
+			select {
+			case err := <-p.done:
+				p.done <- err // allow stop() to consume the exit status
+				return fsterr.RemediationError{
+					Inner:       fmt.Errorf("the local server exited unexpectedly: %w", err),
+					Remediation: fmt.Sprintf("Viceroy output:\n\n%s", p.output.String()),
+				}
+			case <-time.After(100 * time.Millisecond):
+			}
+			if time.Now().After(deadline) {
+				_ = p.stop()
+				return fsterr.RemediationError{
+					Inner:       fmt.Errorf("timed out waiting for the local server to listen on %s", addr),
+					Remediation: fmt.Sprintf("Increase the --startup-timeout value. Viceroy output:\n\n%s", p.output.String()),
+				}
+			}
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// runTests sends each test case request to the local server and checks the
+// response against the expectation.
+func (c *TestCommand) runTests(out io.Writer, addr string, tests []TestCase) []TestResult {
+	client := &http.Client{
+		Timeout: 30 * time.Second,
+		// NOTE: We don't follow redirects so they can be tested for.
+		CheckRedirect: func(_ *http.Request, _ []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+
+	results := make([]TestResult, 0, len(tests))
+	for _, tc := range tests {
+		start := time.Now()
+		failures := runTest(client, addr, tc)
+		r := TestResult{
+			Duration: time.Since(start),
+			Failures: failures,
+			Name:     tc.Name,
+		}
+		results = append(results, r)
+
+		if len(failures) == 0 {
+			text.Output(out, "%s %s (%s)", text.BoldGreen("PASS"), r.Name, r.Duration.Round(time.Millisecond))
+			continue
+		}
+		text.Output(out, "%s %s (%s)", text.BoldRed("FAIL"), r.Name, r.Duration.Round(time.Millisecond))
+		for _, f := range failures {
+			text.Output(out, "     %s", f)
+		}
+	}
+	return results
+}
+
+// runTest sends the test case request and returns any failed expectations.
+func runTest(client *http.Client, addr string, tc TestCase) []string {
+	req, err := http.NewRequest(tc.Request.Method, "http://"+addr+tc.Request.Path, strings.NewReader(tc.Request.Body))
+	if err != nil {
+		return []string{fmt.Sprintf("failed to construct request: %s", err)}
+	}
+	for k, v := range tc.Request.Headers {
+		if strings.EqualFold(k, "Host") {
+			req.Host = v
+			continue
+		}
+		req.Header.Set(k, v)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return []string{fmt.Sprintf("request failed: %s", err)}
+	}
+	defer resp.Body.Close() // #nosec G307
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return []string{fmt.Sprintf("failed to read response body: %s", err)}
+	}
+	return tc.Expect.Check(resp.StatusCode, resp.Header, body)
+}
+
+// availableAddr returns a local address with a port that isn't in use.
+func availableAddr() (string, error) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return "", err
+	}
+	defer l.Close()
+	return l.Addr().String(), nil
+}
+
+// truncate shortens long response bodies displayed in failure messages.
+func truncate(s string) string {
+	const limit = 200
+	if len(s) <= limit {
+		return s
+	}
+	return s[:limit] + "..."
+}
+
+// junitTestSuites is the root element of a JUnit XML report.
+type junitTestSuites struct {
+	XMLName  xml.Name         `xml:"testsuites"`
+	Failures int              `xml:"failures,attr"`
+	Suites   []junitTestSuite `xml:"testsuite"`
+	Tests    int              `xml:"tests,attr"`
+	Time     string           `xml:"time,attr"`
+}
+
+// junitTestSuite is a group of test cases in a JUnit XML report.
+type junitTestSuite struct {
+	Cases    []junitTestCase `xml:"testcase"`
+	Failures int             `xml:"failures,attr"`
+	Name     string          `xml:"name,attr"`
+	Tests    int             `xml:"tests,attr"`
+	Time     string          `xml:"time,attr"`
+}
+
+// junitTestCase is a single test case in a JUnit XML report.
+type junitTestCase struct {
+	Classname string        `xml:"classname,attr"`
+	Failure   *junitFailure `xml:"failure,omitempty"`
+	Name      string        `xml:"name,attr"`
+	Time      string        `xml:"time,attr"`
+}
+
+// junitFailure describes why a test case failed in a JUnit XML report.
+type junitFailure struct {
+	Message  string `xml:"message,attr"`
+	Contents string `xml:",chardata"`
+}
+
+// WriteJUnit writes the test results to path as a JUnit XML report.
+func WriteJUnit(path, suite string, results []TestResult) error {
+	if suite == "" {
+		suite = "compute"
+	}
+	s := junitTestSuite{Name: suite, Tests: len(results)}
+	var total time.Duration
+	for _, r := range results {
+		total += r.Duration
+		tc := junitTestCase{
+			Classname: suite,
+			Name:      r.Name,
+			Time:      junitTime(r.Duration),
+		}
+		if len(r.Failures) > 0 {
+			s.Failures++
+			tc.Failure = &junitFailure{
+				Message:  r.Failures[0],
+				Contents: strings.Join(r.Failures, "\n"),
+			}
+		}
+		s.Cases = append(s.Cases, tc)
+	}
+	s.Time = junitTime(total)
+
+	report := junitTestSuites{
+		Failures: s.Failures,
+		Suites:   []junitTestSuite{s},
+		Tests:    s.Tests,
+		Time:     s.Time,
+	}
+	data, err := xml.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return err
+	}
+	data = append([]byte(xml.Header), append(data, '\n')...)
+	return os.WriteFile(path, data, 0o644) // #nosec G306
+}
+
+// junitTime formats a duration in seconds as expected by JUnit reports.
+func junitTime(d time.Duration) string {
+	return fmt.Sprintf("%.3f", d.Seconds())
+}
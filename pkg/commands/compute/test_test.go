@@ -0,0 +1,206 @@
+package compute_test
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/fastly/cli/pkg/app"
+	"github.com/fastly/cli/pkg/commands/compute"
+	"github.com/fastly/cli/pkg/global"
+	"github.com/fastly/cli/pkg/manifest"
+	"github.com/fastly/cli/pkg/mock"
+	"github.com/fastly/cli/pkg/testutil"
+	"github.com/fastly/cli/pkg/threadsafe"
+)
+
+// TestHelperViceroy isn't a real test. It's run as a subprocess (via the
+// fake Viceroy binary created by TestTest) so the test binary can act as the
+// local server.
+func TestHelperViceroy(_ *testing.T) {
+	if os.Getenv("FASTLY_TEST_HELPER_VICEROY") != "1" {
+		return
+	}
+	var addr string
+	for i, arg := range os.Args {
+		if arg == "--addr" && i+1 < len(os.Args) {
+			addr = os.Args[i+1]
+		}
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "text/plain")
+		_, _ = fmt.Fprintf(w, "Hello from %s", r.Host)
+	})
+	_ = http.ListenAndServe(addr, mux) // #nosec G114
+	os.Exit(0)
+}
+
+func TestTest(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("the fake Viceroy binary is a bash script")
+	}
+
+	testBin, err := os.Executable()
+	if err != nil {
+		t.Fatal(err)
+	}
+	viceroy := fmt.Sprintf(`#!/usr/bin/env bash
+FASTLY_TEST_HELPER_VICEROY=1 exec %q -test.run='^TestHelperViceroy$' -- "$@"
+`, testBin)
+
+	passingTests := `
+[[test]]
+name = "homepage"
+[test.request]
+path = "/"
+headers = { Host = "example.com" }
+[test.expect]
+status = 200
+headers = { "Content-Type" = "text/plain" }
+body = "Hello from example.com"
+
+[[test]]
+[test.request]
+method = "POST"
+path = "/"
+[test.expect]
+body_contains = "Hello"
+`
+	failingTests := passingTests + `
+[[test]]
+name = "missing page"
+[test.request]
+path = "/missing"
+[test.expect]
+status = 200
+`
+
+	args := testutil.Args
+	scenarios := []struct {
+		name       string
+		args       []string
+		tests      string
+		viceroy    string
+		wantError  string
+		wantOutput []string
+		wantJUnit  []string
+	}{
+		{
+			name:    "all tests pass",
+			args:    args("compute test --skip-build --viceroy-path ./viceroy --junit report.xml"),
+			tests:   passingTests,
+			viceroy: viceroy,
+			wantOutput: []string{
+				"PASS homepage",
+				"PASS POST /",
+				"All 2 tests passed",
+			},
+			wantJUnit: []string{
+				`<testsuites failures="0" tests="2"`,
+				`<testsuite failures="0" name="package" tests="2"`,
+				`<testcase classname="package" name="homepage"`,
+			},
+		},
+		{
+			name:      "a test fails",
+			args:      args("compute test --skip-build --viceroy-path ./viceroy --junit report.xml"),
+			tests:     failingTests,
+			viceroy:   viceroy,
+			wantError: "1 of 3 tests failed",
+			wantOutput: []string{
+				"PASS homepage",
+				"FAIL missing page",
+				"expected status 200, got 404",
+			},
+			wantJUnit: []string{
+				`<testsuites failures="1" tests="3"`,
+				`<failure message="expected status 200, got 404">`,
+			},
+		},
+		{
+			name:      "missing tests file",
+			args:      args("compute test --skip-build --viceroy-path ./viceroy"),
+			viceroy:   viceroy,
+			wantError: "failed to read tests file",
+		},
+		{
+			name:      "invalid request path",
+			args:      args("compute test --skip-build --viceroy-path ./viceroy"),
+			tests:     "[[test]]\n[test.request]\npath = \"missing-slash\"\n",
+			viceroy:   viceroy,
+			wantError: "request path must begin with a forward slash",
+		},
+		{
+			name:      "local server fails to start",
+			args:      args("compute test --skip-build --viceroy-path ./viceroy"),
+			tests:     passingTests,
+			viceroy:   "#!/usr/bin/env bash\necho 'invalid configuration'\nexit 1\n",
+			wantError: "the local server exited unexpectedly",
+		},
+	}
+
+	for _, testcase := range scenarios {
+		t.Run(testcase.name, func(t *testing.T) {
+			pwd, err := os.Getwd()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			write := []testutil.FileIO{
+				{Src: "manifest_version = 2\nname = \"package\"\n", Dst: manifest.Filename},
+				{Src: "\x00asm\x01\x00\x00\x00", Dst: filepath.Join("bin", "main.wasm")},
+				{Src: testcase.viceroy, Dst: "viceroy", Executable: true},
+			}
+			if testcase.tests != "" {
+				write = append(write, testutil.FileIO{Src: testcase.tests, Dst: compute.TestsFilename})
+			}
+			rootdir := testutil.NewEnv(testutil.EnvOpts{
+				T:     t,
+				Write: write,
+			})
+			defer os.RemoveAll(rootdir)
+
+			if err := os.Chdir(rootdir); err != nil {
+				t.Fatal(err)
+			}
+			defer func() {
+				_ = os.Chdir(pwd)
+			}()
+
+			var stdout threadsafe.Buffer
+			app.Init = func(_ []string, _ io.Reader) (*global.Data, error) {
+				opts := testutil.MockGlobalData(testcase.args, &stdout)
+				opts.Versioners = global.Versioners{
+					Viceroy: mock.AssetVersioner{},
+				}
+				return opts, nil
+			}
+			err = app.Run(testcase.args, nil)
+
+			t.Log(stdout.String())
+
+			testutil.AssertErrorContains(t, err, testcase.wantError)
+			for _, s := range testcase.wantOutput {
+				testutil.AssertStringContains(t, stdout.String(), s)
+			}
+			if len(testcase.wantJUnit) > 0 {
+				report, err := os.ReadFile(filepath.Join(rootdir, "report.xml"))
+				if err != nil {
+					t.Fatal(err)
+				}
+				for _, s := range testcase.wantJUnit {
+					testutil.AssertStringContains(t, string(report), s)
+				}
+			}
+		})
+	}
+}
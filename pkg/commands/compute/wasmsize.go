@@ -0,0 +1,532 @@
+package compute
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// SizeHistoryFile is the file (relative to the project directory) recording
+// the size report of the current and previous builds.
+const SizeHistoryFile = "pkg/.size-history.json"
+
+// PackageSizeWarnThreshold is the proportion of MaxPackageSize above which
+// `compute inspect` warns the package is approaching the limit.
+const PackageSizeWarnThreshold = 0.8
+
+// wasmMagic is the preamble of a Wasm binary (magic number and version).
+var wasmMagic = []byte{0x00, 0x61, 0x73, 0x6d, 0x01, 0x00, 0x00, 0x00}
+
+// wasmSectionNames maps a Wasm section ID to its name.
+var wasmSectionNames = map[byte]string{
+	0:  "custom",
+	1:  "type",
+	2:  "import",
+	3:  "function",
+	4:  "table",
+	5:  "memory",
+	6:  "global",
+	7:  "export",
+	8:  "start",
+	9:  "element",
+	10: "code",
+	11: "data",
+	12: "datacount",
+	13: "tag",
+}
+
+// SizeEntry is the size, in bytes, of a named part of the Wasm binary.
+type SizeEntry struct {
+	Name string `json:"name"`
+	Size int64  `json:"size"`
+}
+
+// SizeReport is a breakdown of the size of a build.
+type SizeReport struct {
+	// Crates is the size of the function bodies grouped by the crate (or
+	// package) the function belongs to. It's empty when the Wasm binary has no
+	// "name" section.
+	Crates []SizeEntry `json:"crates,omitempty"`
+	// CustomSections is the size of each custom section (e.g. name, producers).
+	CustomSections []SizeEntry `json:"custom_sections"`
+	// Functions is the size of each function body. It's empty when the Wasm
+	// binary has no "name" section.
+	//
+	// NOTE: It's not persisted to SizeHistoryFile as it can be very large.
+	Functions []SizeEntry `json:"functions,omitempty"`
+	// PackageSize is the size of the package archive (zero if not found).
+	PackageSize int64 `json:"package_size"`
+	// Sections is the size of each section (including the section header).
+	Sections []SizeEntry `json:"sections"`
+	// WasmHash is the SHA256 hash of the Wasm binary.
+	WasmHash string `json:"wasm_hash"`
+	// WasmSize is the size of the Wasm binary.
+	WasmSize int64 `json:"wasm_size"`
+}
+
+// SizeHistory records the size report of the current and previous builds.
+type SizeHistory struct {
+	Current  *SizeReport `json:"current,omitempty"`
+	Previous *SizeReport `json:"previous,omitempty"`
+}
+
+// ReadSizeHistory reads the size history from the project directory.
+func ReadSizeHistory() (SizeHistory, error) {
+	var h SizeHistory
+	data, err := os.ReadFile(SizeHistoryFile)
+	if err != nil {
+		return h, err
+	}
+	err = json.Unmarshal(data, &h)
+	return h, err
+}
+
+// Write persists the size history to the project directory.
+func (h SizeHistory) Write() error {
+	data, err := json.MarshalIndent(h, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(SizeHistoryFile), 0o750); err != nil {
+		return err
+	}
+	return os.WriteFile(SizeHistoryFile, data, 0o600)
+}
+
+// Baseline returns the report of the build before the one identified by
+// wasmHash (nil if there isn't one).
+func (h SizeHistory) Baseline(wasmHash string) *SizeReport {
+	if h.Current != nil && h.Current.WasmHash != wasmHash {
+		return h.Current
+	}
+	return h.Previous
+}
+
+// Record adds the report to the history. The current report becomes the
+// previous one unless it's for the same Wasm binary.
+func (h *SizeHistory) Record(r SizeReport) {
+	r.Functions = nil
+	if h.Current != nil && h.Current.WasmHash != r.WasmHash {
+		h.Previous = h.Current
+	}
+	h.Current = &r
+}
+
+// recordSizeReport adds the size report of the Wasm binary and package to the
+// size history, so `compute inspect` can compare a build with the previous one.
+func recordSizeReport(wasmPath, pkgPath string) error {
+	r, err := NewSizeReport(wasmPath, pkgPath)
+	if err != nil {
+		return err
+	}
+	h, err := ReadSizeHistory()
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		// A corrupt history is replaced.
+		h = SizeHistory{}
+	}
+	h.Record(r)
+	return h.Write()
+}
+
+// NewSizeReport analyses the Wasm binary and package archive (which is
+// optional and ignored if it doesn't exist).
+func NewSizeReport(wasmPath, pkgPath string) (SizeReport, error) {
+	var r SizeReport
+
+	// gosec flagged this:
+	// G304 (CWE-22): Potential file inclusion via variable
+	// Disabling as the path is within the user's project directory.
+	// #nosec
+	data, err := os.ReadFile(wasmPath)
+	if err != nil {
+		return r, err
+	}
+	r, err = analyseWasm(data)
+	if err != nil {
+		return r, fmt.Errorf("error parsing Wasm binary '%s': %w", wasmPath, err)
+	}
+	r.WasmHash, err = fileHash(wasmPath)
+	if err != nil {
+		return r, err
+	}
+
+	if pkgPath != "" {
+		if fi, err := os.Stat(pkgPath); err == nil {
+			r.PackageSize = fi.Size()
+		}
+	}
+	return r, nil
+}
+
+// analyseWasm breaks down the size of a Wasm binary by section, custom section
+// and (when the binary has a "name" section) function and crate.
+func analyseWasm(data []byte) (SizeReport, error) {
+	r := SizeReport{WasmSize: int64(len(data))}
+	if len(data) < len(wasmMagic) || string(data[:len(wasmMagic)]) != string(wasmMagic) {
+		return r, errors.New("not a Wasm module (invalid magic number or version)")
+	}
+
+	var (
+		bodies        []int64
+		importedFuncs int
+		names         map[int]string
+		sections      = make(map[string]int64)
+		custom        = make(map[string]int64)
+	)
+
+	rd := &wasmReader{data: data, pos: len(wasmMagic)}
+	for rd.pos < len(rd.data) {
+		start := rd.pos
+		id, err := rd.byte()
+		if err != nil {
+			return r, err
+		}
+		size, err := rd.u32()
+		if err != nil {
+			return r, err
+		}
+		content, err := rd.bytes(int(size))
+		if err != nil {
+			return r, fmt.Errorf("section %d: %w", id, err)
+		}
+		total := int64(rd.pos - start)
+
+		name, ok := wasmSectionNames[id]
+		if !ok {
+			name = fmt.Sprintf("unknown (%d)", id)
+		}
+		sections[name] += total
+
+		// NOTE: The contents of the sections we look into are best effort, a
+		// failure to parse them only means the breakdown is less detailed.
+		switch id {
+		case 0:
+			cr := &wasmReader{data: content}
+			customName, err := cr.name()
+			if err != nil {
+				customName = "(invalid)"
+			}
+			custom[customName] += total
+			if customName == "name" {
+				names, _ = parseFunctionNames(cr)
+			}
+		case 2:
+			importedFuncs, _ = countImportedFunctions(&wasmReader{data: content})
+		case 10:
+			bodies, _ = parseFunctionBodies(&wasmReader{data: content})
+		}
+	}
+
+	r.Sections = sortedSizeEntries(sections)
+	r.CustomSections = sortedSizeEntries(custom)
+
+	if len(names) > 0 {
+		functions := make(map[string]int64)
+		crates := make(map[string]int64)
+		for i, size := range bodies {
+			name, ok := names[importedFuncs+i]
+			if !ok {
+				name = fmt.Sprintf("func[%d]", importedFuncs+i)
+			}
+			name = demangle(name)
+			functions[name] += size
+			crate := crateName(name)
+			if crate == "" {
+				crate = "(unknown)"
+			}
+			crates[crate] += size
+		}
+		r.Functions = sortedSizeEntries(functions)
+		r.Crates = sortedSizeEntries(crates)
+	}
+	return r, nil
+}
+
+// countImportedFunctions returns the number of functions within the import
+// section, as imported functions come first in the function index space.
+func countImportedFunctions(rd *wasmReader) (int, error) {
+	count, err := rd.u32()
+	if err != nil {
+		return 0, err
+	}
+	var funcs int
+	for i := uint32(0); i < count; i++ {
+		if _, err := rd.name(); err != nil { // module
+			return funcs, err
+		}
+		if _, err := rd.name(); err != nil { // field
+			return funcs, err
+		}
+		kind, err := rd.byte()
+		if err != nil {
+			return funcs, err
+		}
+		switch kind {
+		case 0x00: // function: type index
+			funcs++
+			_, err = rd.u32()
+		case 0x01: // table: reftype and limits
+			if _, err = rd.byte(); err == nil {
+				err = rd.limits()
+			}
+		case 0x02: // memory: limits
+			err = rd.limits()
+		case 0x03: // global: valtype and mutability
+			_, err = rd.bytes(2)
+		case 0x04: // tag: attribute and type index
+			if _, err = rd.byte(); err == nil {
+				_, err = rd.u32()
+			}
+		default:
+			return funcs, fmt.Errorf("unknown import kind %d", kind)
+		}
+		if err != nil {
+			return funcs, err
+		}
+	}
+	return funcs, nil
+}
+
+// parseFunctionBodies returns the size of each function body within the code
+// section (including the size prefix).
+func parseFunctionBodies(rd *wasmReader) ([]int64, error) {
+	count, err := rd.u32()
+	if err != nil {
+		return nil, err
+	}
+	bodies := make([]int64, 0, count)
+	for i := uint32(0); i < count; i++ {
+		start := rd.pos
+		size, err := rd.u32()
+		if err != nil {
+			return bodies, err
+		}
+		if _, err := rd.bytes(int(size)); err != nil {
+			return bodies, err
+		}
+		bodies = append(bodies, int64(rd.pos-start))
+	}
+	return bodies, nil
+}
+
+// parseFunctionNames returns the function names subsection of the "name"
+// custom section, keyed by function index.
+func parseFunctionNames(rd *wasmReader) (map[int]string, error) {
+	for rd.pos < len(rd.data) {
+		id, err := rd.byte()
+		if err != nil {
+			return nil, err
+		}
+		size, err := rd.u32()
+		if err != nil {
+			return nil, err
+		}
+		content, err := rd.bytes(int(size))
+		if err != nil {
+			return nil, err
+		}
+		if id != 1 {
+			continue
+		}
+
+		sr := &wasmReader{data: content}
+		count, err := sr.u32()
+		if err != nil {
+			return nil, err
+		}
+		names := make(map[int]string, count)
+		for i := uint32(0); i < count; i++ {
+			idx, err := sr.u32()
+			if err != nil {
+				return names, err
+			}
+			name, err := sr.name()
+			if err != nil {
+				return names, err
+			}
+			names[int(idx)] = name
+		}
+		return names, nil
+	}
+	return nil, nil
+}
+
+// wasmReader reads the primitive values of the Wasm binary format.
+type wasmReader struct {
+	data []byte
+	pos  int
+}
+
+var errUnexpectedEOF = errors.New("unexpected end of data")
+
+func (rd *wasmReader) byte() (byte, error) {
+	if rd.pos >= len(rd.data) {
+		return 0, errUnexpectedEOF
+	}
+	b := rd.data[rd.pos]
+	rd.pos++
+	return b, nil
+}
+
+func (rd *wasmReader) bytes(n int) ([]byte, error) {
+	if n < 0 || n > len(rd.data)-rd.pos {
+		return nil, errUnexpectedEOF
+	}
+	b := rd.data[rd.pos : rd.pos+n]
+	rd.pos += n
+	return b, nil
+}
+
+// u32 reads an unsigned LEB128 encoded integer.
+func (rd *wasmReader) u32() (uint32, error) {
+	var (
+		result uint32
+		shift  uint
+	)
+	for {
+		b, err := rd.byte()
+		if err != nil {
+			return 0, err
+		}
+		if shift >= 32 {
+			return 0, errors.New("integer representation too long")
+		}
+		result |= uint32(b&0x7f) << shift
+		if b&0x80 == 0 {
+			return result, nil
+		}
+		shift += 7
+	}
+}
+
+// name reads a length prefixed UTF-8 string.
+func (rd *wasmReader) name() (string, error) {
+	n, err := rd.u32()
+	if err != nil {
+		return "", err
+	}
+	b, err := rd.bytes(int(n))
+	return string(b), err
+}
+
+// limits reads the limits of a table or memory.
+func (rd *wasmReader) limits() error {
+	flags, err := rd.byte()
+	if err != nil {
+		return err
+	}
+	if _, err := rd.u32(); err != nil {
+		return err
+	}
+	if flags&0x01 != 0 {
+		_, err = rd.u32()
+	}
+	return err
+}
+
+// rustSymbolEscapes are the escape sequences used by the legacy Rust symbol
+// mangling scheme.
+var rustSymbolEscapes = strings.NewReplacer(
+	"$SP$", "@",
+	"$BP$", "*",
+	"$RF$", "&",
+	"$LT$", "<",
+	"$GT$", ">",
+	"$LP$", "(",
+	"$RP$", ")",
+	"$C$", ",",
+	"$u20$", " ",
+	"$u22$", "\"",
+	"$u27$", "'",
+	"$u2b$", "+",
+	"$u3b$", ";",
+	"$u5b$", "[",
+	"$u5d$", "]",
+	"$u7b$", "{",
+	"$u7d$", "}",
+	"$u7e$", "~",
+	"..", "::",
+)
+
+// demangle converts a symbol mangled with the Itanium (C++) or legacy Rust
+// scheme (e.g. _ZN4core3fmt5write17h0123456789abcdefE) into a readable path
+// (e.g. core::fmt::write). Other names are returned unmodified.
+func demangle(name string) string {
+	if !strings.HasPrefix(name, "_ZN") {
+		return name
+	}
+	s := name[3:]
+	var segments []string
+	for len(s) > 0 && s[0] != 'E' {
+		i := 0
+		for i < len(s) && s[i] >= '0' && s[i] <= '9' {
+			i++
+		}
+		n, err := strconv.Atoi(s[:i])
+		if err != nil || n == 0 || i+n > len(s) {
+			return name
+		}
+		segments = append(segments, s[i:i+n])
+		s = s[i+n:]
+	}
+	if len(s) == 0 || len(segments) == 0 {
+		return name
+	}
+
+	// Rust appends a hash of the symbol (e.g. h0123456789abcdef).
+	if last := segments[len(segments)-1]; len(segments) > 1 && len(last) == 17 && last[0] == 'h' {
+		if _, err := strconv.ParseUint(last[1:], 16, 64); err == nil {
+			segments = segments[:len(segments)-1]
+		}
+	}
+	for i, seg := range segments {
+		seg = strings.TrimPrefix(seg, "_$")
+		if len(seg) < len(segments[i]) {
+			seg = "$" + seg
+		}
+		segments[i] = rustSymbolEscapes.Replace(seg)
+	}
+	return strings.Join(segments, "::")
+}
+
+// crateName returns the crate (Rust, C++ namespace) or package (Go) a
+// function name belongs to, or an empty string if it can't be determined.
+func crateName(name string) string {
+	if i := strings.Index(name, "::"); i > 0 {
+		// e.g. <alloc::vec::Vec<T> as core::ops::drop::Drop>::drop
+		prefix := strings.TrimLeft(name[:i], "<&*( ")
+		if j := strings.LastIndexAny(prefix, " <&*("); j >= 0 {
+			prefix = prefix[j+1:]
+		}
+		return prefix
+	}
+
+	// e.g. (*net/http.Client).Do
+	s := strings.TrimLeft(name, "(*")
+	slash := strings.LastIndex(s, "/")
+	if dot := strings.Index(s[slash+1:], "."); dot > 0 {
+		return s[:slash+1+dot]
+	}
+	return ""
+}
+
+// sortedSizeEntries returns the entries ordered by size (largest first) then
+// name.
+func sortedSizeEntries(m map[string]int64) []SizeEntry {
+	entries := make([]SizeEntry, 0, len(m))
+	for name, size := range m {
+		entries = append(entries, SizeEntry{Name: name, Size: size})
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].Size != entries[j].Size {
+			return entries[i].Size > entries[j].Size
+		}
+		return entries[i].Name < entries[j].Name
+	})
+	return entries
+}
@@ -0,0 +1,352 @@
+package compute
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+
+	toml "github.com/pelletier/go-toml"
+
+	"github.com/fastly/cli/pkg/commands/compute/setup"
+	fsterr "github.com/fastly/cli/pkg/errors"
+	fstexec "github.com/fastly/cli/pkg/exec"
+	"github.com/fastly/cli/pkg/manifest"
+	"github.com/fastly/cli/pkg/text"
+)
+
+// WorkspaceFilename is the file listing the projects run together by
+// `compute serve --workspace`.
+const WorkspaceFilename = "fastly-workspace.toml"
+
+// WorkspaceManifestFilename is the manifest generated within each workspace
+// project directory. It's a copy of the project manifest with the
+// [local_server.backends] that reference other workspace services rewritten to
+// their local address. The file is removed when the local servers stop.
+const WorkspaceManifestFilename = ".workspace.fastly.toml"
+
+// Workspace represents the workspace file.
+type Workspace struct {
+	Services []WorkspaceService `toml:"service"`
+}
+
+// WorkspaceService represents a project within the workspace.
+type WorkspaceService struct {
+	// Addr is the address the local server listens on (default: the --addr port
+	// incremented for each service).
+	Addr string `toml:"addr"`
+	// Backends maps a [local_server.backends] name to the name of the workspace
+	// service it should be routed to. A backend whose name matches the name of
+	// another workspace service is routed to that service without needing to
+	// be listed here.
+	Backends map[string]string `toml:"backends"`
+	// Dir is the project directory (relative to the workspace file).
+	Dir string `toml:"dir"`
+	// Name identifies the service within the workspace (default: the package
+	// name from the manifest).
+	Name string `toml:"name"`
+}
+
+// ReadWorkspace reads and validates the workspace file.
+//
+// The project directories are resolved relative to the workspace file and a
+// service without an address is assigned the next port after defaultAddr.
+func ReadWorkspace(path, defaultAddr string) (Workspace, error) {
+	var w Workspace
+
+	// gosec flagged this:
+	// G304 (CWE-22): Potential file inclusion via variable
+	// Disabling as we need to load the workspace file from the user's project.
+	// #nosec
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return w, err
+	}
+	if err := toml.Unmarshal(data, &w); err != nil {
+		return w, fmt.Errorf("error parsing workspace file '%s': %w", path, err)
+	}
+	if len(w.Services) == 0 {
+		return w, fmt.Errorf("no services defined in '%s'", path)
+	}
+
+	host, p, err := net.SplitHostPort(defaultAddr)
+	if err != nil {
+		return w, fmt.Errorf("error parsing address '%s': %w", defaultAddr, err)
+	}
+	port, err := strconv.Atoi(p)
+	if err != nil {
+		return w, fmt.Errorf("error parsing port of address '%s': %w", defaultAddr, err)
+	}
+
+	root := filepath.Dir(path)
+	addrs := make(map[string]string)
+	for i, s := range w.Services {
+		if s.Dir == "" {
+			return w, fmt.Errorf("service %d in '%s': missing 'dir'", i+1, path)
+		}
+		dir := s.Dir
+		if !filepath.IsAbs(dir) {
+			dir = filepath.Join(root, dir)
+		}
+		if fi, err := os.Stat(dir); err != nil || !fi.IsDir() {
+			return w, fmt.Errorf("service %d in '%s': project directory '%s' not found", i+1, path, s.Dir)
+		}
+		w.Services[i].Dir = dir
+
+		if s.Addr == "" {
+			w.Services[i].Addr = net.JoinHostPort(host, strconv.Itoa(port+i))
+		}
+		if other, ok := addrs[w.Services[i].Addr]; ok {
+			return w, fmt.Errorf("service %d in '%s': address '%s' is already used by '%s'", i+1, path, w.Services[i].Addr, other)
+		}
+		addrs[w.Services[i].Addr] = s.Dir
+	}
+	return w, nil
+}
+
+// workspaceServer is a workspace service ready to be run by Viceroy.
+type workspaceServer struct {
+	addr         string
+	dir          string
+	manifestPath string
+	name         string
+	stream       *fstexec.Streaming
+}
+
+// serveWorkspace builds each project listed in the workspace file, then runs
+// a Viceroy instance per project with the backends between them routed to the
+// local servers.
+func (c *ServeCommand) serveWorkspace(in io.Reader, out io.Writer) error {
+	if c.watch || c.dir.WasSet || c.pullStores || c.recordHAR != "" || c.replayHAR != "" {
+		return fsterr.RemediationError{
+			Inner:       errors.New("--workspace can't be used with --watch, --dir, --pull-stores, --record-har or --replay-har"),
+			Remediation: fsterr.ComputeServeRemediation,
+		}
+	}
+
+	wd, err := os.Getwd()
+	if err != nil {
+		c.Globals.ErrLog.Add(err)
+		return fmt.Errorf("failed to get current working directory: %w", err)
+	}
+	workspacePath := filepath.Join(wd, WorkspaceFilename)
+
+	w, err := ReadWorkspace(workspacePath, c.addr)
+	if err != nil {
+		c.Globals.ErrLog.Add(err)
+		return fsterr.RemediationError{
+			Inner:       fmt.Errorf("failed to read workspace file: %w", err),
+			Remediation: fmt.Sprintf("Create a %s file listing the project directory of each service, e.g.\n\n[[service]]\ndir = \"./frontend\"\n\n[[service]]\ndir = \"./api\"", WorkspaceFilename),
+		}
+	}
+
+	if !c.skipBuild {
+		for _, s := range w.Services {
+			text.Info(out, "Building %s\n\n", s.Dir)
+			c.build.Flags.Dir = s.Dir
+			// NOTE: Read() decodes into the existing File, so without a reset the
+			// fields of the previous project (e.g. [scripts]) would leak into this
+			// project's build wherever its manifest omits them.
+			c.Globals.Manifest.File.Reset()
+			if err := c.Build(in, out); err != nil {
+				return err
+			}
+			text.Break(out)
+		}
+	}
+
+	manifestFilename := EnvironmentManifest(c.env.Value)
+	servers := make([]*workspaceServer, len(w.Services))
+	files := make([]*manifest.File, len(w.Services))
+	names := make(map[string]*workspaceServer)
+	for i, s := range w.Services {
+		var m manifest.File
+		m.SetErrLog(c.Globals.ErrLog)
+		m.SetOutput(out)
+		m.SetQuiet(true)
+		manifestPath := filepath.Join(s.Dir, manifestFilename)
+		if err := m.Read(manifestPath); err != nil {
+			return fmt.Errorf("failed to parse manifest '%s': %w", manifestPath, err)
+		}
+
+		name := s.Name
+		if name == "" {
+			name = m.Name
+		}
+		if _, ok := names[name]; ok || name == "" {
+			return fsterr.RemediationError{
+				Inner:       fmt.Errorf("the service name '%s' (%s) isn't unique within the workspace", name, s.Dir),
+				Remediation: fmt.Sprintf("Set a unique `name` for the service in %s.", WorkspaceFilename),
+			}
+		}
+
+		servers[i] = &workspaceServer{
+			addr:         s.Addr,
+			dir:          s.Dir,
+			manifestPath: filepath.Join(s.Dir, WorkspaceManifestFilename),
+			name:         name,
+		}
+		files[i] = &m
+		names[name] = servers[i]
+	}
+
+	defer func() {
+		for _, s := range servers {
+			_ = os.Remove(s.manifestPath)
+		}
+	}()
+
+	for i, s := range w.Services {
+		m := files[i]
+		routes := make(map[string]string)
+		for backend := range m.LocalServer.Backends {
+			if _, ok := names[backend]; ok && backend != servers[i].name {
+				routes[backend] = backend
+			}
+		}
+		for backend, target := range s.Backends {
+			if _, ok := names[target]; !ok {
+				return fsterr.RemediationError{
+					Inner:       fmt.Errorf("the backend '%s' of service '%s' references an unknown workspace service '%s'", backend, servers[i].name, target),
+					Remediation: fmt.Sprintf("Ensure each `backends` value in %s is the name of a workspace service.", WorkspaceFilename),
+				}
+			}
+			routes[backend] = target
+		}
+
+		if len(routes) > 0 && m.LocalServer.Backends == nil {
+			m.LocalServer.Backends = make(map[string]manifest.LocalBackend)
+		}
+		for _, backend := range setup.SortedKeys(routes) {
+			target := names[routes[backend]]
+			b := m.LocalServer.Backends[backend]
+			b.URL = "http://" + target.addr
+			b.CertHost = ""
+			b.UseSNI = false
+			m.LocalServer.Backends[backend] = b
+			if c.Globals.Verbose() {
+				text.Info(out, "[local_server.backends.%s] of '%s' routed to '%s' (%s)", backend, servers[i].name, target.name, b.URL)
+			}
+		}
+
+		if err := m.Write(servers[i].manifestPath); err != nil {
+			c.Globals.ErrLog.Add(err)
+			return fmt.Errorf("error writing manifest '%s': %w", servers[i].manifestPath, err)
+		}
+	}
+
+	if v := files[0].LocalServer.ViceroyVersion; v != "" {
+		c.ViceroyVersioner.SetRequestedVersion(v)
+	}
+
+	spinner, err := text.NewSpinner(out)
+	if err != nil {
+		return err
+	}
+	bin, err := c.GetViceroy(spinner, out, filepath.Join(w.Services[0].Dir, manifestFilename))
+	if err != nil {
+		return err
+	}
+
+	return c.runWorkspace(bin, servers, out)
+}
+
+// runWorkspace runs a Viceroy instance for each workspace service until the
+// user stops them, or until one of them stops unexpectedly.
+func (c *ServeCommand) runWorkspace(bin string, servers []*workspaceServer, out io.Writer) error {
+	var mu sync.Mutex
+
+	text.Output(out, "%s", text.BoldYellow("Local servers"))
+	for _, s := range servers {
+		text.Output(out, "\t%s: http://%s (%s)", s.name, s.addr, s.dir)
+	}
+	text.Break(out)
+
+	type result struct {
+		name string
+		err  error
+	}
+	results := make(chan result, len(servers))
+	for _, s := range servers {
+		file := c.file
+		if !filepath.IsAbs(file) {
+			file = filepath.Join(s.dir, file)
+		}
+		s.stream = &fstexec.Streaming{
+			Args:        []string{"-v", "-C", s.manifestPath, "--addr", s.addr, file},
+			Command:     bin,
+			Env:         os.Environ(),
+			ForceOutput: true,
+			Output:      &prefixWriter{mu: &mu, out: out, prefix: s.name + " | "},
+			SignalCh:    make(chan os.Signal, 1),
+		}
+		s.stream.MonitorSignals()
+
+		go func(s *workspaceServer) {
+			results <- result{name: s.name, err: s.stream.Exec()}
+		}(s)
+	}
+
+	// NOTE: A signal (e.g. the user presses Ctrl-C) is delivered to every
+	// instance, otherwise the first instance to stop causes the others to be
+	// stopped (the stopped instance is also signalled so its signal listener
+	// is released).
+	first := <-results
+	for _, s := range servers {
+		select {
+		case s.stream.SignalCh <- syscall.SIGTERM:
+		default:
+		}
+	}
+	for i := 1; i < len(servers); i++ {
+		<-results
+	}
+
+	if first.err != nil && strings.Contains(first.err.Error(), "signal: ") {
+		text.Info(out, "\nLocal servers stopped")
+		return nil
+	}
+	if first.err != nil {
+		c.Globals.ErrLog.Add(first.err)
+		return fmt.Errorf("the local server for '%s' stopped unexpectedly: %w", first.name, first.err)
+	}
+	return fmt.Errorf("the local server for '%s' stopped unexpectedly", first.name)
+}
+
+// prefixWriter prefixes each line written with a label, so the output of each
+// local server can be told apart.
+//
+// NOTE: The mutex is shared by the writers of every local server so lines
+// aren't interleaved.
+type prefixWriter struct {
+	buf    []byte
+	mu     *sync.Mutex
+	out    io.Writer
+	prefix string
+}
+
+// Write implements io.Writer.
+func (w *prefixWriter) Write(p []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.buf = append(w.buf, p...)
+	for {
+		i := bytes.IndexByte(w.buf, '\n')
+		if i < 0 {
+			break
+		}
+		if _, err := fmt.Fprintf(w.out, "%s%s\n", w.prefix, w.buf[:i]); err != nil {
+			return 0, err
+		}
+		w.buf = w.buf[i+1:]
+	}
+	return len(p), nil
+}
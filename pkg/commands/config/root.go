@@ -1,23 +1,31 @@
 package config
 
 import (
+	"errors"
 	"fmt"
 	"io"
 	"os"
+	"path/filepath"
 
 	"github.com/fastly/cli/pkg/argparser"
 	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/credentials"
 	"github.com/fastly/cli/pkg/global"
 	"github.com/fastly/cli/pkg/text"
 )
 
+// CredentialStores are the supported values of the --credential-store flag.
+var CredentialStores = []string{credentials.StoreConfig, credentials.StoreFile, credentials.StoreHelper}
+
 // RootCommand is the parent command for all subcommands in this package.
 // It should be installed under the primary root command.
 type RootCommand struct {
 	argparser.Base
 
-	location bool
-	reset    bool
+	credentialHelper string
+	credentialStore  string
+	location         bool
+	reset            bool
 }
 
 // NewRootCommand returns a new command registered in the parent.
@@ -25,19 +33,32 @@ func NewRootCommand(parent argparser.Registerer, g *global.Data) *RootCommand {
 	var c RootCommand
 	c.Globals = g
 	c.CmdClause = parent.Command("config", "Display the Fastly CLI configuration")
+	c.CmdClause.Flag("credential-helper", "The credential helper executable (e.g. docker-credential-osxkeychain) used by the 'helper' credential store").StringVar(&c.credentialHelper)
+	c.CmdClause.Flag("credential-store", "Move the profile tokens to the credential store ('config' keeps them in the config file, 'file' in a passphrase encrypted file, 'helper' uses a credential helper)").HintOptions(CredentialStores...).EnumVar(&c.credentialStore, CredentialStores...)
 	c.CmdClause.Flag("location", "Print the location of the CLI configuration file").Short('l').BoolVar(&c.location)
 	c.CmdClause.Flag("reset", "Reset the config to a version compatible with the current CLI version").Short('r').BoolVar(&c.reset)
 	return &c
 }
 
 // Exec implements the command interface.
-func (c *RootCommand) Exec(_ io.Reader, out io.Writer) (err error) {
+func (c *RootCommand) Exec(in io.Reader, out io.Writer) (err error) {
 	if c.reset {
 		if err := c.Globals.Config.UseStatic(config.FilePath); err != nil {
 			return err
 		}
 	}
 
+	if c.credentialStore != "" {
+		if err := c.migrateCredentials(in, out); err != nil {
+			c.Globals.ErrLog.Add(err)
+			return err
+		}
+		return nil
+	}
+	if c.credentialHelper != "" {
+		return errors.New("the --credential-helper flag requires --credential-store=helper")
+	}
+
 	if c.location {
 		if c.Globals.Flags.Verbose {
 			text.Break(out)
@@ -54,3 +75,79 @@ func (c *RootCommand) Exec(_ io.Reader, out io.Writer) (err error) {
 	fmt.Fprintln(out, string(data))
 	return nil
 }
+
+// migrateCredentials moves the profile tokens from the current credential
+// store to the one specified by the --credential-store flag.
+func (c *RootCommand) migrateCredentials(in io.Reader, out io.Writer) error {
+	cfg := &c.Globals.Config
+
+	helper := c.credentialHelper
+	if c.credentialStore != credentials.StoreHelper {
+		if helper != "" {
+			return errors.New("the --credential-helper flag requires --credential-store=helper")
+		}
+	} else if helper == "" {
+		helper = cfg.Credentials.Helper
+	}
+
+	current := cfg.Credentials.Store
+	if current == "" {
+		current = credentials.StoreConfig
+	}
+	if current == c.credentialStore && cfg.Credentials.Helper == helper {
+		text.Info(out, "The profile tokens are already in the '%s' credential store.", c.credentialStore)
+		return nil
+	}
+
+	store, err := credentials.New(credentials.Opts{
+		Dir:            filepath.Dir(c.Globals.ConfigPath),
+		Helper:         helper,
+		In:             in,
+		NonInteractive: c.Globals.Flags.NonInteractive,
+		Out:            out,
+		Passphrase:     c.Globals.Env.CredentialsPassphrase,
+		Store:          c.credentialStore,
+	})
+	if err != nil {
+		return err
+	}
+
+	// Load the tokens from the current store, then clear the references so the
+	// tokens are written to the new store.
+	previous := cfg.CredentialStore()
+	var refs []string
+	for name, p := range cfg.Profiles {
+		if err := cfg.LoadCredentials(name); err != nil {
+			return err
+		}
+		if p.CredentialRef != "" {
+			refs = append(refs, p.CredentialRef)
+			p.CredentialRef = ""
+		}
+	}
+
+	cfg.Credentials = config.Credentials{
+		Helper: helper,
+		Store:  c.credentialStore,
+	}
+	if c.credentialStore == credentials.StoreConfig {
+		cfg.Credentials = config.Credentials{}
+	}
+	cfg.SetCredentialStore(store)
+	if err := cfg.Write(c.Globals.ConfigPath); err != nil {
+		return fmt.Errorf("failed to update the config file: %w", err)
+	}
+
+	// NOTE: The tokens are only erased from the previous store once they've been
+	// written to the new one.
+	if previous != nil {
+		for _, ref := range refs {
+			if err := previous.Delete(ref); err != nil {
+				text.Warning(out, "Failed to erase '%s' from the previous credential store: %s", ref, err)
+			}
+		}
+	}
+
+	text.Success(out, "Moved the tokens of %d profile(s) to the '%s' credential store", len(cfg.Profiles), c.credentialStore)
+	return nil
+}
@@ -0,0 +1,130 @@
+package director
+
+import (
+	"io"
+
+	"github.com/fastly/go-fastly/v8/fastly"
+
+	"github.com/fastly/cli/pkg/argparser"
+	"github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/global"
+	"github.com/fastly/cli/pkg/text"
+)
+
+// directorTypeFlagOpts is the list of director types accepted by --type.
+var directorTypeFlagOpts = []string{"random", "round-robin", "hash", "client"}
+
+// CreateCommand calls the Fastly API to create directors.
+type CreateCommand struct {
+	argparser.Base
+
+	// Required.
+	name           string
+	serviceVersion argparser.OptionalServiceVersion
+
+	// Optional.
+	autoClone    argparser.OptionalAutoClone
+	comment      argparser.OptionalString
+	directorType argparser.OptionalString
+	quorum       argparser.OptionalInt
+	retries      argparser.OptionalInt
+	serviceName  argparser.OptionalServiceNameID
+	shield       argparser.OptionalString
+}
+
+// NewCreateCommand returns a usable command registered under the parent.
+func NewCreateCommand(parent argparser.Registerer, g *global.Data) *CreateCommand {
+	c := CreateCommand{
+		Base: argparser.Base{
+			Globals: g,
+		},
+	}
+	c.CmdClause = parent.Command("create", "Create a director on a Fastly service version").Alias("add")
+
+	// Required.
+	c.CmdClause.Flag("name", "Director name").Short('n').Required().StringVar(&c.name)
+	c.RegisterFlag(argparser.StringFlagOpts{
+		Name:        argparser.FlagVersionName,
+		Description: argparser.FlagVersionDesc,
+		Dst:         &c.serviceVersion.Value,
+		Required:    true,
+	})
+
+	// Optional.
+	c.RegisterAutoCloneFlag(argparser.AutoCloneFlagOpts{
+		Action: c.autoClone.Set,
+		Dst:    &c.autoClone.Value,
+	})
+	c.CmdClause.Flag("comment", "A descriptive note").Action(c.comment.Set).StringVar(&c.comment.Value)
+	c.CmdClause.Flag("quorum", "The percentage of capacity that needs to be up for a director to be considered up (0-100)").Action(c.quorum.Set).IntVar(&c.quorum.Value)
+	c.CmdClause.Flag("retries", "How many backends to search if it fails").Action(c.retries.Set).IntVar(&c.retries.Value)
+	c.RegisterFlag(argparser.StringFlagOpts{
+		Name:        argparser.FlagServiceIDName,
+		Description: argparser.FlagServiceIDDesc,
+		Dst:         &g.Manifest.Flag.ServiceID,
+		Short:       's',
+	})
+	c.RegisterFlag(argparser.StringFlagOpts{
+		Action:      c.serviceName.Set,
+		Name:        argparser.FlagServiceName,
+		Description: argparser.FlagServiceDesc,
+		Dst:         &c.serviceName.Value,
+	})
+	c.CmdClause.Flag("shield", "Selected POP to serve as a shield for the backends").Action(c.shield.Set).StringVar(&c.shield.Value)
+	c.CmdClause.Flag("type", "How backends are selected by the director").HintOptions(directorTypeFlagOpts...).Action(c.directorType.Set).EnumVar(&c.directorType.Value, directorTypeFlagOpts...)
+
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *CreateCommand) Exec(_ io.Reader, out io.Writer) error {
+	serviceID, serviceVersion, err := argparser.ServiceDetails(argparser.ServiceDetailsOpts{
+		AutoCloneFlag:      c.autoClone,
+		APIClient:          c.Globals.APIClient,
+		Manifest:           *c.Globals.Manifest,
+		Out:                out,
+		ServiceNameFlag:    c.serviceName,
+		ServiceVersionFlag: c.serviceVersion,
+		VerboseMode:        c.Globals.Flags.Verbose,
+	})
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]any{
+			"Service ID":      serviceID,
+			"Service Version": errors.ServiceVersion(serviceVersion),
+		})
+		return err
+	}
+
+	input := fastly.CreateDirectorInput{
+		Name:           &c.name,
+		ServiceID:      serviceID,
+		ServiceVersion: serviceVersion.Number,
+	}
+	if c.comment.WasSet {
+		input.Comment = &c.comment.Value
+	}
+	if c.quorum.WasSet {
+		input.Quorum = &c.quorum.Value
+	}
+	if c.retries.WasSet {
+		input.Retries = &c.retries.Value
+	}
+	if c.shield.WasSet {
+		input.Shield = &c.shield.Value
+	}
+	if c.directorType.WasSet {
+		input.Type = fastly.DirectorTypePtr(text.DirectorTypes[c.directorType.Value])
+	}
+
+	d, err := c.Globals.APIClient.CreateDirector(&input)
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]any{
+			"Service ID":      serviceID,
+			"Service Version": serviceVersion.Number,
+		})
+		return err
+	}
+
+	text.Success(out, "Created director %s (service %s version %d)", d.Name, d.ServiceID, d.ServiceVersion)
+	return nil
+}
@@ -0,0 +1,352 @@
+package director_test
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/fastly/go-fastly/v8/fastly"
+
+	"github.com/fastly/cli/pkg/app"
+	"github.com/fastly/cli/pkg/global"
+	"github.com/fastly/cli/pkg/mock"
+	"github.com/fastly/cli/pkg/testutil"
+)
+
+func TestDirectorCreate(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Args:      args("director create --service-id 123 --version 3"),
+			WantError: "error parsing arguments: required flag --name not provided",
+		},
+		{
+			Args:      args("director create --service-id 123 --version 3 --name lb --type unknown"),
+			WantError: "error parsing arguments: enum value must be one of random,round-robin,hash,client, got 'unknown'",
+		},
+		{
+			Args: args("director create --service-id 123 --version 1 --name lb"),
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+			},
+			WantError: "service version 1 is not editable",
+		},
+		{
+			Args: args("director create --service-id 123 --version 3 --name lb"),
+			API: mock.API{
+				ListVersionsFn:   testutil.ListVersions,
+				CreateDirectorFn: createDirectorError,
+			},
+			WantError: errTest.Error(),
+		},
+		{
+			Args: args("director create --service-id 123 --version 1 --name lb --type hash --quorum 50 --autoclone"),
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				CloneVersionFn: testutil.CloneVersionResult(4),
+				CreateDirectorFn: func(i *fastly.CreateDirectorInput) (*fastly.Director, error) {
+					if *i.Type != fastly.DirectorTypeHash || *i.Quorum != 50 {
+						return nil, errTest
+					}
+					return &fastly.Director{
+						ServiceID:      i.ServiceID,
+						ServiceVersion: i.ServiceVersion,
+						Name:           *i.Name,
+					}, nil
+				},
+			},
+			WantOutput: "Created director lb (service 123 version 4)",
+		},
+	}
+	for testcaseIdx := range scenarios {
+		testcase := &scenarios[testcaseIdx]
+		t.Run(strings.Join(testcase.Args, " "), func(t *testing.T) {
+			var stdout bytes.Buffer
+			app.Init = func(_ []string, _ io.Reader) (*global.Data, error) {
+				opts := testutil.MockGlobalData(testcase.Args, &stdout)
+				opts.APIClientFactory = mock.APIClient(testcase.API)
+				return opts, nil
+			}
+			err := app.Run(testcase.Args, nil)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.WantOutput)
+		})
+	}
+}
+
+func TestDirectorList(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Args: args("director list --service-id 123 --version 1"),
+			API: mock.API{
+				ListVersionsFn:  testutil.ListVersions,
+				ListDirectorsFn: listDirectorsOK,
+			},
+			WantOutput: listDirectorsShortOutput,
+		},
+		{
+			Args: args("director list --service-id 123 --version 1 --verbose"),
+			API: mock.API{
+				ListVersionsFn:  testutil.ListVersions,
+				ListDirectorsFn: listDirectorsOK,
+			},
+			WantOutput: listDirectorsVerboseOutput,
+		},
+		{
+			Args: args("director list --service-id 123 --version 1"),
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				ListDirectorsFn: func(_ *fastly.ListDirectorsInput) ([]*fastly.Director, error) {
+					return nil, errTest
+				},
+			},
+			WantError: errTest.Error(),
+		},
+	}
+	for testcaseIdx := range scenarios {
+		testcase := &scenarios[testcaseIdx]
+		t.Run(strings.Join(testcase.Args, " "), func(t *testing.T) {
+			var stdout bytes.Buffer
+			app.Init = func(_ []string, _ io.Reader) (*global.Data, error) {
+				opts := testutil.MockGlobalData(testcase.Args, &stdout)
+				opts.APIClientFactory = mock.APIClient(testcase.API)
+				return opts, nil
+			}
+			err := app.Run(testcase.Args, nil)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.WantOutput)
+		})
+	}
+}
+
+func TestDirectorDescribe(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Args:      args("director describe --service-id 123 --version 1"),
+			WantError: "error parsing arguments: required flag --name not provided",
+		},
+		{
+			Args: args("director describe --service-id 123 --version 1 --name lb"),
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				GetDirectorFn: func(i *fastly.GetDirectorInput) (*fastly.Director, error) {
+					return directorFixture(i.ServiceID, i.ServiceVersion, i.Name), nil
+				},
+			},
+			WantOutput: describeDirectorOutput,
+		},
+	}
+	for testcaseIdx := range scenarios {
+		testcase := &scenarios[testcaseIdx]
+		t.Run(strings.Join(testcase.Args, " "), func(t *testing.T) {
+			var stdout bytes.Buffer
+			app.Init = func(_ []string, _ io.Reader) (*global.Data, error) {
+				opts := testutil.MockGlobalData(testcase.Args, &stdout)
+				opts.APIClientFactory = mock.APIClient(testcase.API)
+				return opts, nil
+			}
+			err := app.Run(testcase.Args, nil)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertString(t, testcase.WantOutput, stdout.String())
+		})
+	}
+}
+
+func TestDirectorUpdate(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Args:      args("director update --service-id 123 --version 3 --name lb"),
+			WantError: "error parsing arguments: must provide either --comment, --new-name, --quorum, --retries, --shield or --type to update director",
+		},
+		{
+			Args: args("director update --service-id 123 --version 3 --name lb --new-name balancer --type random"),
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				UpdateDirectorFn: func(i *fastly.UpdateDirectorInput) (*fastly.Director, error) {
+					if i.Name != "lb" || i.Type != fastly.DirectorTypeRandom {
+						return nil, errTest
+					}
+					return &fastly.Director{
+						ServiceID:      i.ServiceID,
+						ServiceVersion: i.ServiceVersion,
+						Name:           *i.NewName,
+					}, nil
+				},
+			},
+			WantOutput: "Updated director balancer (service 123 version 3)",
+		},
+	}
+	for testcaseIdx := range scenarios {
+		testcase := &scenarios[testcaseIdx]
+		t.Run(strings.Join(testcase.Args, " "), func(t *testing.T) {
+			var stdout bytes.Buffer
+			app.Init = func(_ []string, _ io.Reader) (*global.Data, error) {
+				opts := testutil.MockGlobalData(testcase.Args, &stdout)
+				opts.APIClientFactory = mock.APIClient(testcase.API)
+				return opts, nil
+			}
+			err := app.Run(testcase.Args, nil)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.WantOutput)
+		})
+	}
+}
+
+func TestDirectorDelete(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Args: args("director delete --service-id 123 --version 3 --name lb"),
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				DeleteDirectorFn: func(_ *fastly.DeleteDirectorInput) error {
+					return errTest
+				},
+			},
+			WantError: errTest.Error(),
+		},
+		{
+			Args: args("director delete --service-id 123 --version 3 --name lb"),
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				DeleteDirectorFn: func(_ *fastly.DeleteDirectorInput) error {
+					return nil
+				},
+			},
+			WantOutput: "Deleted director lb (service 123 version 3)",
+		},
+	}
+	for testcaseIdx := range scenarios {
+		testcase := &scenarios[testcaseIdx]
+		t.Run(strings.Join(testcase.Args, " "), func(t *testing.T) {
+			var stdout bytes.Buffer
+			app.Init = func(_ []string, _ io.Reader) (*global.Data, error) {
+				opts := testutil.MockGlobalData(testcase.Args, &stdout)
+				opts.APIClientFactory = mock.APIClient(testcase.API)
+				return opts, nil
+			}
+			err := app.Run(testcase.Args, nil)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.WantOutput)
+		})
+	}
+}
+
+func TestDirectorBackend(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Args:      args("director add-backend --service-id 123 --version 3 --name lb"),
+			WantError: "error parsing arguments: required flag --backend not provided",
+		},
+		{
+			Args: args("director add-backend --service-id 123 --version 3 --name lb --backend origin"),
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				CreateDirectorBackendFn: func(i *fastly.CreateDirectorBackendInput) (*fastly.DirectorBackend, error) {
+					return &fastly.DirectorBackend{
+						Backend:        i.Backend,
+						Director:       i.Director,
+						ServiceID:      i.ServiceID,
+						ServiceVersion: i.ServiceVersion,
+					}, nil
+				},
+			},
+			WantOutput: "Added backend origin to director lb (service 123 version 3)",
+		},
+		{
+			Args: args("director remove-backend --service-id 123 --version 3 --name lb --backend origin"),
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				DeleteDirectorBackendFn: func(_ *fastly.DeleteDirectorBackendInput) error {
+					return errTest
+				},
+			},
+			WantError: errTest.Error(),
+		},
+		{
+			Args: args("director remove-backend --service-id 123 --version 3 --name lb --backend origin"),
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				DeleteDirectorBackendFn: func(_ *fastly.DeleteDirectorBackendInput) error {
+					return nil
+				},
+			},
+			WantOutput: "Removed backend origin from director lb (service 123 version 3)",
+		},
+	}
+	for testcaseIdx := range scenarios {
+		testcase := &scenarios[testcaseIdx]
+		t.Run(strings.Join(testcase.Args, " "), func(t *testing.T) {
+			var stdout bytes.Buffer
+			app.Init = func(_ []string, _ io.Reader) (*global.Data, error) {
+				opts := testutil.MockGlobalData(testcase.Args, &stdout)
+				opts.APIClientFactory = mock.APIClient(testcase.API)
+				return opts, nil
+			}
+			err := app.Run(testcase.Args, nil)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.WantOutput)
+		})
+	}
+}
+
+var errTest = errors.New("fixture error")
+
+func createDirectorError(_ *fastly.CreateDirectorInput) (*fastly.Director, error) {
+	return nil, errTest
+}
+
+func directorFixture(serviceID string, version int, name string) *fastly.Director {
+	return &fastly.Director{
+		Backends:       []string{"origin-a", "origin-b"},
+		Comment:        "load balancer",
+		Name:           name,
+		Quorum:         75,
+		Retries:        5,
+		ServiceID:      serviceID,
+		ServiceVersion: version,
+		Type:           fastly.DirectorTypeRandom,
+	}
+}
+
+func listDirectorsOK(i *fastly.ListDirectorsInput) ([]*fastly.Director, error) {
+	return []*fastly.Director{
+		directorFixture(i.ServiceID, i.ServiceVersion, "lb"),
+	}, nil
+}
+
+var listDirectorsShortOutput = strings.TrimSpace(`
+SERVICE  VERSION  NAME  TYPE    QUORUM  BACKENDS
+123      1        lb    random  75      2
+`) + "\n"
+
+var listDirectorsVerboseOutput = strings.Join([]string{
+	"Version: 1",
+	"	Director 1/1",
+	"		Name: lb",
+	"		Comment: load balancer",
+	"		Type: random",
+	"		Quorum: 75",
+	"		Retries: 5",
+	"		Shield: ",
+	"		Backends: origin-a, origin-b",
+}, "\n") + "\n\n"
+
+var describeDirectorOutput = `
+Service ID: 123
+Service Version: 1
+
+Name: lb
+Comment: load balancer
+Type: random
+Quorum: 75
+Retries: 5
+Shield: 
+Backends: origin-a, origin-b
+`
@@ -0,0 +1,2 @@
+// Package director contains commands to inspect and manipulate Fastly service directors.
+package director
@@ -0,0 +1,132 @@
+package director
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/fastly/go-fastly/v8/fastly"
+
+	"github.com/fastly/cli/pkg/argparser"
+	"github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/global"
+	"github.com/fastly/cli/pkg/text"
+)
+
+// UpdateCommand calls the Fastly API to update directors.
+type UpdateCommand struct {
+	argparser.Base
+	input          fastly.UpdateDirectorInput
+	serviceName    argparser.OptionalServiceNameID
+	serviceVersion argparser.OptionalServiceVersion
+	autoClone      argparser.OptionalAutoClone
+
+	comment      argparser.OptionalString
+	directorType argparser.OptionalString
+	newName      argparser.OptionalString
+	quorum       argparser.OptionalInt
+	retries      argparser.OptionalInt
+	shield       argparser.OptionalString
+}
+
+// NewUpdateCommand returns a usable command registered under the parent.
+func NewUpdateCommand(parent argparser.Registerer, g *global.Data) *UpdateCommand {
+	c := UpdateCommand{
+		Base: argparser.Base{
+			Globals: g,
+		},
+	}
+	c.CmdClause = parent.Command("update", "Update a director on a Fastly service version")
+
+	// Required.
+	c.CmdClause.Flag("name", "Director name").Short('n').Required().StringVar(&c.input.Name)
+	c.RegisterFlag(argparser.StringFlagOpts{
+		Name:        argparser.FlagVersionName,
+		Description: argparser.FlagVersionDesc,
+		Dst:         &c.serviceVersion.Value,
+		Required:    true,
+	})
+
+	// Optional.
+	c.RegisterAutoCloneFlag(argparser.AutoCloneFlagOpts{
+		Action: c.autoClone.Set,
+		Dst:    &c.autoClone.Value,
+	})
+	c.CmdClause.Flag("comment", "A descriptive note").Action(c.comment.Set).StringVar(&c.comment.Value)
+	c.CmdClause.Flag("new-name", "New director name").Action(c.newName.Set).StringVar(&c.newName.Value)
+	c.CmdClause.Flag("quorum", "The percentage of capacity that needs to be up for a director to be considered up (0-100)").Action(c.quorum.Set).IntVar(&c.quorum.Value)
+	c.CmdClause.Flag("retries", "How many backends to search if it fails").Action(c.retries.Set).IntVar(&c.retries.Value)
+	c.RegisterFlag(argparser.StringFlagOpts{
+		Name:        argparser.FlagServiceIDName,
+		Description: argparser.FlagServiceIDDesc,
+		Dst:         &g.Manifest.Flag.ServiceID,
+		Short:       's',
+	})
+	c.RegisterFlag(argparser.StringFlagOpts{
+		Action:      c.serviceName.Set,
+		Name:        argparser.FlagServiceName,
+		Description: argparser.FlagServiceDesc,
+		Dst:         &c.serviceName.Value,
+	})
+	c.CmdClause.Flag("shield", "Selected POP to serve as a shield for the backends").Action(c.shield.Set).StringVar(&c.shield.Value)
+	c.CmdClause.Flag("type", "How backends are selected by the director").HintOptions(directorTypeFlagOpts...).Action(c.directorType.Set).EnumVar(&c.directorType.Value, directorTypeFlagOpts...)
+
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *UpdateCommand) Exec(_ io.Reader, out io.Writer) error {
+	if !c.comment.WasSet && !c.directorType.WasSet && !c.newName.WasSet && !c.quorum.WasSet && !c.retries.WasSet && !c.shield.WasSet {
+		return fmt.Errorf("error parsing arguments: must provide either --comment, --new-name, --quorum, --retries, --shield or --type to update director")
+	}
+
+	serviceID, serviceVersion, err := argparser.ServiceDetails(argparser.ServiceDetailsOpts{
+		AutoCloneFlag:      c.autoClone,
+		APIClient:          c.Globals.APIClient,
+		Manifest:           *c.Globals.Manifest,
+		Out:                out,
+		ServiceNameFlag:    c.serviceName,
+		ServiceVersionFlag: c.serviceVersion,
+		VerboseMode:        c.Globals.Flags.Verbose,
+	})
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]any{
+			"Service ID":      serviceID,
+			"Service Version": errors.ServiceVersion(serviceVersion),
+		})
+		return err
+	}
+
+	c.input.ServiceID = serviceID
+	c.input.ServiceVersion = serviceVersion.Number
+
+	if c.comment.WasSet {
+		c.input.Comment = &c.comment.Value
+	}
+	if c.newName.WasSet {
+		c.input.NewName = &c.newName.Value
+	}
+	if c.quorum.WasSet {
+		c.input.Quorum = &c.quorum.Value
+	}
+	if c.retries.WasSet {
+		c.input.Retries = &c.retries.Value
+	}
+	if c.shield.WasSet {
+		c.input.Shield = &c.shield.Value
+	}
+	if c.directorType.WasSet {
+		c.input.Type = text.DirectorTypes[c.directorType.Value]
+	}
+
+	d, err := c.Globals.APIClient.UpdateDirector(&c.input)
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]any{
+			"Service ID":      serviceID,
+			"Service Version": serviceVersion.Number,
+		})
+		return err
+	}
+
+	text.Success(out, "Updated director %s (service %s version %d)", d.Name, d.ServiceID, d.ServiceVersion)
+	return nil
+}
@@ -0,0 +1,209 @@
+package pool
+
+import (
+	"io"
+
+	"github.com/fastly/go-fastly/v8/fastly"
+
+	"github.com/fastly/cli/pkg/argparser"
+	"github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/global"
+	"github.com/fastly/cli/pkg/text"
+)
+
+// poolTypeFlagOpts is the list of pool types accepted by --type.
+var poolTypeFlagOpts = []string{
+	string(fastly.PoolTypeRandom),
+	string(fastly.PoolTypeHash),
+	string(fastly.PoolTypeClient),
+}
+
+// CreateCommand calls the Fastly API to create pools.
+type CreateCommand struct {
+	argparser.Base
+
+	// Required.
+	name           string
+	serviceVersion argparser.OptionalServiceVersion
+
+	// Optional.
+	autoClone        argparser.OptionalAutoClone
+	comment          argparser.OptionalString
+	connectTimeout   argparser.OptionalInt
+	firstByteTimeout argparser.OptionalInt
+	healthcheck      argparser.OptionalString
+	maxConnDefault   argparser.OptionalInt
+	maxTLSVersion    argparser.OptionalString
+	minTLSVersion    argparser.OptionalString
+	overrideHost     argparser.OptionalString
+	poolType         argparser.OptionalString
+	quorum           argparser.OptionalInt
+	requestCondition argparser.OptionalString
+	serviceName      argparser.OptionalServiceNameID
+	shield           argparser.OptionalString
+	tlsCACert        argparser.OptionalString
+	tlsCertHostname  argparser.OptionalString
+	tlsCheckCert     argparser.OptionalBool
+	tlsCiphers       argparser.OptionalString
+	tlsClientCert    argparser.OptionalString
+	tlsClientKey     argparser.OptionalString
+	tlsSNIHostname   argparser.OptionalString
+	useTLS           argparser.OptionalBool
+}
+
+// NewCreateCommand returns a usable command registered under the parent.
+func NewCreateCommand(parent argparser.Registerer, g *global.Data) *CreateCommand {
+	c := CreateCommand{
+		Base: argparser.Base{
+			Globals: g,
+		},
+	}
+	c.CmdClause = parent.Command("create", "Create a pool on a Fastly service version").Alias("add")
+
+	// Required.
+	c.CmdClause.Flag("name", "Pool name").Short('n').Required().StringVar(&c.name)
+	c.RegisterFlag(argparser.StringFlagOpts{
+		Name:        argparser.FlagVersionName,
+		Description: argparser.FlagVersionDesc,
+		Dst:         &c.serviceVersion.Value,
+		Required:    true,
+	})
+
+	// Optional.
+	c.RegisterAutoCloneFlag(argparser.AutoCloneFlagOpts{
+		Action: c.autoClone.Set,
+		Dst:    &c.autoClone.Value,
+	})
+	c.CmdClause.Flag("comment", "A descriptive note").Action(c.comment.Set).StringVar(&c.comment.Value)
+	c.CmdClause.Flag("connect-timeout", "How long to wait for a timeout in milliseconds").Action(c.connectTimeout.Set).IntVar(&c.connectTimeout.Value)
+	c.CmdClause.Flag("first-byte-timeout", "How long to wait for the first bytes in milliseconds").Action(c.firstByteTimeout.Set).IntVar(&c.firstByteTimeout.Value)
+	c.CmdClause.Flag("healthcheck", "The name of the healthcheck to use with this pool").Action(c.healthcheck.Set).StringVar(&c.healthcheck.Value)
+	c.CmdClause.Flag("max-conn-default", "Maximum number of connections for each server in the pool").Action(c.maxConnDefault.Set).IntVar(&c.maxConnDefault.Value)
+	c.CmdClause.Flag("max-tls-version", "Maximum allowed TLS version on connections to the pool").Action(c.maxTLSVersion.Set).StringVar(&c.maxTLSVersion.Value)
+	c.CmdClause.Flag("min-tls-version", "Minimum allowed TLS version on connections to the pool").Action(c.minTLSVersion.Set).StringVar(&c.minTLSVersion.Value)
+	c.CmdClause.Flag("override-host", "The hostname to override the Host header").Action(c.overrideHost.Set).StringVar(&c.overrideHost.Value)
+	c.CmdClause.Flag("quorum", "Percentage of capacity (0-100) that needs to be operationally available for a pool to be considered up").Action(c.quorum.Set).IntVar(&c.quorum.Value)
+	c.CmdClause.Flag("request-condition", "Condition, which if met, will select this pool during a request").Action(c.requestCondition.Set).StringVar(&c.requestCondition.Value)
+	c.RegisterFlag(argparser.StringFlagOpts{
+		Name:        argparser.FlagServiceIDName,
+		Description: argparser.FlagServiceIDDesc,
+		Dst:         &g.Manifest.Flag.ServiceID,
+		Short:       's',
+	})
+	c.RegisterFlag(argparser.StringFlagOpts{
+		Action:      c.serviceName.Set,
+		Name:        argparser.FlagServiceName,
+		Description: argparser.FlagServiceDesc,
+		Dst:         &c.serviceName.Value,
+	})
+	c.CmdClause.Flag("shield", "Selected POP to serve as a shield for the servers").Action(c.shield.Set).StringVar(&c.shield.Value)
+	c.CmdClause.Flag("tls-ca-cert", "A secure certificate to authenticate a server with").Action(c.tlsCACert.Set).StringVar(&c.tlsCACert.Value)
+	c.CmdClause.Flag("tls-cert-hostname", "The hostname used to verify a server's certificate").Action(c.tlsCertHostname.Set).StringVar(&c.tlsCertHostname.Value)
+	c.CmdClause.Flag("tls-check-cert", "Be strict on checking TLS certs").Action(c.tlsCheckCert.Set).BoolVar(&c.tlsCheckCert.Value)
+	c.CmdClause.Flag("tls-ciphers", "List of OpenSSL ciphers (https://www.openssl.org/docs/man1.0.2/man1/ciphers)").Action(c.tlsCiphers.Set).StringVar(&c.tlsCiphers.Value)
+	c.CmdClause.Flag("tls-client-cert", "The client certificate used to make authenticated requests").Action(c.tlsClientCert.Set).StringVar(&c.tlsClientCert.Value)
+	c.CmdClause.Flag("tls-client-key", "The client private key used to make authenticated requests").Action(c.tlsClientKey.Set).StringVar(&c.tlsClientKey.Value)
+	c.CmdClause.Flag("tls-sni-hostname", "SNI hostname").Action(c.tlsSNIHostname.Set).StringVar(&c.tlsSNIHostname.Value)
+	c.CmdClause.Flag("type", "How servers are selected by the pool").HintOptions(poolTypeFlagOpts...).Action(c.poolType.Set).EnumVar(&c.poolType.Value, poolTypeFlagOpts...)
+	c.CmdClause.Flag("use-tls", "Whether or not to use TLS to reach the servers").Action(c.useTLS.Set).BoolVar(&c.useTLS.Value)
+
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *CreateCommand) Exec(_ io.Reader, out io.Writer) error {
+	serviceID, serviceVersion, err := argparser.ServiceDetails(argparser.ServiceDetailsOpts{
+		AutoCloneFlag:      c.autoClone,
+		APIClient:          c.Globals.APIClient,
+		Manifest:           *c.Globals.Manifest,
+		Out:                out,
+		ServiceNameFlag:    c.serviceName,
+		ServiceVersionFlag: c.serviceVersion,
+		VerboseMode:        c.Globals.Flags.Verbose,
+	})
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]any{
+			"Service ID":      serviceID,
+			"Service Version": errors.ServiceVersion(serviceVersion),
+		})
+		return err
+	}
+
+	input := fastly.CreatePoolInput{
+		Name:           &c.name,
+		ServiceID:      serviceID,
+		ServiceVersion: serviceVersion.Number,
+	}
+	if c.comment.WasSet {
+		input.Comment = &c.comment.Value
+	}
+	if c.connectTimeout.WasSet {
+		input.ConnectTimeout = &c.connectTimeout.Value
+	}
+	if c.firstByteTimeout.WasSet {
+		input.FirstByteTimeout = &c.firstByteTimeout.Value
+	}
+	if c.healthcheck.WasSet {
+		input.Healthcheck = &c.healthcheck.Value
+	}
+	if c.maxConnDefault.WasSet {
+		input.MaxConnDefault = &c.maxConnDefault.Value
+	}
+	if c.maxTLSVersion.WasSet {
+		input.MaxTLSVersion = &c.maxTLSVersion.Value
+	}
+	if c.minTLSVersion.WasSet {
+		input.MinTLSVersion = &c.minTLSVersion.Value
+	}
+	if c.overrideHost.WasSet {
+		input.OverrideHost = &c.overrideHost.Value
+	}
+	if c.poolType.WasSet {
+		input.Type = fastly.PoolTypePtr(fastly.PoolType(c.poolType.Value))
+	}
+	if c.quorum.WasSet {
+		input.Quorum = &c.quorum.Value
+	}
+	if c.requestCondition.WasSet {
+		input.RequestCondition = &c.requestCondition.Value
+	}
+	if c.shield.WasSet {
+		input.Shield = &c.shield.Value
+	}
+	if c.tlsCACert.WasSet {
+		input.TLSCACert = &c.tlsCACert.Value
+	}
+	if c.tlsCertHostname.WasSet {
+		input.TLSCertHostname = &c.tlsCertHostname.Value
+	}
+	if c.tlsCheckCert.WasSet {
+		input.TLSCheckCert = fastly.CBool(c.tlsCheckCert.Value)
+	}
+	if c.tlsCiphers.WasSet {
+		input.TLSCiphers = &c.tlsCiphers.Value
+	}
+	if c.tlsClientCert.WasSet {
+		input.TLSClientCert = &c.tlsClientCert.Value
+	}
+	if c.tlsClientKey.WasSet {
+		input.TLSClientKey = &c.tlsClientKey.Value
+	}
+	if c.tlsSNIHostname.WasSet {
+		input.TLSSNIHostname = &c.tlsSNIHostname.Value
+	}
+	if c.useTLS.WasSet {
+		input.UseTLS = fastly.CBool(c.useTLS.Value)
+	}
+
+	p, err := c.Globals.APIClient.CreatePool(&input)
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]any{
+			"Service ID":      serviceID,
+			"Service Version": serviceVersion.Number,
+		})
+		return err
+	}
+
+	text.Success(out, "Created pool %s (id %s, service %s version %d)", p.Name, p.ID, p.ServiceID, p.ServiceVersion)
+	return nil
+}
@@ -0,0 +1,241 @@
+package pool_test
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/fastly/go-fastly/v8/fastly"
+
+	"github.com/fastly/cli/pkg/app"
+	"github.com/fastly/cli/pkg/global"
+	"github.com/fastly/cli/pkg/mock"
+	"github.com/fastly/cli/pkg/testutil"
+)
+
+func TestPoolCreate(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Args:      args("pool create --service-id 123 --version 3"),
+			WantError: "error parsing arguments: required flag --name not provided",
+		},
+		{
+			Args: args("pool create --service-id 123 --version 1 --name web"),
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+			},
+			WantError: "service version 1 is not editable",
+		},
+		{
+			Args: args("pool create --service-id 123 --version 3 --name web"),
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				CreatePoolFn: func(_ *fastly.CreatePoolInput) (*fastly.Pool, error) {
+					return nil, errTest
+				},
+			},
+			WantError: errTest.Error(),
+		},
+		{
+			Args: args("pool create --service-id 123 --version 1 --name web --type hash --use-tls --autoclone"),
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				CloneVersionFn: testutil.CloneVersionResult(4),
+				CreatePoolFn: func(i *fastly.CreatePoolInput) (*fastly.Pool, error) {
+					if *i.Type != fastly.PoolTypeHash || !bool(*i.UseTLS) {
+						return nil, errTest
+					}
+					return &fastly.Pool{
+						ID:             "456",
+						Name:           *i.Name,
+						ServiceID:      i.ServiceID,
+						ServiceVersion: i.ServiceVersion,
+					}, nil
+				},
+			},
+			WantOutput: "Created pool web (id 456, service 123 version 4)",
+		},
+	}
+	for testcaseIdx := range scenarios {
+		testcase := &scenarios[testcaseIdx]
+		t.Run(strings.Join(testcase.Args, " "), func(t *testing.T) {
+			var stdout bytes.Buffer
+			app.Init = func(_ []string, _ io.Reader) (*global.Data, error) {
+				opts := testutil.MockGlobalData(testcase.Args, &stdout)
+				opts.APIClientFactory = mock.APIClient(testcase.API)
+				return opts, nil
+			}
+			err := app.Run(testcase.Args, nil)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.WantOutput)
+		})
+	}
+}
+
+func TestPoolList(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Args: args("pool list --service-id 123 --version 1"),
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				ListPoolsFn:    listPoolsOK,
+			},
+			WantOutput: listPoolsShortOutput,
+		},
+		{
+			Args: args("pool list --service-id 123 --version 1"),
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				ListPoolsFn: func(_ *fastly.ListPoolsInput) ([]*fastly.Pool, error) {
+					return nil, errTest
+				},
+			},
+			WantError: errTest.Error(),
+		},
+	}
+	for testcaseIdx := range scenarios {
+		testcase := &scenarios[testcaseIdx]
+		t.Run(strings.Join(testcase.Args, " "), func(t *testing.T) {
+			var stdout bytes.Buffer
+			app.Init = func(_ []string, _ io.Reader) (*global.Data, error) {
+				opts := testutil.MockGlobalData(testcase.Args, &stdout)
+				opts.APIClientFactory = mock.APIClient(testcase.API)
+				return opts, nil
+			}
+			err := app.Run(testcase.Args, nil)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.WantOutput)
+		})
+	}
+}
+
+func TestPoolDescribe(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Args:      args("pool describe --service-id 123 --version 1"),
+			WantError: "error parsing arguments: required flag --name not provided",
+		},
+		{
+			Args: args("pool describe --service-id 123 --version 1 --name web"),
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				GetPoolFn: func(i *fastly.GetPoolInput) (*fastly.Pool, error) {
+					return poolFixture(i.ServiceID, i.ServiceVersion, i.Name), nil
+				},
+			},
+			WantOutput: "Service Version: 1\n\nID: 456\nName: web\nComment: web servers\nType: random\nQuorum: 50\n",
+		},
+	}
+	for testcaseIdx := range scenarios {
+		testcase := &scenarios[testcaseIdx]
+		t.Run(strings.Join(testcase.Args, " "), func(t *testing.T) {
+			var stdout bytes.Buffer
+			app.Init = func(_ []string, _ io.Reader) (*global.Data, error) {
+				opts := testutil.MockGlobalData(testcase.Args, &stdout)
+				opts.APIClientFactory = mock.APIClient(testcase.API)
+				return opts, nil
+			}
+			err := app.Run(testcase.Args, nil)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.WantOutput)
+		})
+	}
+}
+
+func TestPoolUpdate(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Args: args("pool update --service-id 123 --version 3 --name web --new-name app --quorum 75"),
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				UpdatePoolFn: func(i *fastly.UpdatePoolInput) (*fastly.Pool, error) {
+					if i.Name != "web" || *i.Quorum != 75 {
+						return nil, errTest
+					}
+					return &fastly.Pool{
+						ID:             "456",
+						Name:           *i.NewName,
+						ServiceID:      i.ServiceID,
+						ServiceVersion: i.ServiceVersion,
+					}, nil
+				},
+			},
+			WantOutput: "Updated pool app (id 456, service 123 version 3)",
+		},
+	}
+	for testcaseIdx := range scenarios {
+		testcase := &scenarios[testcaseIdx]
+		t.Run(strings.Join(testcase.Args, " "), func(t *testing.T) {
+			var stdout bytes.Buffer
+			app.Init = func(_ []string, _ io.Reader) (*global.Data, error) {
+				opts := testutil.MockGlobalData(testcase.Args, &stdout)
+				opts.APIClientFactory = mock.APIClient(testcase.API)
+				return opts, nil
+			}
+			err := app.Run(testcase.Args, nil)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.WantOutput)
+		})
+	}
+}
+
+func TestPoolDelete(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Args: args("pool delete --service-id 123 --version 3 --name web"),
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				DeletePoolFn: func(_ *fastly.DeletePoolInput) error {
+					return nil
+				},
+			},
+			WantOutput: "Deleted pool web (service 123 version 3)",
+		},
+	}
+	for testcaseIdx := range scenarios {
+		testcase := &scenarios[testcaseIdx]
+		t.Run(strings.Join(testcase.Args, " "), func(t *testing.T) {
+			var stdout bytes.Buffer
+			app.Init = func(_ []string, _ io.Reader) (*global.Data, error) {
+				opts := testutil.MockGlobalData(testcase.Args, &stdout)
+				opts.APIClientFactory = mock.APIClient(testcase.API)
+				return opts, nil
+			}
+			err := app.Run(testcase.Args, nil)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.WantOutput)
+		})
+	}
+}
+
+var errTest = errors.New("fixture error")
+
+func poolFixture(serviceID string, version int, name string) *fastly.Pool {
+	return &fastly.Pool{
+		Comment:        "web servers",
+		ID:             "456",
+		Name:           name,
+		Quorum:         50,
+		ServiceID:      serviceID,
+		ServiceVersion: version,
+		Type:           fastly.PoolTypeRandom,
+	}
+}
+
+func listPoolsOK(i *fastly.ListPoolsInput) ([]*fastly.Pool, error) {
+	return []*fastly.Pool{
+		poolFixture(i.ServiceID, i.ServiceVersion, "web"),
+	}, nil
+}
+
+var listPoolsShortOutput = strings.TrimSpace(`
+SERVICE  VERSION  NAME  ID   TYPE    QUORUM
+123      1        web   456  random  50
+`) + "\n"
@@ -0,0 +1,3 @@
+// Package server contains commands to inspect and manipulate the servers of
+// a Fastly service pool.
+package server
@@ -0,0 +1,249 @@
+package server_test
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/fastly/go-fastly/v8/fastly"
+
+	"github.com/fastly/cli/pkg/app"
+	"github.com/fastly/cli/pkg/global"
+	"github.com/fastly/cli/pkg/mock"
+	"github.com/fastly/cli/pkg/testutil"
+)
+
+func TestServerCreate(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Name:      "validate missing --pool-id flag",
+			Args:      args("pool server create --address 127.0.0.1 --service-id 123"),
+			WantError: "error parsing arguments: required flag --pool-id not provided",
+		},
+		{
+			Name:      "validate missing --service-id flag",
+			Args:      args("pool server create --address 127.0.0.1 --pool-id 456"),
+			WantError: "error reading service: no service ID found",
+		},
+		{
+			Name: "validate CreateServer API error",
+			API: mock.API{
+				CreateServerFn: func(_ *fastly.CreateServerInput) (*fastly.Server, error) {
+					return nil, errTest
+				},
+			},
+			Args:      args("pool server create --address 127.0.0.1 --pool-id 456 --service-id 123"),
+			WantError: errTest.Error(),
+		},
+		{
+			Name: "validate CreateServer API success",
+			API: mock.API{
+				CreateServerFn: func(i *fastly.CreateServerInput) (*fastly.Server, error) {
+					if *i.Weight != 50 {
+						return nil, errTest
+					}
+					return &fastly.Server{
+						Address:   *i.Address,
+						ID:        "789",
+						PoolID:    i.PoolID,
+						ServiceID: i.ServiceID,
+					}, nil
+				},
+			},
+			Args:       args("pool server create --address 127.0.0.1 --pool-id 456 --service-id 123 --weight 50"),
+			WantOutput: "Created server '789' (address: 127.0.0.1, pool: 456, service: 123)",
+		},
+	}
+
+	for testcaseIdx := range scenarios {
+		testcase := &scenarios[testcaseIdx]
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			app.Init = func(_ []string, _ io.Reader) (*global.Data, error) {
+				opts := testutil.MockGlobalData(testcase.Args, &stdout)
+				opts.APIClientFactory = mock.APIClient(testcase.API)
+				return opts, nil
+			}
+			err := app.Run(testcase.Args, nil)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.WantOutput)
+		})
+	}
+}
+
+func TestServerList(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Name: "validate ListServers API error",
+			API: mock.API{
+				ListServersFn: func(_ *fastly.ListServersInput) ([]*fastly.Server, error) {
+					return nil, errTest
+				},
+			},
+			Args:      args("pool server list --pool-id 456 --service-id 123"),
+			WantError: errTest.Error(),
+		},
+		{
+			Name: "validate ListServers API success",
+			API: mock.API{
+				ListServersFn: listServersOK,
+			},
+			Args:       args("pool server list --pool-id 456 --service-id 123"),
+			WantOutput: listServersOutput,
+		},
+	}
+
+	for testcaseIdx := range scenarios {
+		testcase := &scenarios[testcaseIdx]
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			app.Init = func(_ []string, _ io.Reader) (*global.Data, error) {
+				opts := testutil.MockGlobalData(testcase.Args, &stdout)
+				opts.APIClientFactory = mock.APIClient(testcase.API)
+				return opts, nil
+			}
+			err := app.Run(testcase.Args, nil)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.WantOutput)
+		})
+	}
+}
+
+func TestServerDescribe(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Name:      "validate missing --id flag",
+			Args:      args("pool server describe --pool-id 456 --service-id 123"),
+			WantError: "error parsing arguments: required flag --id not provided",
+		},
+		{
+			Name: "validate GetServer API success",
+			API: mock.API{
+				GetServerFn: func(i *fastly.GetServerInput) (*fastly.Server, error) {
+					return serverFixture(i.ServiceID, i.PoolID, i.Server), nil
+				},
+			},
+			Args:       args("pool server describe --id 789 --pool-id 456 --service-id 123"),
+			WantOutput: "\nService ID: 123\nID: 789\nPool ID: 456\nAddress: 127.0.0.1\nPort: 443\nWeight: 100\n",
+		},
+	}
+
+	for testcaseIdx := range scenarios {
+		testcase := &scenarios[testcaseIdx]
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			app.Init = func(_ []string, _ io.Reader) (*global.Data, error) {
+				opts := testutil.MockGlobalData(testcase.Args, &stdout)
+				opts.APIClientFactory = mock.APIClient(testcase.API)
+				return opts, nil
+			}
+			err := app.Run(testcase.Args, nil)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.WantOutput)
+		})
+	}
+}
+
+func TestServerUpdate(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Name: "validate UpdateServer API success",
+			API: mock.API{
+				UpdateServerFn: func(i *fastly.UpdateServerInput) (*fastly.Server, error) {
+					if !*i.Disabled {
+						return nil, errTest
+					}
+					return serverFixture(i.ServiceID, i.PoolID, i.Server), nil
+				},
+			},
+			Args:       args("pool server update --id 789 --pool-id 456 --service-id 123 --disabled"),
+			WantOutput: "Updated server '789' (address: 127.0.0.1, pool: 456, service: 123)",
+		},
+	}
+
+	for testcaseIdx := range scenarios {
+		testcase := &scenarios[testcaseIdx]
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			app.Init = func(_ []string, _ io.Reader) (*global.Data, error) {
+				opts := testutil.MockGlobalData(testcase.Args, &stdout)
+				opts.APIClientFactory = mock.APIClient(testcase.API)
+				return opts, nil
+			}
+			err := app.Run(testcase.Args, nil)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.WantOutput)
+		})
+	}
+}
+
+func TestServerDelete(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Name: "validate DeleteServer API error",
+			API: mock.API{
+				DeleteServerFn: func(_ *fastly.DeleteServerInput) error {
+					return errTest
+				},
+			},
+			Args:      args("pool server delete --id 789 --pool-id 456 --service-id 123"),
+			WantError: errTest.Error(),
+		},
+		{
+			Name: "validate DeleteServer API success",
+			API: mock.API{
+				DeleteServerFn: func(_ *fastly.DeleteServerInput) error {
+					return nil
+				},
+			},
+			Args:       args("pool server delete --id 789 --pool-id 456 --service-id 123"),
+			WantOutput: "Deleted server '789' (pool: 456, service: 123)",
+		},
+	}
+
+	for testcaseIdx := range scenarios {
+		testcase := &scenarios[testcaseIdx]
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			app.Init = func(_ []string, _ io.Reader) (*global.Data, error) {
+				opts := testutil.MockGlobalData(testcase.Args, &stdout)
+				opts.APIClientFactory = mock.APIClient(testcase.API)
+				return opts, nil
+			}
+			err := app.Run(testcase.Args, nil)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.WantOutput)
+		})
+	}
+}
+
+var errTest = errors.New("fixture error")
+
+func serverFixture(serviceID, poolID, id string) *fastly.Server {
+	return &fastly.Server{
+		Address:   "127.0.0.1",
+		ID:        id,
+		PoolID:    poolID,
+		Port:      443,
+		ServiceID: serviceID,
+		Weight:    100,
+	}
+}
+
+func listServersOK(i *fastly.ListServersInput) ([]*fastly.Server, error) {
+	return []*fastly.Server{
+		serverFixture(i.ServiceID, i.PoolID, "789"),
+	}, nil
+}
+
+var listServersOutput = strings.TrimSpace(`
+SERVICE  POOL  ID   ADDRESS    PORT  WEIGHT  DISABLED
+123      456   789  127.0.0.1  443   100     false
+`) + "\n"
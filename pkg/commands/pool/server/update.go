@@ -0,0 +1,121 @@
+package server
+
+import (
+	"io"
+
+	"github.com/fastly/go-fastly/v8/fastly"
+
+	"github.com/fastly/cli/pkg/argparser"
+	"github.com/fastly/cli/pkg/global"
+	"github.com/fastly/cli/pkg/text"
+)
+
+// NewUpdateCommand returns a usable command registered under the parent.
+func NewUpdateCommand(parent argparser.Registerer, g *global.Data) *UpdateCommand {
+	c := UpdateCommand{
+		Base: argparser.Base{
+			Globals: g,
+		},
+	}
+	c.CmdClause = parent.Command("update", "Update a server in a pool")
+
+	// Required.
+	c.CmdClause.Flag("id", "Alphanumeric string identifying a server").Required().StringVar(&c.id)
+	c.CmdClause.Flag("pool-id", "Alphanumeric string identifying a pool").Required().StringVar(&c.poolID)
+
+	// Optional.
+	c.CmdClause.Flag("address", "A hostname, IPv4, or IPv6 address for the server").Action(c.address.Set).StringVar(&c.address.Value)
+	c.CmdClause.Flag("comment", "A descriptive note").Action(c.comment.Set).StringVar(&c.comment.Value)
+	c.CmdClause.Flag("disabled", "Whether the server is disabled").Action(c.disabled.Set).BoolVar(&c.disabled.Value)
+	c.CmdClause.Flag("max-conn", "Maximum number of connections (overrides the pool's max-conn-default)").Action(c.maxConn.Set).IntVar(&c.maxConn.Value)
+	c.CmdClause.Flag("override-host", "The hostname to override the Host header").Action(c.overrideHost.Set).StringVar(&c.overrideHost.Value)
+	c.CmdClause.Flag("port", "Port number of the address").Action(c.port.Set).IntVar(&c.port.Value)
+	c.RegisterFlag(argparser.StringFlagOpts{
+		Name:        argparser.FlagServiceIDName,
+		Description: argparser.FlagServiceIDDesc,
+		Dst:         &g.Manifest.Flag.ServiceID,
+		Short:       's',
+	})
+	c.RegisterFlag(argparser.StringFlagOpts{
+		Action:      c.serviceName.Set,
+		Name:        argparser.FlagServiceName,
+		Description: argparser.FlagServiceDesc,
+		Dst:         &c.serviceName.Value,
+	})
+	c.CmdClause.Flag("weight", "Weight (1-100) used to load balance this server against others").Action(c.weight.Set).IntVar(&c.weight.Value)
+
+	return &c
+}
+
+// UpdateCommand calls the Fastly API to update an appropriate resource.
+type UpdateCommand struct {
+	argparser.Base
+
+	address      argparser.OptionalString
+	comment      argparser.OptionalString
+	disabled     argparser.OptionalBool
+	id           string
+	maxConn      argparser.OptionalInt
+	overrideHost argparser.OptionalString
+	poolID       string
+	port         argparser.OptionalInt
+	serviceName  argparser.OptionalServiceNameID
+	weight       argparser.OptionalInt
+}
+
+// Exec invokes the application logic for the command.
+func (c *UpdateCommand) Exec(_ io.Reader, out io.Writer) error {
+	serviceID, source, flag, err := argparser.ServiceID(c.serviceName, *c.Globals.Manifest, c.Globals.APIClient, c.Globals.ErrLog)
+	if err != nil {
+		return err
+	}
+	if c.Globals.Verbose() {
+		argparser.DisplayServiceID(serviceID, flag, source, out)
+	}
+
+	input := c.constructInput(serviceID)
+
+	s, err := c.Globals.APIClient.UpdateServer(input)
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]any{
+			"Service ID": serviceID,
+			"Pool ID":    c.poolID,
+		})
+		return err
+	}
+
+	text.Success(out, "Updated server '%s' (address: %s, pool: %s, service: %s)", s.ID, s.Address, s.PoolID, s.ServiceID)
+	return nil
+}
+
+// constructInput transforms values parsed from CLI flags into an object to be used by the API client library.
+func (c *UpdateCommand) constructInput(serviceID string) *fastly.UpdateServerInput {
+	input := fastly.UpdateServerInput{
+		PoolID:    c.poolID,
+		Server:    c.id,
+		ServiceID: serviceID,
+	}
+	if c.address.WasSet {
+		input.Address = &c.address.Value
+	}
+	if c.comment.WasSet {
+		input.Comment = &c.comment.Value
+	}
+	if c.disabled.WasSet {
+		input.Disabled = &c.disabled.Value
+	}
+	if c.maxConn.WasSet {
+		input.MaxConn = &c.maxConn.Value
+	}
+	if c.overrideHost.WasSet {
+		input.OverrideHost = &c.overrideHost.Value
+	}
+	if c.port.WasSet {
+		input.Port = &c.port.Value
+	}
+	if c.weight.WasSet {
+		input.Weight = &c.weight.Value
+	}
+
+	return &input
+}
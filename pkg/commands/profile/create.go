@@ -29,6 +29,8 @@ type CreateCommand struct {
 	automationToken bool
 	profile         string
 	sso             bool
+	// tokenExpiresAt is when the validated token expires (see validateToken).
+	tokenExpiresAt int64
 }
 
 // NewCreateCommand returns a new command registered in the parent.
@@ -175,6 +177,7 @@ func (c *CreateCommand) validateToken(token, endpoint string, spinner text.Spinn
 			c.Globals.ErrLog.Add(err)
 			return fmt.Errorf("error validating token: %w", err)
 		}
+		c.tokenExpiresAt = profile.TokenExpiry(t)
 		return nil
 	})
 	if err != nil {
@@ -215,9 +218,10 @@ func (c *CreateCommand) updateInMemCfg(email, token, endpoint string, makeDefaul
 			c.Globals.Config.Profiles = make(config.Profiles)
 		}
 		c.Globals.Config.Profiles[c.profile] = &config.Profile{
-			Default: makeDefault,
-			Email:   email,
-			Token:   token,
+			Default:        makeDefault,
+			Email:          email,
+			Token:          token,
+			TokenExpiresAt: c.tokenExpiresAt,
 		}
 
 		// If the user wants the newly created profile to be their new default, then
@@ -3,6 +3,10 @@ package profile
 import (
 	"fmt"
 	"io"
+	"strings"
+	"time"
+
+	"github.com/fastly/go-fastly/v8/fastly"
 
 	"github.com/fastly/cli/pkg/argparser"
 	"github.com/fastly/cli/pkg/config"
@@ -16,6 +20,8 @@ import (
 type ListCommand struct {
 	argparser.Base
 	argparser.JSONOutput
+
+	checkTokens bool
 }
 
 // NewListCommand returns a usable command registered under the parent.
@@ -23,6 +29,7 @@ func NewListCommand(parent argparser.Registerer, g *global.Data) *ListCommand {
 	var c ListCommand
 	c.Globals = g
 	c.CmdClause = parent.Command("list", "List user profiles")
+	c.CmdClause.Flag("check-tokens", "Retrieve the scope, services and expiry of every profile token from the API (by default only the token of the current profile is checked)").BoolVar(&c.checkTokens)
 	c.RegisterFlagBool(c.JSONFlag()) // --json
 	return &c
 }
@@ -33,7 +40,21 @@ func (c *ListCommand) Exec(_ io.Reader, out io.Writer) error {
 		return fsterr.ErrInvalidVerboseJSONCombo
 	}
 
-	if ok, err := c.WriteJSON(out, c.Globals.Config.Profiles); ok {
+	for name := range c.Globals.Config.Profiles {
+		if err := c.Globals.Config.LoadCredentials(name); err != nil {
+			c.Globals.ErrLog.Add(err)
+			return err
+		}
+	}
+
+	details := c.tokenDetails()
+
+	if c.JSONOutput.Enabled {
+		profiles := make(map[string]profileJSON, len(c.Globals.Config.Profiles))
+		for name, p := range c.Globals.Config.Profiles {
+			profiles[name] = newProfileJSON(p, details[name])
+		}
+		_, err := c.WriteJSON(out, profiles)
 		return err
 	}
 
@@ -56,22 +77,139 @@ func (c *ListCommand) Exec(_ io.Reader, out io.Writer) error {
 		text.Warning(out, profile.NoDefaults)
 	} else {
 		text.Info(out, "Default profile highlighted in red.\n\n")
-		display(name, p, out, text.BoldRed)
+		display(name, p, details[name], out, text.BoldRed)
 	}
 
 	for k, v := range c.Globals.Config.Profiles {
 		if !v.Default {
 			text.Break(out)
-			display(k, v, out, text.Bold)
+			display(k, v, details[k], out, text.Bold)
 		}
 	}
 	return nil
 }
 
-func display(k string, v *config.Profile, out io.Writer, style func(a ...any) string) {
+// tokenDetail is the result of retrieving the details of a profile token.
+type tokenDetail struct {
+	err   error
+	token *fastly.Token
+}
+
+// profileJSON is the JSON representation of a profile, along with the details
+// of its token (when they were retrieved).
+//
+// NOTE: The token expiry is the token_expires_at field of the profile, which
+// is updated whenever the token details are retrieved.
+type profileJSON struct {
+	*config.Profile
+	TokenDetailsError string   `json:"token_details_error,omitempty"`
+	TokenScope        string   `json:"token_scope,omitempty"`
+	TokenServices     []string `json:"token_services,omitempty"`
+}
+
+func newProfileJSON(p *config.Profile, d tokenDetail) profileJSON {
+	pj := profileJSON{Profile: p}
+	switch {
+	case d.err != nil:
+		pj.TokenDetailsError = fsterr.Deduce(d.err).Inner.Error()
+	case d.token != nil:
+		pj.TokenScope = string(d.token.Scope)
+		pj.TokenServices = d.token.Services
+	}
+	return pj
+}
+
+// tokenDetails retrieves the scope, services and expiry of the current profile
+// token (or every profile token with --check-tokens) from the API, recording
+// the expiry in the profile (so the user can be warned before it expires).
+//
+// NOTE: The endpoint of the current invocation (e.g. --api or the project
+// file) only applies to the current profile. The other profile tokens are only
+// sent to the endpoint set in the CLI config (or the default endpoint).
+func (c *ListCommand) tokenDetails() map[string]tokenDetail {
+	current, _ := c.Globals.Profile()
+	currentEndpoint, _ := c.Globals.APIEndpoint()
+	configEndpoint := c.Globals.Config.Fastly.APIEndpoint
+	if configEndpoint == "" {
+		configEndpoint = global.DefaultAPIEndpoint
+	}
+	details := make(map[string]tokenDetail)
+
+	var changed bool
+	for name, p := range c.Globals.Config.Profiles {
+		if p.Token == "" || (name != current && !c.checkTokens) {
+			continue
+		}
+		endpoint := configEndpoint
+		if name == current {
+			endpoint = currentEndpoint
+		}
+		client, err := c.Globals.APIClientFactory(p.Token, endpoint, c.Globals.Flags.Debug)
+		if err != nil {
+			c.Globals.ErrLog.Add(err)
+			details[name] = tokenDetail{err: err}
+			continue
+		}
+		t, err := client.GetTokenSelf()
+		if err != nil {
+			c.Globals.ErrLog.Add(err)
+			details[name] = tokenDetail{err: err}
+			continue
+		}
+		details[name] = tokenDetail{token: t}
+		if expiry := profile.TokenExpiry(t); expiry != p.TokenExpiresAt {
+			p.TokenExpiresAt = expiry
+			changed = true
+		}
+	}
+
+	// NOTE: A failure to persist the token expiry isn't fatal.
+	// The config isn't written when only displaying JSON.
+	if changed && !c.JSONOutput.Enabled {
+		if err := c.Globals.Config.Write(c.Globals.ConfigPath); err != nil {
+			c.Globals.ErrLog.Add(err)
+		}
+	}
+	return details
+}
+
+func display(k string, v *config.Profile, d tokenDetail, out io.Writer, style func(a ...any) string) {
 	text.Output(out, style(k))
 	text.Break(out)
 	text.Output(out, "%s: %t", style("Default"), v.Default)
 	text.Output(out, "%s: %s", style("Email"), v.Email)
 	text.Output(out, "%s: %s", style("Token"), v.Token)
+
+	switch {
+	case d.err != nil:
+		text.Output(out, "%s: unavailable (%s)", style("Token details"), fsterr.Deduce(d.err).Inner)
+	case d.token != nil:
+		services := "all"
+		if len(d.token.Services) > 0 {
+			services = strings.Join(d.token.Services, ", ")
+		}
+		text.Output(out, "%s: %s", style("Token scope"), d.token.Scope)
+		text.Output(out, "%s: %s", style("Token services"), services)
+		text.Output(out, "%s: %s", style("Token expires"), formatExpiry(d.token.ExpiresAt, time.Now()))
+	}
+}
+
+// formatExpiry describes when a token expires.
+func formatExpiry(t *time.Time, now time.Time) string {
+	if t == nil || t.IsZero() {
+		return "never"
+	}
+	expires := t.UTC().Format(time.RFC3339)
+	remaining := t.Sub(now)
+	if remaining <= 0 {
+		return expires + " (expired)"
+	}
+	days := int(remaining.Hours() / 24)
+	switch days {
+	case 0:
+		return expires + " (in less than a day)"
+	case 1:
+		return expires + " (in 1 day)"
+	}
+	return fmt.Sprintf("%s (in %d days)", expires, days)
 }
@@ -12,6 +12,7 @@ import (
 
 	"github.com/fastly/go-fastly/v8/fastly"
 
+	"github.com/fastly/cli/pkg/api"
 	"github.com/fastly/cli/pkg/app"
 	"github.com/fastly/cli/pkg/config"
 	"github.com/fastly/cli/pkg/global"
@@ -335,12 +336,16 @@ func TestProfileList(t *testing.T) {
 	scenarios := []Scenario{
 		{
 			TestScenario: testutil.TestScenario{
+				API: mock.API{
+					GetTokenSelfFn: getToken,
+				},
 				Name: "validate listing profiles works",
 				Args: args("profile list"),
 				WantOutputs: []string{
 					"Default profile highlighted in red.",
 					"foo\n\nDefault: true\nEmail: foo@example.com\nToken: 123",
 					"bar\n\nDefault: false\nEmail: bar@example.com\nToken: 456",
+					"Token scope: purge_all global:read\nToken services: a, b\nToken expires: 2021-06-15T23:00:00Z (expired)",
 				},
 			},
 			ConfigFile: config.File{
@@ -384,12 +389,18 @@ func TestProfileList(t *testing.T) {
 		},
 		{
 			TestScenario: testutil.TestScenario{
+				API: mock.API{
+					GetTokenSelfFn: func() (*fastly.Token, error) {
+						return nil, testutil.Err
+					},
+				},
 				Name: "validate listing profiles displays warning if no default set",
-				Args: args("profile list"),
+				Args: args("profile list --check-tokens"),
 				WantOutputs: []string{
-					"At least one account profile should be set as the 'default'. Run `fastly profile update <NAME>`.",
+					"At least one account profile should be set as the 'default'. Run `fastly profile update <NAME>`",
 					"foo\n\nDefault: false\nEmail: foo@example.com\nToken: 123",
 					"bar\n\nDefault: false\nEmail: bar@example.com\nToken: 456",
+					"Token details: unavailable (test error)",
 				},
 			},
 			ConfigFile: config.File{
@@ -430,6 +441,11 @@ func TestProfileList(t *testing.T) {
 		},
 		{
 			TestScenario: testutil.TestScenario{
+				API: mock.API{
+					GetTokenSelfFn: func() (*fastly.Token, error) {
+						return &fastly.Token{ID: "123"}, nil
+					},
+				},
 				Name: "validate listing profiles with --json displays data correctly",
 				Args: args("profile list --json"),
 				WantOutput: `{
@@ -472,6 +488,42 @@ func TestProfileList(t *testing.T) {
 				},
 			},
 		},
+		{
+			TestScenario: testutil.TestScenario{
+				API: mock.API{
+					GetTokenSelfFn: getToken,
+				},
+				Name: "validate listing profiles with --json includes the current profile token details",
+				Args: args("profile list --json"),
+				WantOutputs: []string{
+					`"token": "456"
+  },
+  "foo": {`,
+					`"token": "123",
+    "token_expires_at": 1623798000,
+    "token_scope": "purge_all global:read",
+    "token_services": [
+      "a",
+      "b"
+    ]
+  }`,
+				},
+			},
+			ConfigFile: config.File{
+				Profiles: config.Profiles{
+					"foo": &config.Profile{
+						Default: true,
+						Email:   "foo@example.com",
+						Token:   "123",
+					},
+					"bar": &config.Profile{
+						Default: false,
+						Email:   "bar@example.com",
+						Token:   "456",
+					},
+				},
+			},
+		},
 	}
 
 	for testcaseIdx := range scenarios {
@@ -503,6 +555,82 @@ func TestProfileList(t *testing.T) {
 
 			testutil.AssertErrorContains(t, err, testcase.WantError)
 			testutil.AssertStringContains(t, stdout.String(), testcase.WantOutput)
+			for _, s := range testcase.WantOutputs {
+				testutil.AssertStringContains(t, stdout.String(), s)
+			}
+		})
+	}
+}
+
+func TestProfileListCheckTokens(t *testing.T) {
+	rootdir := testutil.NewEnv(testutil.EnvOpts{
+		T: t,
+	})
+	defer os.RemoveAll(rootdir)
+
+	args := testutil.Args
+	for _, testcase := range []struct {
+		name          string
+		args          []string
+		wantEndpoints map[string]string
+	}{
+		{
+			name: "only the current profile token is checked by default",
+			args: args("profile list --api https://api.example.com"),
+			wantEndpoints: map[string]string{
+				"123": "https://api.example.com",
+			},
+		},
+		{
+			name: "the other profile tokens are only sent to the default endpoint",
+			args: args("profile list --check-tokens --api https://api.example.com"),
+			wantEndpoints: map[string]string{
+				"123": "https://api.example.com",
+				"456": global.DefaultAPIEndpoint,
+			},
+		},
+		{
+			name: "the --profile flag selects the current profile",
+			args: args("profile list --profile bar"),
+			wantEndpoints: map[string]string{
+				"456": global.DefaultAPIEndpoint,
+			},
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.MockGlobalData(testcase.args, &stdout)
+			opts.ConfigPath = filepath.Join(rootdir, "config.toml")
+			opts.Config = config.File{
+				Profiles: config.Profiles{
+					"foo": &config.Profile{
+						Default: true,
+						Email:   "foo@example.com",
+						Token:   "123",
+					},
+					"bar": &config.Profile{
+						Default: false,
+						Email:   "bar@example.com",
+						Token:   "456",
+					},
+				},
+			}
+
+			endpoints := make(map[string]string)
+			opts.APIClientFactory = func(token, endpoint string, _ bool) (api.Interface, error) {
+				endpoints[token] = endpoint
+				return mock.API{GetTokenSelfFn: getToken}, nil
+			}
+
+			app.Init = func(_ []string, _ io.Reader) (*global.Data, error) {
+				return opts, nil
+			}
+			err := app.Run(testcase.args, nil)
+
+			t.Log(stdout.String())
+
+			testutil.AssertNoError(t, err)
+			testutil.AssertEqual(t, testcase.wantEndpoints, endpoints)
 		})
 	}
 }
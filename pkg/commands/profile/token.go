@@ -39,8 +39,12 @@ func (c *TokenCommand) Exec(_ io.Reader, out io.Writer) (err error) {
 	}
 
 	if p != "" {
-		if p := profile.Get(p, c.Globals.Config.Profiles); p != nil {
-			text.Output(out, p.Token)
+		if pd := profile.Get(p, c.Globals.Config.Profiles); pd != nil {
+			if err := c.Globals.Config.LoadCredentials(p); err != nil {
+				c.Globals.ErrLog.Add(err)
+				return err
+			}
+			text.Output(out, pd.Token)
 			return nil
 		}
 		msg := fmt.Sprintf(profile.DoesNotExist, p)
@@ -51,7 +55,11 @@ func (c *TokenCommand) Exec(_ io.Reader, out io.Writer) (err error) {
 	}
 
 	// If no 'profile' arg or global --profile, then we'll use 'active' profile.
-	if _, p := profile.Default(c.Globals.Config.Profiles); p != nil {
+	if name, p := profile.Default(c.Globals.Config.Profiles); p != nil {
+		if err := c.Globals.Config.LoadCredentials(name); err != nil {
+			c.Globals.ErrLog.Add(err)
+			return err
+		}
 		text.Output(out, p.Token)
 		return nil
 	}
@@ -25,6 +25,8 @@ type UpdateCommand struct {
 	automationToken bool
 	profile         string
 	sso             bool
+	// tokenExpiresAt is when the validated token expires (see validateToken).
+	tokenExpiresAt int64
 }
 
 // NewUpdateCommand returns a usable command registered under the parent.
@@ -47,6 +49,12 @@ func (c *UpdateCommand) Exec(in io.Reader, out io.Writer) error {
 	}
 	text.Info(out, "Profile being updated: '%s'.\n\n", profileName)
 
+	// NOTE: A failure to load the existing tokens isn't fatal, as the user is
+	// about to provide a new token.
+	if err := c.Globals.Config.LoadCredentials(profileName); err != nil {
+		c.Globals.ErrLog.Add(err)
+	}
+
 	err = c.updateToken(profileName, p, in, out)
 	if err != nil {
 		return fmt.Errorf("failed to update token: %w", err)
@@ -182,6 +190,7 @@ func (c *UpdateCommand) validateToken(token, endpoint string, spinner text.Spinn
 			c.Globals.ErrLog.Add(err)
 			return fmt.Errorf("error validating token: %w", err)
 		}
+		c.tokenExpiresAt = profile.TokenExpiry(t)
 		return nil
 	})
 	if err != nil {
@@ -256,6 +265,7 @@ func (c *UpdateCommand) staticTokenFlow(profileName string, p *config.Profile, i
 	}
 	opts = append(opts, func(p *config.Profile) {
 		p.Email = email
+		p.TokenExpiresAt = c.tokenExpiresAt
 	})
 
 	ps, ok := profile.Edit(profileName, c.Globals.Config.Profiles, opts...)
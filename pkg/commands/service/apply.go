@@ -0,0 +1,249 @@
+package service
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/fastly/cli/pkg/argparser"
+	fsterr "github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/global"
+	"github.com/fastly/cli/pkg/serviceconfig"
+	"github.com/fastly/cli/pkg/text"
+	"github.com/fastly/cli/pkg/undo"
+)
+
+// ApplyCommand calls the Fastly API to reconcile a service version with a
+// declarative configuration document (see `service export`).
+type ApplyCommand struct {
+	argparser.Base
+
+	// Required.
+	file           string
+	serviceVersion argparser.OptionalServiceVersion
+
+	// Optional.
+	autoClone   argparser.OptionalAutoClone
+	format      string
+	planOnly    bool
+	serviceName argparser.OptionalServiceNameID
+}
+
+// NewApplyCommand returns a usable command registered under the parent.
+func NewApplyCommand(parent argparser.Registerer, g *global.Data) *ApplyCommand {
+	c := ApplyCommand{
+		Base: argparser.Base{
+			Globals: g,
+		},
+	}
+	c.CmdClause = parent.Command("apply", "Apply a TOML or JSON configuration document (see 'fastly service export') to a Fastly service version")
+
+	// Required.
+	c.CmdClause.Flag("file", "Path to the configuration document").Short('f').Required().StringVar(&c.file)
+	c.RegisterFlag(argparser.StringFlagOpts{
+		Name:        argparser.FlagVersionName,
+		Description: argparser.FlagVersionDesc,
+		Dst:         &c.serviceVersion.Value,
+		Required:    true,
+	})
+
+	// Optional.
+	c.RegisterAutoCloneFlag(argparser.AutoCloneFlagOpts{
+		Action: c.autoClone.Set,
+		Dst:    &c.autoClone.Value,
+	})
+	c.CmdClause.Flag("format", "Format of the document (default: inferred from the file extension)").HintOptions(serviceconfig.Formats...).EnumVar(&c.format, serviceconfig.Formats...)
+	c.CmdClause.Flag("plan", "Display the changes that would be made without applying them").BoolVar(&c.planOnly)
+	c.RegisterFlag(argparser.StringFlagOpts{
+		Name:        argparser.FlagServiceIDName,
+		Description: argparser.FlagServiceIDDesc,
+		Dst:         &g.Manifest.Flag.ServiceID,
+		Short:       's',
+	})
+	c.RegisterFlag(argparser.StringFlagOpts{
+		Action:      c.serviceName.Set,
+		Name:        argparser.FlagServiceName,
+		Description: argparser.FlagServiceDesc,
+		Dst:         &c.serviceName.Value,
+	})
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *ApplyCommand) Exec(in io.Reader, out io.Writer) (err error) {
+	desired, err := c.readDocument()
+	if err != nil {
+		c.Globals.ErrLog.Add(err)
+		return err
+	}
+
+	// NOTE: The version is only cloned once the plan has been confirmed.
+	// A clone has an identical configuration and so the plan remains valid.
+	serviceID, serviceVersion, err := argparser.ServiceDetails(argparser.ServiceDetailsOpts{
+		AllowActiveLocked:  true,
+		APIClient:          c.Globals.APIClient,
+		Manifest:           *c.Globals.Manifest,
+		Out:                out,
+		ServiceNameFlag:    c.serviceName,
+		ServiceVersionFlag: c.serviceVersion,
+		VerboseMode:        c.Globals.Flags.Verbose,
+	})
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]any{
+			"Service ID":      serviceID,
+			"Service Version": fsterr.ServiceVersion(serviceVersion),
+		})
+		return err
+	}
+
+	editable := !serviceVersion.Active && !serviceVersion.Locked
+	if !editable && !c.autoClone.WasSet && !c.planOnly {
+		return fsterr.RemediationError{
+			Inner:       fmt.Errorf("service version %d is not editable", serviceVersion.Number),
+			Remediation: fsterr.AutoCloneRemediation,
+		}
+	}
+
+	current, err := serviceconfig.Fetch(c.Globals.APIClient, serviceID, serviceVersion.Number)
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]any{
+			"Service ID":      serviceID,
+			"Service Version": serviceVersion.Number,
+		})
+		return err
+	}
+
+	changes := serviceconfig.Diff(current, desired)
+	if len(changes) == 0 {
+		text.Info(out, "No changes. Service %s version %d matches %s.", serviceID, serviceVersion.Number, c.file)
+		return nil
+	}
+
+	serviceconfig.PrintChanges(out, changes)
+	create, update, remove := serviceconfig.Summary(changes)
+	text.Output(out, "Plan: %d to add, %d to change, %d to destroy.", create, update, remove)
+	text.Break(out)
+
+	if c.planOnly {
+		return nil
+	}
+
+	if !c.Globals.Flags.AutoYes && !c.Globals.Flags.NonInteractive {
+		cont, err := text.AskYesNo(out, "Are you sure you want to apply these changes? [y/N]: ", in)
+		if err != nil {
+			return err
+		}
+		if !cont {
+			return nil
+		}
+		text.Break(out)
+	}
+
+	if !editable {
+		serviceVersion, err = c.autoClone.Parse(serviceVersion, serviceID, c.Globals.Verbose(), out, c.Globals.APIClient)
+		if err != nil {
+			c.Globals.ErrLog.AddWithContext(err, map[string]any{
+				"Service ID":      serviceID,
+				"Service Version": serviceVersion.Number,
+			})
+			return err
+		}
+	}
+
+	spinner, err := text.NewSpinner(out)
+	if err != nil {
+		return err
+	}
+
+	// If any change fails, then the changes already made to the service version
+	// are reverted (in reverse order) so it's not left in a partial state.
+	undoStack := undo.NewStack()
+	defer func() {
+		if err != nil {
+			c.Globals.ErrLog.AddWithContext(err, map[string]any{
+				"Service ID":      serviceID,
+				"Service Version": serviceVersion.Number,
+			})
+			if undoStack.Len() > 0 {
+				text.Break(out)
+				text.Warning(out, "Reverting the changes made to service version %d", serviceVersion.Number)
+			}
+		}
+		undoStack.RunIfError(out, err)
+	}()
+
+	for _, ch := range orderChanges(changes) {
+		ch := ch
+		family, ok := serviceconfig.Lookup(ch.Family)
+		if !ok {
+			return fmt.Errorf("unrecognised resource family: %s", ch.Family)
+		}
+		msg := fmt.Sprintf("%s %s '%s'", actionVerb(ch.Action), ch.Family, ch.Name)
+		err = spinner.Process(msg, func(_ *text.SpinnerWrapper) error {
+			return applyChange(family, c.Globals, serviceID, serviceVersion.Number, ch)
+		})
+		if err != nil {
+			return fmt.Errorf("error applying change to %s '%s': %w", ch.Family, ch.Name, err)
+		}
+		undoStack.Push(func() error {
+			return applyChange(family, c.Globals, serviceID, serviceVersion.Number, ch.Reverse())
+		})
+	}
+
+	text.Success(out, "Applied %d changes to service %s version %d", len(changes), serviceID, serviceVersion.Number)
+	return nil
+}
+
+// readDocument reads and decodes the configuration document.
+func (c *ApplyCommand) readDocument() (*serviceconfig.Config, error) {
+	format := c.format
+	if format == "" {
+		format = serviceconfig.FormatFromPath(c.file)
+	}
+	f, err := os.Open(c.file) // #nosec G304 (CWE-22)
+	if err != nil {
+		return nil, fmt.Errorf("error reading configuration document: %w", err)
+	}
+	defer f.Close() // #nosec G307
+	return serviceconfig.Decode(f, format)
+}
+
+// applyChange makes the API call(s) required for a single change.
+func applyChange(f serviceconfig.Family, g *global.Data, serviceID string, version int, ch serviceconfig.Change) error {
+	switch ch.Action {
+	case serviceconfig.ActionCreate:
+		return f.Create(g.APIClient, serviceID, version, ch.Payload())
+	case serviceconfig.ActionUpdate:
+		return f.Update(g.APIClient, serviceID, version, ch.Name, ch.Payload())
+	case serviceconfig.ActionDelete:
+		return f.Delete(g.APIClient, serviceID, version, ch.Name)
+	}
+	return nil
+}
+
+// orderChanges returns the changes in the order they should be applied.
+//
+// Creates and updates are applied in family order so that referenced
+// resources (e.g. conditions) exist before the resources that reference them.
+// Deletes are applied afterwards in reverse order for the same reason.
+func orderChanges(changes []serviceconfig.Change) []serviceconfig.Change {
+	ordered := make([]serviceconfig.Change, 0, len(changes))
+	var deletes []serviceconfig.Change
+	for _, ch := range changes {
+		if ch.Action == serviceconfig.ActionDelete {
+			deletes = append(deletes, ch)
+			continue
+		}
+		ordered = append(ordered, ch)
+	}
+	for i := len(deletes) - 1; i >= 0; i-- {
+		ordered = append(ordered, deletes[i])
+	}
+	return ordered
+}
+
+func actionVerb(a serviceconfig.Action) string {
+	s := string(a)
+	return strings.ToUpper(s[:1]) + strings.TrimSuffix(s[1:], "e") + "ing"
+}
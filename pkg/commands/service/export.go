@@ -0,0 +1,112 @@
+package service
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/fastly/cli/pkg/argparser"
+	fsterr "github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/global"
+	"github.com/fastly/cli/pkg/serviceconfig"
+	"github.com/fastly/cli/pkg/text"
+)
+
+// ExportCommand calls the Fastly API to export the configuration of a service
+// version as a declarative document.
+type ExportCommand struct {
+	argparser.Base
+
+	file           string
+	format         string
+	serviceName    argparser.OptionalServiceNameID
+	serviceVersion argparser.OptionalServiceVersion
+}
+
+// NewExportCommand returns a usable command registered under the parent.
+func NewExportCommand(parent argparser.Registerer, g *global.Data) *ExportCommand {
+	c := ExportCommand{
+		Base: argparser.Base{
+			Globals: g,
+		},
+	}
+	c.CmdClause = parent.Command("export", "Export the configuration of a Fastly service version as a TOML or JSON document")
+
+	// Required.
+	c.RegisterFlag(argparser.StringFlagOpts{
+		Name:        argparser.FlagVersionName,
+		Description: argparser.FlagVersionDesc,
+		Dst:         &c.serviceVersion.Value,
+		Required:    true,
+	})
+
+	// Optional.
+	c.CmdClause.Flag("file", "Path to write the document to (default: stdout)").Short('f').StringVar(&c.file)
+	c.CmdClause.Flag("format", "Format of the document (default: inferred from the file extension)").HintOptions(serviceconfig.Formats...).EnumVar(&c.format, serviceconfig.Formats...)
+	c.RegisterFlag(argparser.StringFlagOpts{
+		Name:        argparser.FlagServiceIDName,
+		Description: argparser.FlagServiceIDDesc,
+		Dst:         &g.Manifest.Flag.ServiceID,
+		Short:       's',
+	})
+	c.RegisterFlag(argparser.StringFlagOpts{
+		Action:      c.serviceName.Set,
+		Name:        argparser.FlagServiceName,
+		Description: argparser.FlagServiceDesc,
+		Dst:         &c.serviceName.Value,
+	})
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *ExportCommand) Exec(_ io.Reader, out io.Writer) error {
+	serviceID, serviceVersion, err := argparser.ServiceDetails(argparser.ServiceDetailsOpts{
+		AllowActiveLocked:  true,
+		APIClient:          c.Globals.APIClient,
+		Manifest:           *c.Globals.Manifest,
+		Out:                out,
+		ServiceNameFlag:    c.serviceName,
+		ServiceVersionFlag: c.serviceVersion,
+		VerboseMode:        c.Globals.Flags.Verbose,
+	})
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]any{
+			"Service ID":      serviceID,
+			"Service Version": fsterr.ServiceVersion(serviceVersion),
+		})
+		return err
+	}
+
+	cfg, err := serviceconfig.Fetch(c.Globals.APIClient, serviceID, serviceVersion.Number)
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]any{
+			"Service ID":      serviceID,
+			"Service Version": serviceVersion.Number,
+		})
+		return err
+	}
+
+	format := c.format
+	if format == "" {
+		format = serviceconfig.FormatFromPath(c.file)
+	}
+
+	if c.file == "" {
+		return cfg.Encode(out, format)
+	}
+
+	f, err := os.Create(c.file) // #nosec G304 (CWE-22)
+	if err != nil {
+		c.Globals.ErrLog.Add(err)
+		return fmt.Errorf("error creating file: %w", err)
+	}
+	defer f.Close() // #nosec G307
+
+	if err := cfg.Encode(f, format); err != nil {
+		c.Globals.ErrLog.Add(err)
+		return fmt.Errorf("error writing file: %w", err)
+	}
+
+	text.Success(out, "Exported service %s version %d to %s", serviceID, serviceVersion.Number, c.file)
+	return nil
+}
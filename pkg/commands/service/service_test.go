@@ -3,9 +3,11 @@ package service_test
 import (
 	"bytes"
 	"errors"
+	"fmt"
 	"io"
 	"os"
 	"path/filepath"
+	"reflect"
 	"regexp"
 	"strings"
 	"testing"
@@ -434,6 +436,357 @@ func TestServiceDelete(t *testing.T) {
 	}
 }
 
+func TestServiceExport(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Args:      args("service export --service-id 123"),
+			WantError: "error parsing arguments: required flag --version not provided",
+		},
+		{
+			Args: args("service export --service-id 123 --version 1"),
+			API: testutil.EmptyLists(mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				ListBackendsFn: func(_ *fastly.ListBackendsInput) ([]*fastly.Backend, error) {
+					return nil, errTest
+				},
+			}),
+			WantError: "error listing backend resources: fixture error",
+		},
+		{
+			Args: args("service export --service-id 123 --version 1"),
+			API: testutil.EmptyLists(mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				ListBackendsFn: listBackendsOK,
+				ListDomainsFn:  listDomainsOK,
+			}),
+			WantOutput: exportServiceTOMLOutput,
+		},
+		{
+			Args: args("service export --service-id 123 --version 1 --format json"),
+			API: testutil.EmptyLists(mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				ListBackendsFn: listBackendsOK,
+				ListDomainsFn:  listDomainsOK,
+			}),
+			WantOutput: exportServiceJSONOutput,
+		},
+	}
+
+	for testcaseIdx := range scenarios {
+		testcase := &scenarios[testcaseIdx]
+		t.Run(strings.Join(testcase.Args, " "), func(t *testing.T) {
+			var stdout bytes.Buffer
+			app.Init = func(_ []string, _ io.Reader) (*global.Data, error) {
+				opts := testutil.MockGlobalData(testcase.Args, &stdout)
+				opts.APIClientFactory = mock.APIClient(testcase.API)
+				return opts, nil
+			}
+			err := app.Run(testcase.Args, nil)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.WantOutput)
+		})
+	}
+}
+
+func TestServiceExportApplyRoundTrip(t *testing.T) {
+	document := filepath.Join(t.TempDir(), "service.json")
+	api := testutil.EmptyLists(mock.API{
+		ListVersionsFn: testutil.ListVersions,
+		ListBackendsFn: listBackendsOK,
+		ListDomainsFn:  listDomainsOK,
+	})
+
+	args := testutil.Args
+	for _, testcase := range []struct {
+		args       []string
+		wantOutput string
+	}{
+		{
+			args:       args("service export --service-id 123 --version 1 --file " + document),
+			wantOutput: "Exported service 123 version 1 to " + document,
+		},
+		{
+			args:       args("service apply --service-id 123 --version 3 --file " + document),
+			wantOutput: "No changes.",
+		},
+	} {
+		var stdout bytes.Buffer
+		app.Init = func(_ []string, _ io.Reader) (*global.Data, error) {
+			opts := testutil.MockGlobalData(testcase.args, &stdout)
+			opts.APIClientFactory = mock.APIClient(api)
+			return opts, nil
+		}
+		err := app.Run(testcase.args, nil)
+		testutil.AssertNoError(t, err)
+		testutil.AssertStringContains(t, stdout.String(), testcase.wantOutput)
+	}
+
+	// The format is inferred from the .json extension.
+	data, err := os.ReadFile(document)
+	if err != nil {
+		t.Fatal(err)
+	}
+	testutil.AssertString(t, exportServiceJSONOutput, string(data))
+}
+
+func TestServiceApply(t *testing.T) {
+	dir := t.TempDir()
+	document := filepath.Join(dir, "service.toml")
+	if err := os.WriteFile(document, []byte(applyServiceDocument), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	var deletedDomains []string
+	deleteDomainOK := func(i *fastly.DeleteDomainInput) error {
+		deletedDomains = append(deletedDomains, i.Name)
+		return nil
+	}
+
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Name:      "validate missing --file flag",
+			Args:      args("service apply --service-id 123 --version 3"),
+			WantError: "error parsing arguments: required flag --file not provided",
+		},
+		{
+			Name: "validate active version without --autoclone",
+			Args: args("service apply --service-id 123 --version 1 --file " + document),
+			API: testutil.EmptyLists(mock.API{
+				ListVersionsFn: testutil.ListVersions,
+			}),
+			WantError: "service version 1 is not editable",
+		},
+		{
+			Name: "validate --plan only displays the changes",
+			Args: args("service apply --service-id 123 --version 1 --plan --file " + document),
+			API: testutil.EmptyLists(mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				ListBackendsFn: listBackendsOK,
+				ListDomainsFn:  listDomainsOK,
+			}),
+			WantOutputs: []string{
+				`+ domain "api.example.com"`,
+				`- domain "www.example.com"`,
+				`~ backend "origin"`,
+				"~ port = 443 -> 8080",
+				"Plan: 1 to add, 1 to change, 1 to destroy.",
+			},
+			DontWantOutput: "Applied",
+		},
+		{
+			Name: "validate no changes",
+			Args: args("service apply --service-id 123 --version 3 --file " + document),
+			API: testutil.EmptyLists(mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				ListBackendsFn: func(i *fastly.ListBackendsInput) ([]*fastly.Backend, error) {
+					return []*fastly.Backend{
+						{ServiceID: i.ServiceID, ServiceVersion: i.ServiceVersion, Name: "origin", Address: "example.com", Port: 8080, UseSSL: true},
+					}, nil
+				},
+				ListDomainsFn: func(i *fastly.ListDomainsInput) ([]*fastly.Domain, error) {
+					return []*fastly.Domain{
+						{ServiceID: i.ServiceID, ServiceVersion: i.ServiceVersion, Name: "api.example.com"},
+					}, nil
+				},
+			}),
+			WantOutput: "No changes.",
+		},
+		{
+			Name: "validate changes are applied to a cloned version",
+			Args: args("service apply --service-id 123 --version 1 --autoclone --auto-yes --file " + document),
+			API: testutil.EmptyLists(mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				CloneVersionFn: testutil.CloneVersionResult(4),
+				ListBackendsFn: listBackendsOK,
+				ListDomainsFn:  listDomainsOK,
+				CreateDomainFn: func(i *fastly.CreateDomainInput) (*fastly.Domain, error) {
+					if i.ServiceVersion != 4 || *i.Name != "api.example.com" {
+						return nil, errTest
+					}
+					return &fastly.Domain{}, nil
+				},
+				UpdateBackendFn: func(i *fastly.UpdateBackendInput) (*fastly.Backend, error) {
+					if i.Name != "origin" || i.Port == nil || *i.Port != 8080 || i.Address != nil {
+						return nil, errTest
+					}
+					return &fastly.Backend{}, nil
+				},
+				DeleteDomainFn: deleteDomainOK,
+			}),
+			WantOutput: "Applied 3 changes to service 123 version 4",
+		},
+		{
+			Name: "validate changes are reverted on error",
+			Args: args("service apply --service-id 123 --version 3 --auto-yes --file " + document),
+			API: testutil.EmptyLists(mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				ListBackendsFn: listBackendsOK,
+				ListDomainsFn:  listDomainsOK,
+				CreateDomainFn: func(_ *fastly.CreateDomainInput) (*fastly.Domain, error) {
+					return &fastly.Domain{}, nil
+				},
+				UpdateBackendFn: func(_ *fastly.UpdateBackendInput) (*fastly.Backend, error) {
+					return nil, errTest
+				},
+				DeleteDomainFn: deleteDomainOK,
+			}),
+			WantError:  "error applying change to backend 'origin': fixture error",
+			WantOutput: "Reverting the changes made to service version 3",
+		},
+	}
+
+	for testcaseIdx := range scenarios {
+		testcase := &scenarios[testcaseIdx]
+		t.Run(testcase.Name, func(t *testing.T) {
+			deletedDomains = nil
+			var stdout bytes.Buffer
+			app.Init = func(_ []string, _ io.Reader) (*global.Data, error) {
+				opts := testutil.MockGlobalData(testcase.Args, &stdout)
+				opts.APIClientFactory = mock.APIClient(testcase.API)
+				return opts, nil
+			}
+			err := app.Run(testcase.Args, nil)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.WantOutput)
+			for _, s := range testcase.WantOutputs {
+				testutil.AssertStringContains(t, stdout.String(), s)
+			}
+			if testcase.DontWantOutput != "" {
+				testutil.AssertStringDoesntContain(t, stdout.String(), testcase.DontWantOutput)
+			}
+			if testcase.WantError != "" && strings.Contains(testcase.Name, "reverted") {
+				// The domain created before the failure should have been deleted.
+				if len(deletedDomains) != 1 || deletedDomains[0] != "api.example.com" {
+					t.Fatalf("want created domain to be reverted, got deletes: %v", deletedDomains)
+				}
+			}
+		})
+	}
+}
+
+func TestServiceApplyDirectorsAndPools(t *testing.T) {
+	dir := t.TempDir()
+	document := filepath.Join(dir, "service.toml")
+	if err := os.WriteFile(document, []byte(applyDirectorsAndPoolsDocument), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	var calls []string
+	record := func(format string, args ...any) {
+		calls = append(calls, fmt.Sprintf(format, args...))
+	}
+	api := testutil.EmptyLists(mock.API{
+		ListVersionsFn: testutil.ListVersions,
+		ListBackendsFn: func(i *fastly.ListBackendsInput) ([]*fastly.Backend, error) {
+			return []*fastly.Backend{
+				{ServiceID: i.ServiceID, ServiceVersion: i.ServiceVersion, Name: "a"},
+				{ServiceID: i.ServiceID, ServiceVersion: i.ServiceVersion, Name: "b"},
+			}, nil
+		},
+		ListDirectorsFn: func(i *fastly.ListDirectorsInput) ([]*fastly.Director, error) {
+			return []*fastly.Director{
+				{ServiceID: i.ServiceID, ServiceVersion: i.ServiceVersion, Name: "lb", Backends: []string{"b", "a"}, Quorum: 75, Type: fastly.DirectorTypeRandom},
+			}, nil
+		},
+		GetDirectorFn: func(i *fastly.GetDirectorInput) (*fastly.Director, error) {
+			return &fastly.Director{Name: i.Name, Backends: []string{"a", "b"}}, nil
+		},
+		CreateDirectorBackendFn: func(i *fastly.CreateDirectorBackendInput) (*fastly.DirectorBackend, error) {
+			record("add backend %s to %s", i.Backend, i.Director)
+			return &fastly.DirectorBackend{}, nil
+		},
+		DeleteDirectorBackendFn: func(i *fastly.DeleteDirectorBackendInput) error {
+			record("remove backend %s from %s", i.Backend, i.Director)
+			return nil
+		},
+		ListPoolsFn: func(i *fastly.ListPoolsInput) ([]*fastly.Pool, error) {
+			return []*fastly.Pool{
+				{ServiceID: i.ServiceID, ServiceVersion: i.ServiceVersion, ID: "pool-1", Name: "servers"},
+			}, nil
+		},
+		GetPoolFn: func(i *fastly.GetPoolInput) (*fastly.Pool, error) {
+			return &fastly.Pool{ID: "pool-1", Name: i.Name}, nil
+		},
+		ListServersFn: func(i *fastly.ListServersInput) ([]*fastly.Server, error) {
+			if i.PoolID != "pool-1" {
+				return nil, errTest
+			}
+			return []*fastly.Server{
+				{ID: "s-1", PoolID: i.PoolID, ServiceID: i.ServiceID, Address: "10.0.0.1", Weight: 100},
+				{ID: "s-2", PoolID: i.PoolID, ServiceID: i.ServiceID, Address: "10.0.0.2", Weight: 100},
+			}, nil
+		},
+		CreateServerFn: func(i *fastly.CreateServerInput) (*fastly.Server, error) {
+			record("add server %s to %s", *i.Address, i.PoolID)
+			return &fastly.Server{}, nil
+		},
+		UpdateServerFn: func(i *fastly.UpdateServerInput) (*fastly.Server, error) {
+			record("update server %s of %s (weight %d)", i.Server, i.PoolID, *i.Weight)
+			return &fastly.Server{}, nil
+		},
+		DeleteServerFn: func(i *fastly.DeleteServerInput) error {
+			record("remove server %s from %s", i.Server, i.PoolID)
+			return nil
+		},
+	})
+
+	args := testutil.Args
+	scenarios := []struct {
+		name       string
+		args       []string
+		wantOutput []string
+		wantCalls  []string
+	}{
+		{
+			name: "export",
+			args: args("service export --service-id 123 --version 3"),
+			wantOutput: []string{
+				"[[director]]\n  backends = [\"a\", \"b\"]\n  name = \"lb\"\n  quorum = 75\n  type = 1",
+				"[[pool]]\n  name = \"servers\"\n\n  [[pool.servers]]\n    address = \"10.0.0.1\"\n    weight = 100",
+			},
+		},
+		{
+			name: "apply",
+			args: args("service apply --service-id 123 --version 3 --auto-yes --file " + document),
+			wantOutput: []string{
+				`~ director "lb"`,
+				`~ pool "servers"`,
+				"Applied 2 changes to service 123 version 3",
+			},
+			wantCalls: []string{
+				"add backend c to lb",
+				"remove backend b from lb",
+				"update server s-2 of pool-1 (weight 50)",
+				"add server 10.0.0.3 to pool-1",
+				"remove server s-1 from pool-1",
+			},
+		},
+	}
+
+	for _, testcase := range scenarios {
+		testcase := testcase
+		t.Run(testcase.name, func(t *testing.T) {
+			calls = nil
+			var stdout bytes.Buffer
+			app.Init = func(_ []string, _ io.Reader) (*global.Data, error) {
+				opts := testutil.MockGlobalData(testcase.args, &stdout)
+				opts.APIClientFactory = mock.APIClient(api)
+				return opts, nil
+			}
+			err := app.Run(testcase.args, nil)
+			testutil.AssertNoError(t, err)
+			for _, s := range testcase.wantOutput {
+				testutil.AssertStringContains(t, stdout.String(), s)
+			}
+			if !reflect.DeepEqual(calls, testcase.wantCalls) {
+				t.Errorf("want calls %q, got %q", testcase.wantCalls, calls)
+			}
+		})
+	}
+}
+
 var errTest = errors.New("fixture error")
 
 func createServiceOK(i *fastly.CreateServiceInput) (*fastly.Service, error) {
@@ -774,3 +1127,102 @@ func deleteServiceOK(*fastly.DeleteServiceInput) error {
 func deleteServiceError(*fastly.DeleteServiceInput) error {
 	return errTest
 }
+
+func listBackendsOK(i *fastly.ListBackendsInput) ([]*fastly.Backend, error) {
+	return []*fastly.Backend{
+		{
+			ServiceID:      i.ServiceID,
+			ServiceVersion: i.ServiceVersion,
+			Name:           "origin",
+			Address:        "example.com",
+			Port:           443,
+			UseSSL:         true,
+			CreatedAt:      testutil.MustParseTimeRFC3339("2001-02-03T04:05:06Z"),
+		},
+	}, nil
+}
+
+func listDomainsOK(i *fastly.ListDomainsInput) ([]*fastly.Domain, error) {
+	return []*fastly.Domain{
+		{
+			ServiceID:      i.ServiceID,
+			ServiceVersion: i.ServiceVersion,
+			Name:           "www.example.com",
+			Comment:        "primary",
+		},
+	}, nil
+}
+
+var exportServiceTOMLOutput = `service_id = "123"
+version = 1
+
+[[backend]]
+  address = "example.com"
+  name = "origin"
+  port = 443
+  use_ssl = true
+
+[[domain]]
+  comment = "primary"
+  name = "www.example.com"
+`
+
+var exportServiceJSONOutput = `{
+  "backend": [
+    {
+      "address": "example.com",
+      "name": "origin",
+      "port": 443,
+      "use_ssl": true
+    }
+  ],
+  "domain": [
+    {
+      "comment": "primary",
+      "name": "www.example.com"
+    }
+  ],
+  "service_id": "123",
+  "version": 1
+}
+`
+
+var applyServiceDocument = `service_id = "123"
+version = 1
+
+[[backend]]
+  address = "example.com"
+  name = "origin"
+  port = 8080
+  use_ssl = true
+
+[[domain]]
+  name = "api.example.com"
+`
+
+var applyDirectorsAndPoolsDocument = `service_id = "123"
+version = 3
+
+[[backend]]
+  name = "a"
+
+[[backend]]
+  name = "b"
+
+[[director]]
+  backends = ["a", "c"]
+  name = "lb"
+  quorum = 75
+  type = 1
+
+[[pool]]
+  name = "servers"
+
+  [[pool.servers]]
+    address = "10.0.0.2"
+    weight = 50
+
+  [[pool.servers]]
+    address = "10.0.0.3"
+    weight = 100
+`
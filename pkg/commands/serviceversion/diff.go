@@ -0,0 +1,145 @@
+package serviceversion
+
+import (
+	"io"
+
+	"github.com/fastly/cli/pkg/argparser"
+	fsterr "github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/global"
+	"github.com/fastly/cli/pkg/serviceconfig"
+	"github.com/fastly/cli/pkg/text"
+)
+
+// DiffCommand calls the Fastly API to compare the configuration of two
+// service versions.
+type DiffCommand struct {
+	argparser.Base
+	argparser.JSONOutput
+
+	against        argparser.OptionalServiceVersion
+	serviceName    argparser.OptionalServiceNameID
+	serviceVersion argparser.OptionalServiceVersion
+}
+
+// NewDiffCommand returns a usable command registered under the parent.
+func NewDiffCommand(parent argparser.Registerer, g *global.Data) *DiffCommand {
+	c := DiffCommand{
+		Base: argparser.Base{
+			Globals: g,
+		},
+	}
+	c.CmdClause = parent.Command("diff", "Compare the configuration of two Fastly service versions")
+
+	// Required.
+	c.RegisterFlag(argparser.StringFlagOpts{
+		Name:        argparser.FlagVersionName,
+		Description: argparser.FlagVersionDesc,
+		Dst:         &c.serviceVersion.Value,
+		Required:    true,
+	})
+	c.RegisterFlag(argparser.StringFlagOpts{
+		Name:        "against",
+		Description: "The service version to compare against (" + argparser.FlagVersionDesc + ")",
+		Dst:         &c.against.Value,
+		Required:    true,
+	})
+
+	// Optional.
+	c.RegisterFlagBool(c.JSONFlag()) // --json
+	c.RegisterFlag(argparser.StringFlagOpts{
+		Name:        argparser.FlagServiceIDName,
+		Description: argparser.FlagServiceIDDesc,
+		Dst:         &g.Manifest.Flag.ServiceID,
+		Short:       's',
+	})
+	c.RegisterFlag(argparser.StringFlagOpts{
+		Action:      c.serviceName.Set,
+		Name:        argparser.FlagServiceName,
+		Description: argparser.FlagServiceDesc,
+		Dst:         &c.serviceName.Value,
+	})
+	return &c
+}
+
+// DiffResult is the JSON representation of a comparison.
+type DiffResult struct {
+	ServiceID string                 `json:"service_id"`
+	Version   int                    `json:"version"`
+	Against   int                    `json:"against"`
+	Changes   []serviceconfig.Change `json:"changes"`
+}
+
+// Exec invokes the application logic for the command.
+func (c *DiffCommand) Exec(_ io.Reader, out io.Writer) error {
+	if c.Globals.Verbose() && c.JSONOutput.Enabled {
+		return fsterr.ErrInvalidVerboseJSONCombo
+	}
+
+	serviceID, serviceVersion, err := argparser.ServiceDetails(argparser.ServiceDetailsOpts{
+		AllowActiveLocked:  true,
+		APIClient:          c.Globals.APIClient,
+		Manifest:           *c.Globals.Manifest,
+		Out:                out,
+		ServiceNameFlag:    c.serviceName,
+		ServiceVersionFlag: c.serviceVersion,
+		VerboseMode:        c.Globals.Flags.Verbose,
+	})
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]any{
+			"Service ID":      serviceID,
+			"Service Version": fsterr.ServiceVersion(serviceVersion),
+		})
+		return err
+	}
+
+	againstVersion, err := c.against.Parse(serviceID, c.Globals.APIClient)
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]any{
+			"Service ID": serviceID,
+			"Against":    c.against.Value,
+		})
+		return err
+	}
+
+	// The version being reviewed is compared against the base version, so
+	// the changes describe what the version adds, changes and removes.
+	base, err := serviceconfig.Fetch(c.Globals.APIClient, serviceID, againstVersion.Number)
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]any{
+			"Service ID":      serviceID,
+			"Service Version": againstVersion.Number,
+		})
+		return err
+	}
+	target, err := serviceconfig.Fetch(c.Globals.APIClient, serviceID, serviceVersion.Number)
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]any{
+			"Service ID":      serviceID,
+			"Service Version": serviceVersion.Number,
+		})
+		return err
+	}
+
+	changes := serviceconfig.Diff(base, target)
+
+	if ok, err := c.WriteJSON(out, DiffResult{
+		ServiceID: serviceID,
+		Version:   serviceVersion.Number,
+		Against:   againstVersion.Number,
+		Changes:   append([]serviceconfig.Change{}, changes...),
+	}); ok {
+		return err
+	}
+
+	if len(changes) == 0 {
+		text.Info(out, "No differences between service %s version %d and version %d.", serviceID, serviceVersion.Number, againstVersion.Number)
+		return nil
+	}
+
+	text.Output(out, "Comparing service %s version %d against version %d:", serviceID, serviceVersion.Number, againstVersion.Number)
+	text.Break(out)
+	serviceconfig.PrintChanges(out, changes)
+	added, changed, removed := serviceconfig.Summary(changes)
+	text.Output(out, "%d added, %d changed, %d removed.", added, changed, removed)
+	return nil
+}
@@ -322,6 +322,67 @@ func TestVersionLock(t *testing.T) {
 	}
 }
 
+func TestVersionDiff(t *testing.T) {
+	args := testutil.Args
+	scenarios := []struct {
+		args       []string
+		api        mock.API
+		wantError  string
+		wantOutput string
+	}{
+		{
+			args:      args("service-version diff --service-id 123 --version 3"),
+			wantError: "error parsing arguments: required flag --against not provided",
+		},
+		{
+			args: args("service-version diff --service-id 123 --version 3 --against 42"),
+			api: testutil.EmptyLists(mock.API{
+				ListVersionsFn: testutil.ListVersions,
+			}),
+			wantError: "specified service version not found: 42",
+		},
+		{
+			args: args("service-version diff --service-id 123 --version 3 --against active"),
+			api: testutil.EmptyLists(mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				ListBackendsFn: listBackendsPerVersion,
+			}),
+			wantOutput: diffVersionsOutput,
+		},
+		{
+			args: args("service-version diff --service-id 123 --version 1 --against 1"),
+			api: testutil.EmptyLists(mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				ListBackendsFn: listBackendsPerVersion,
+			}),
+			wantOutput: "No differences between service 123 version 1 and version 1.",
+		},
+		{
+			args: args("service-version diff --service-id 123 --version 3 --against 1 --json"),
+			api: testutil.EmptyLists(mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				ListBackendsFn: listBackendsPerVersion,
+			}),
+			wantOutput: diffVersionsJSONOutput,
+		},
+	}
+
+	for testcaseIdx := range scenarios {
+		testcase := &scenarios[testcaseIdx]
+		t.Run(strings.Join(testcase.args, " "), func(t *testing.T) {
+			var stdout bytes.Buffer
+			app.Init = func(_ []string, _ io.Reader) (*global.Data, error) {
+				opts := testutil.MockGlobalData(testcase.args, &stdout)
+				opts.APIClientFactory = mock.APIClient(testcase.api)
+				return opts, nil
+			}
+			err := app.Run(testcase.args, nil)
+			testutil.AssertErrorContains(t, err, testcase.wantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.wantOutput)
+		})
+	}
+}
+
 var listVersionsShortOutput = strings.TrimSpace(`
 NUMBER  ACTIVE  LAST EDITED (UTC)
 1       true    2000-01-01 01:00
@@ -425,3 +486,108 @@ func lockVersionOK(i *fastly.LockVersionInput) (*fastly.Version, error) {
 func lockVersionError(_ *fastly.LockVersionInput) (*fastly.Version, error) {
 	return nil, testutil.Err
 }
+
+func listBackendsPerVersion(i *fastly.ListBackendsInput) ([]*fastly.Backend, error) {
+	bs := []*fastly.Backend{
+		{ServiceID: i.ServiceID, ServiceVersion: i.ServiceVersion, Name: "origin", Address: "example.com", Port: 443},
+		{ServiceID: i.ServiceID, ServiceVersion: i.ServiceVersion, Name: "legacy", Address: "legacy.example.com"},
+	}
+	if i.ServiceVersion == 3 {
+		bs = []*fastly.Backend{
+			{ServiceID: i.ServiceID, ServiceVersion: i.ServiceVersion, Name: "origin", Address: "example.com", Port: 8080, UseSSL: true},
+			{ServiceID: i.ServiceID, ServiceVersion: i.ServiceVersion, Name: "static", Address: "static.example.com"},
+		}
+	}
+	return bs, nil
+}
+
+var diffVersionsOutput = `Comparing service 123 version 3 against version 1:
+
+- backend "legacy"
+    - address = "legacy.example.com"
+    - name = "legacy"
+
+~ backend "origin"
+    ~ port = 443 -> 8080
+    ~ use_ssl = (unset) -> true
+
++ backend "static"
+    + address = "static.example.com"
+    + name = "static"
+
+1 added, 1 changed, 1 removed.
+`
+
+var diffVersionsJSONOutput = `{
+  "service_id": "123",
+  "version": 3,
+  "against": 1,
+  "changes": [
+    {
+      "family": "backend",
+      "name": "legacy",
+      "action": "delete",
+      "before": {
+        "address": "legacy.example.com",
+        "name": "legacy"
+      },
+      "fields": [
+        {
+          "field": "address",
+          "before": "legacy.example.com"
+        },
+        {
+          "field": "name",
+          "before": "legacy"
+        }
+      ]
+    },
+    {
+      "family": "backend",
+      "name": "origin",
+      "action": "update",
+      "before": {
+        "address": "example.com",
+        "name": "origin",
+        "port": 443
+      },
+      "after": {
+        "address": "example.com",
+        "name": "origin",
+        "port": 8080,
+        "use_ssl": true
+      },
+      "fields": [
+        {
+          "field": "port",
+          "before": 443,
+          "after": 8080
+        },
+        {
+          "field": "use_ssl",
+          "after": true
+        }
+      ]
+    },
+    {
+      "family": "backend",
+      "name": "static",
+      "action": "create",
+      "after": {
+        "address": "static.example.com",
+        "name": "static"
+      },
+      "fields": [
+        {
+          "field": "address",
+          "after": "static.example.com"
+        },
+        {
+          "field": "name",
+          "after": "static"
+        }
+      ]
+    }
+  ]
+}
+`
@@ -19,6 +19,7 @@ import (
 // It should be installed under the primary root command.
 type RootCommand struct {
 	argparser.Base
+	device  bool
 	profile string
 
 	// IMPORTANT: The following fields are public to the `profile` subcommands.
@@ -41,6 +42,7 @@ func NewRootCommand(parent argparser.Registerer, g *global.Data) *RootCommand {
 	c.Globals = g
 	// FIXME: Unhide this command once SSO is GA.
 	c.CmdClause = parent.Command("sso", "Single Sign-On authentication").Hidden()
+	c.CmdClause.Flag("device", "Authenticate using the OAuth device authorization flow, which doesn't require a browser on this machine (see also: FASTLY_SSO_DEVICE)").BoolVar(&c.device)
 	c.CmdClause.Arg("profile", "Profile to authenticate (i.e. create/update a token for)").Short('p').StringVar(&c.profile)
 	return &c
 }
@@ -55,7 +57,7 @@ func (c *RootCommand) Exec(in io.Reader, out io.Writer) error {
 	if !c.Globals.SkipAuthPrompt && !c.Globals.Flags.AutoYes && !c.Globals.Flags.NonInteractive {
 		profileName, _ := c.identifyProfileAndFlow()
 		msg := fmt.Sprintf("We're going to authenticate the '%s' profile", profileName)
-		text.Important(out, "%s. We need to open your browser to authenticate you.", msg)
+		text.Important(out, "%s. %s", msg, c.PromptMessage())
 		text.Break(out)
 		cont, err := text.AskYesNo(out, text.BoldYellow("Do you want to continue? [y/N]: "), in)
 		text.Break(out)
@@ -70,6 +72,60 @@ func (c *RootCommand) Exec(in io.Reader, out io.Writer) error {
 		}
 	}
 
+	var (
+		ar  auth.AuthorizationResult
+		err error
+	)
+	if c.DeviceFlow() {
+		ar, err = c.deviceAuthorization(out)
+	} else {
+		ar, err = c.browserAuthorization(out)
+	}
+	if err != nil {
+		return err
+	}
+	if ar.Err != nil || ar.SessionToken == "" {
+		err := ar.Err
+		if ar.Err == nil {
+			err = errors.New("no session token")
+		}
+		return fsterr.RemediationError{
+			Inner:       fmt.Errorf("failed to authorize: %w", err),
+			Remediation: auth.Remediation,
+		}
+	}
+
+	err = c.processProfiles(ar)
+	if err != nil {
+		c.Globals.ErrLog.Add(err)
+		return fmt.Errorf("failed to process profile data: %w", err)
+	}
+
+	textFn := text.Success
+	if c.InvokedFromProfileCreate || c.InvokedFromProfileUpdate {
+		textFn = text.Info
+	}
+	textFn(out, "Session token (persisted to your local configuration): %s", ar.SessionToken)
+	return nil
+}
+
+// DeviceFlow indicates if the OAuth device authorization flow should be used
+// instead of opening a browser and running a local server.
+func (c *RootCommand) DeviceFlow() bool {
+	return c.device || c.Globals.Env.SSODevice == "1"
+}
+
+// PromptMessage describes how the user will be authenticated.
+func (c *RootCommand) PromptMessage() string {
+	if c.DeviceFlow() {
+		return "You'll need to open a URL in a web browser (on any device) to authenticate you."
+	}
+	return "We need to open your browser to authenticate you."
+}
+
+// browserAuthorization opens the user's browser and waits for the local server
+// to receive the result of the authorization.
+func (c *RootCommand) browserAuthorization(out io.Writer) (auth.AuthorizationResult, error) {
 	var serverErr error
 	go func() {
 		err := c.Globals.AuthServer.Start()
@@ -78,14 +134,14 @@ func (c *RootCommand) Exec(in io.Reader, out io.Writer) error {
 		}
 	}()
 	if serverErr != nil {
-		return serverErr
+		return auth.AuthorizationResult{}, serverErr
 	}
 
 	text.Info(out, "Starting a local server to handle the authentication flow.")
 
 	authorizationURL, err := c.Globals.AuthServer.AuthURL()
 	if err != nil {
-		return fsterr.RemediationError{
+		return auth.AuthorizationResult{}, fsterr.RemediationError{
 			Inner:       fmt.Errorf("failed to generate an authorization URL: %w", err),
 			Remediation: auth.Remediation,
 		}
@@ -96,33 +152,56 @@ func (c *RootCommand) Exec(in io.Reader, out io.Writer) error {
 
 	err = c.Globals.Opener(authorizationURL)
 	if err != nil {
-		return fmt.Errorf("failed to open your default browser: %w", err)
+		return auth.AuthorizationResult{}, fsterr.RemediationError{
+			Inner:       fmt.Errorf("failed to open your default browser: %w", err),
+			Remediation: "Run `fastly sso --device` (or set FASTLY_SSO_DEVICE=1) to authenticate without a local browser.",
+		}
 	}
 
-	ar := <-c.Globals.AuthServer.GetResult()
-	if ar.Err != nil || ar.SessionToken == "" {
-		err := ar.Err
-		if ar.Err == nil {
-			err = errors.New("no session token")
-		}
-		return fsterr.RemediationError{
-			Inner:       fmt.Errorf("failed to authorize: %w", err),
+	return <-c.Globals.AuthServer.GetResult(), nil
+}
+
+// deviceAuthorization displays a verification URL and user code, then polls
+// the authorization server until the user has authorized the CLI.
+func (c *RootCommand) deviceAuthorization(out io.Writer) (auth.AuthorizationResult, error) {
+	dc, err := c.Globals.AuthServer.DeviceAuthorization()
+	if err != nil {
+		return auth.AuthorizationResult{}, fsterr.RemediationError{
+			Inner:       fmt.Errorf("failed to start the device authorization flow: %w", err),
 			Remediation: auth.Remediation,
 		}
 	}
 
-	err = c.processProfiles(ar)
+	text.Break(out)
+	text.Description(out, "To authenticate with Fastly, open the following URL in a web browser (on any device)", dc.VerificationURI)
+	text.Description(out, "And enter the code", dc.UserCode)
+	if dc.VerificationURIComplete != "" {
+		text.Description(out, "Alternatively, open the following URL which includes the code", dc.VerificationURIComplete)
+	}
+	if dc.ExpiresIn > 0 {
+		text.Info(out, "Waiting for authorization (the code expires in %s).", time.Duration(dc.ExpiresIn)*time.Second)
+	} else {
+		text.Info(out, "Waiting for authorization.")
+	}
+
+	j, err := c.Globals.AuthServer.PollDeviceToken(dc)
 	if err != nil {
-		c.Globals.ErrLog.Add(err)
-		return fmt.Errorf("failed to process profile data: %w", err)
+		return auth.AuthorizationResult{Err: err}, nil
+	}
+	if j.AccessToken == "" {
+		return auth.AuthorizationResult{Err: errors.New("no access token")}, nil
 	}
 
-	textFn := text.Success
-	if c.InvokedFromProfileCreate || c.InvokedFromProfileUpdate {
-		textFn = text.Info
+	email, at, err := c.Globals.AuthServer.ValidateAndRetrieveAPIToken(j.AccessToken)
+	if err != nil {
+		return auth.AuthorizationResult{Err: err}, nil
 	}
-	textFn(out, "Session token (persisted to your local configuration): %s", ar.SessionToken)
-	return nil
+
+	return auth.AuthorizationResult{
+		Email:        email,
+		Jwt:          j,
+		SessionToken: at.AccessToken,
+	}, nil
 }
 
 // ProfileFlow enumerates which profile flow to take.
@@ -150,6 +229,8 @@ func (c *RootCommand) identifyProfileAndFlow() (profileName string, flow Profile
 		profileOverride = c.Globals.Flags.Profile
 	case c.Globals.Manifest.File.Profile != "":
 		profileOverride = c.Globals.Manifest.File.Profile
+	case c.Globals.Manifest.Project.Profile != "":
+		profileOverride = c.Globals.Manifest.Project.Profile
 	}
 
 	currentDefaultProfile, _ := profile.Default(c.Globals.Config.Profiles)
@@ -24,6 +24,7 @@ func TestSSO(t *testing.T) {
 		testutil.TestScenario
 
 		AuthResult            *auth.AuthorizationResult
+		AuthServer            *testutil.MockAuthServer
 		ConfigFile            *config.File
 		ExpectedConfigProfile *config.Profile
 		HTTPClient            api.HTTPClient
@@ -129,11 +130,77 @@ func TestSSO(t *testing.T) {
 				"Y", // when prompted to open a web browser to start authentication
 			},
 		},
+		// 6. Success processing OAuth device authorization flow
+		{
+			TestScenario: testutil.TestScenario{
+				Args: args("sso user --device"),
+				WantOutputs: []string{
+					"You'll need to open a URL in a web browser (on any device) to authenticate you.",
+					"https://example.com/device",
+					"ABCD-EFGH",
+					"https://example.com/device?user_code=ABCD-EFGH",
+					"Waiting for authorization (the code expires in 10m0s).",
+					"Session token (persisted to your local configuration): 456",
+				},
+				DontWantOutputs: []string{
+					"Starting a local server",
+				},
+			},
+			AuthServer: &testutil.MockAuthServer{
+				DeviceCode: auth.DeviceCode{
+					DeviceCode:              "device-code",
+					ExpiresIn:               600,
+					UserCode:                "ABCD-EFGH",
+					VerificationURI:         "https://example.com/device",
+					VerificationURIComplete: "https://example.com/device?user_code=ABCD-EFGH",
+				},
+				Email:        "test@example.com",
+				JWT:          auth.JWT{AccessToken: "access-token", ExpiresIn: 300},
+				SessionToken: "456",
+			},
+			ExpectedConfigProfile: &config.Profile{
+				Token: "456",
+			},
+			Stdin: []string{
+				"Y", // when prompted to authenticate
+			},
+		},
+		// 7. Error starting OAuth device authorization flow
+		{
+			TestScenario: testutil.TestScenario{
+				Args:      args("sso --device"),
+				WantError: "failed to start the device authorization flow: test error",
+			},
+			AuthServer: &testutil.MockAuthServer{
+				DeviceErr: testutil.Err,
+			},
+			Stdin: []string{
+				"Y", // when prompted to authenticate
+			},
+		},
+		// 8. Error polling OAuth device authorization flow (user denied access)
+		{
+			TestScenario: testutil.TestScenario{
+				Args:      args("sso --device"),
+				WantError: "failed to authorize: the authorization request was denied",
+			},
+			AuthServer: &testutil.MockAuthServer{
+				DeviceCode: auth.DeviceCode{
+					DeviceCode:      "device-code",
+					UserCode:        "ABCD-EFGH",
+					VerificationURI: "https://example.com/device",
+				},
+				PollErr: errors.New("the authorization request was denied"),
+			},
+			Stdin: []string{
+				"Y", // when prompted to authenticate
+			},
+		},
 		// NOTE: The following tests indirectly validate our `app.Run()` logic.
 		// Specifically the processing of the token before invoking the subcommand.
 		// It allows us to check that the `sso` command is invoked when expected.
 		//
-		// 6. Success processing `whoami` command.
+		// 9. Success processing `whoami` command.
 		// We configure a non-SSO token so we can validate the INFO message.
 		// Otherwise no OAuth flow is happening here.
 		{
@@ -159,7 +226,7 @@ func TestSSO(t *testing.T) {
 			},
 			HTTPClient: testutil.WhoamiVerifyClient(testutil.WhoamiBasicResponse),
 		},
-		// 7. Success processing `whoami` command.
+		// 10. Success processing `whoami` command.
 		// We set an SSO token that has expired.
 		// This allows us to validate the output message about expiration.
 		// We don't respond "Y" to the prompt for reauthentication.
@@ -185,7 +252,7 @@ func TestSSO(t *testing.T) {
 			},
 			HTTPClient: testutil.WhoamiVerifyClient(testutil.WhoamiBasicResponse),
 		},
-		// 8. Success processing OAuth flow via `whoami` command
+		// 11. Success processing OAuth flow via `whoami` command
 		// We set an SSO token that has expired.
 		// This allows us to validate the output messages.
 		{
@@ -237,6 +304,9 @@ func TestSSO(t *testing.T) {
 			if testcase.Opener != nil {
 				opts.Opener = testcase.Opener
 			}
+			if testcase.AuthServer != nil {
+				opts.AuthServer = *testcase.AuthServer
+			}
 			if testcase.AuthResult != nil {
 				result := make(chan auth.AuthorizationResult)
 				opts.AuthServer = testutil.MockAuthServer{
@@ -0,0 +1,386 @@
+package cachesetting_test
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/fastly/go-fastly/v8/fastly"
+
+	"github.com/fastly/cli/pkg/app"
+	"github.com/fastly/cli/pkg/global"
+	"github.com/fastly/cli/pkg/mock"
+	"github.com/fastly/cli/pkg/testutil"
+)
+
+func TestCacheSettingCreate(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Args:      args("vcl cache-setting create --version 3 --name cache-images"),
+			WantError: "error reading service: no service ID found",
+		},
+		{
+			Args:      args("vcl cache-setting create --service-id 123 --version 3"),
+			WantError: "error parsing arguments: required flag --name not provided",
+		},
+		{
+			Args:      args("vcl cache-setting create --service-id 123 --version 3 --name cache-images --action lookup"),
+			WantError: "error parsing arguments: enum value must be one of cache,pass,restart, got 'lookup'",
+		},
+		{
+			Args: args("vcl cache-setting create --service-id 123 --version 1 --name cache-images --action cache --ttl 3600 --stale-ttl 86400 --cache-condition is_image --autoclone"),
+			API: mock.API{
+				ListVersionsFn:       testutil.ListVersions,
+				CloneVersionFn:       testutil.CloneVersionResult(4),
+				CreateCacheSettingFn: createCacheSettingOK,
+			},
+			WantOutput: "Created cache setting cache-images (service 123 version 4)",
+		},
+		{
+			Args: args("vcl cache-setting create --service-id 123 --version 3 --name cache-images"),
+			API: mock.API{
+				ListVersionsFn:       testutil.ListVersions,
+				CreateCacheSettingFn: createCacheSettingError,
+			},
+			WantError: errTest.Error(),
+		},
+	}
+	for testcaseIdx := range scenarios {
+		testcase := &scenarios[testcaseIdx]
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			app.Init = func(_ []string, _ io.Reader) (*global.Data, error) {
+				opts := testutil.MockGlobalData(testcase.Args, &stdout)
+				opts.APIClientFactory = mock.APIClient(testcase.API)
+				return opts, nil
+			}
+			err := app.Run(testcase.Args, nil)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.WantOutput)
+		})
+	}
+}
+
+func TestCacheSettingDelete(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Args:      args("vcl cache-setting delete --service-id 123 --version 1"),
+			WantError: "error parsing arguments: required flag --name not provided",
+		},
+		{
+			Args: args("vcl cache-setting delete --service-id 123 --version 1 --name cache-images --autoclone"),
+			API: mock.API{
+				ListVersionsFn:       testutil.ListVersions,
+				CloneVersionFn:       testutil.CloneVersionResult(4),
+				DeleteCacheSettingFn: deleteCacheSettingError,
+			},
+			WantError: errTest.Error(),
+		},
+		{
+			Args: args("vcl cache-setting delete --service-id 123 --version 1 --name cache-images --autoclone"),
+			API: mock.API{
+				ListVersionsFn:       testutil.ListVersions,
+				CloneVersionFn:       testutil.CloneVersionResult(4),
+				DeleteCacheSettingFn: deleteCacheSettingOK,
+			},
+			WantOutput: "Deleted cache setting cache-images (service 123 version 4)",
+		},
+	}
+	for testcaseIdx := range scenarios {
+		testcase := &scenarios[testcaseIdx]
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			app.Init = func(_ []string, _ io.Reader) (*global.Data, error) {
+				opts := testutil.MockGlobalData(testcase.Args, &stdout)
+				opts.APIClientFactory = mock.APIClient(testcase.API)
+				return opts, nil
+			}
+			err := app.Run(testcase.Args, nil)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.WantOutput)
+		})
+	}
+}
+
+func TestCacheSettingUpdate(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Args:      args("vcl cache-setting update --service-id 123 --version 1 --new-name cache-images-new"),
+			WantError: "error parsing arguments: required flag --name not provided",
+		},
+		{
+			Args:      args("vcl cache-setting update --service-id 123 --version 1 --name cache-images --autoclone"),
+			WantError: "error parsing arguments: must provide either --new-name, --action, --cache-condition, --stale-ttl or --ttl to update cache setting",
+		},
+		{
+			Args: args("vcl cache-setting update --service-id 123 --version 1 --name cache-images --new-name cache-images-new --autoclone"),
+			API: mock.API{
+				ListVersionsFn:       testutil.ListVersions,
+				CloneVersionFn:       testutil.CloneVersionResult(4),
+				UpdateCacheSettingFn: updateCacheSettingError,
+			},
+			WantError: errTest.Error(),
+		},
+		{
+			Args: args("vcl cache-setting update --service-id 123 --version 1 --name cache-images --new-name cache-images-new --autoclone"),
+			API: mock.API{
+				ListVersionsFn:       testutil.ListVersions,
+				CloneVersionFn:       testutil.CloneVersionResult(4),
+				UpdateCacheSettingFn: updateCacheSettingOK,
+			},
+			WantOutput: "Updated cache setting cache-images-new (service 123 version 4)",
+		},
+	}
+	for testcaseIdx := range scenarios {
+		testcase := &scenarios[testcaseIdx]
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			app.Init = func(_ []string, _ io.Reader) (*global.Data, error) {
+				opts := testutil.MockGlobalData(testcase.Args, &stdout)
+				opts.APIClientFactory = mock.APIClient(testcase.API)
+				return opts, nil
+			}
+			err := app.Run(testcase.Args, nil)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.WantOutput)
+		})
+	}
+}
+
+func TestCacheSettingDescribe(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Args:      args("vcl cache-setting describe --service-id 123 --version 1"),
+			WantError: "error parsing arguments: required flag --name not provided",
+		},
+		{
+			Args: args("vcl cache-setting describe --service-id 123 --version 1 --name cache-images"),
+			API: mock.API{
+				ListVersionsFn:    testutil.ListVersions,
+				GetCacheSettingFn: getCacheSettingError,
+			},
+			WantError: errTest.Error(),
+		},
+		{
+			Args: args("vcl cache-setting describe --service-id 123 --version 1 --name cache-images"),
+			API: mock.API{
+				ListVersionsFn:    testutil.ListVersions,
+				GetCacheSettingFn: getCacheSettingOK,
+			},
+			WantOutput: describeCacheSettingOutput,
+		},
+	}
+	for testcaseIdx := range scenarios {
+		testcase := &scenarios[testcaseIdx]
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			app.Init = func(_ []string, _ io.Reader) (*global.Data, error) {
+				opts := testutil.MockGlobalData(testcase.Args, &stdout)
+				opts.APIClientFactory = mock.APIClient(testcase.API)
+				return opts, nil
+			}
+			err := app.Run(testcase.Args, nil)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertString(t, testcase.WantOutput, stdout.String())
+		})
+	}
+}
+
+func TestCacheSettingList(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Args: args("vcl cache-setting list --service-id 123 --version 1"),
+			API: mock.API{
+				ListVersionsFn:      testutil.ListVersions,
+				ListCacheSettingsFn: listCacheSettingsOK,
+			},
+			WantOutput: listCacheSettingsShortOutput,
+		},
+		{
+			Args: args("vcl cache-setting list --service-id 123 --version 1 --verbose"),
+			API: mock.API{
+				ListVersionsFn:      testutil.ListVersions,
+				ListCacheSettingsFn: listCacheSettingsOK,
+			},
+			WantOutput: listCacheSettingsVerboseOutput,
+		},
+		{
+			Args: args("vcl cache-setting list --service-id 123 --version 1 --json"),
+			API: mock.API{
+				ListVersionsFn:      testutil.ListVersions,
+				ListCacheSettingsFn: listCacheSettingsOK,
+			},
+			WantOutput: listCacheSettingsJSONOutput,
+		},
+		{
+			Args: args("vcl cache-setting list --service-id 123 --version 1"),
+			API: mock.API{
+				ListVersionsFn:      testutil.ListVersions,
+				ListCacheSettingsFn: listCacheSettingsError,
+			},
+			WantError: errTest.Error(),
+		},
+	}
+	for testcaseIdx := range scenarios {
+		testcase := &scenarios[testcaseIdx]
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			app.Init = func(_ []string, _ io.Reader) (*global.Data, error) {
+				opts := testutil.MockGlobalData(testcase.Args, &stdout)
+				opts.APIClientFactory = mock.APIClient(testcase.API)
+				return opts, nil
+			}
+			err := app.Run(testcase.Args, nil)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertString(t, testcase.WantOutput, stdout.String())
+		})
+	}
+}
+
+var describeCacheSettingOutput = "\n" + strings.TrimSpace(`
+Service ID: 123
+Version: 1
+Name: cache-images
+Action: cache
+Cache condition: is_image
+Stale TTL: 86400
+TTL: 3600
+`) + "\n"
+
+var listCacheSettingsShortOutput = strings.TrimSpace(`
+SERVICE  VERSION  NAME          ACTION  TTL   STALE TTL  CACHE CONDITION
+123      1        cache-images  cache   3600  86400      is_image
+123      1        pass-api      pass    0     0          is_api
+`) + "\n"
+
+var listCacheSettingsVerboseOutput = strings.TrimSpace(`
+Fastly API endpoint: https://api.fastly.com
+Fastly API token provided via config file (profile: user)
+
+Service ID (via --service-id): 123
+
+Version: 1
+	Cache setting 1/2
+		Name: cache-images
+		Action: cache
+		Cache condition: is_image
+		Stale TTL: 86400
+		TTL: 3600
+	Cache setting 2/2
+		Name: pass-api
+		Action: pass
+		Cache condition: is_api
+		Stale TTL: 0
+		TTL: 0
+`) + "\n\n"
+
+var listCacheSettingsJSONOutput = strings.TrimSpace(`
+[
+  {
+    "Action": "cache",
+    "CacheCondition": "is_image",
+    "CreatedAt": null,
+    "DeletedAt": null,
+    "Name": "cache-images",
+    "ServiceID": "123",
+    "ServiceVersion": 1,
+    "StaleTTL": 86400,
+    "TTL": 3600,
+    "UpdatedAt": null
+  },
+  {
+    "Action": "pass",
+    "CacheCondition": "is_api",
+    "CreatedAt": null,
+    "DeletedAt": null,
+    "Name": "pass-api",
+    "ServiceID": "123",
+    "ServiceVersion": 1,
+    "StaleTTL": 0,
+    "TTL": 0,
+    "UpdatedAt": null
+  }
+]
+`) + "\n"
+
+var errTest = errors.New("fixture error")
+
+func createCacheSettingOK(i *fastly.CreateCacheSettingInput) (*fastly.CacheSetting, error) {
+	return &fastly.CacheSetting{
+		ServiceID:      i.ServiceID,
+		ServiceVersion: i.ServiceVersion,
+		Name:           *i.Name,
+	}, nil
+}
+
+func createCacheSettingError(_ *fastly.CreateCacheSettingInput) (*fastly.CacheSetting, error) {
+	return nil, errTest
+}
+
+func deleteCacheSettingOK(_ *fastly.DeleteCacheSettingInput) error {
+	return nil
+}
+
+func deleteCacheSettingError(_ *fastly.DeleteCacheSettingInput) error {
+	return errTest
+}
+
+func updateCacheSettingOK(i *fastly.UpdateCacheSettingInput) (*fastly.CacheSetting, error) {
+	return &fastly.CacheSetting{
+		ServiceID:      i.ServiceID,
+		ServiceVersion: i.ServiceVersion,
+		Name:           *i.NewName,
+	}, nil
+}
+
+func updateCacheSettingError(_ *fastly.UpdateCacheSettingInput) (*fastly.CacheSetting, error) {
+	return nil, errTest
+}
+
+func getCacheSettingOK(i *fastly.GetCacheSettingInput) (*fastly.CacheSetting, error) {
+	return &fastly.CacheSetting{
+		ServiceID:      i.ServiceID,
+		ServiceVersion: i.ServiceVersion,
+		Name:           i.Name,
+		Action:         "cache",
+		TTL:            3600,
+		StaleTTL:       86400,
+		CacheCondition: "is_image",
+	}, nil
+}
+
+func getCacheSettingError(_ *fastly.GetCacheSettingInput) (*fastly.CacheSetting, error) {
+	return nil, errTest
+}
+
+func listCacheSettingsOK(i *fastly.ListCacheSettingsInput) ([]*fastly.CacheSetting, error) {
+	return []*fastly.CacheSetting{
+		{
+			ServiceID:      i.ServiceID,
+			ServiceVersion: i.ServiceVersion,
+			Name:           "cache-images",
+			Action:         "cache",
+			TTL:            3600,
+			StaleTTL:       86400,
+			CacheCondition: "is_image",
+		},
+		{
+			ServiceID:      i.ServiceID,
+			ServiceVersion: i.ServiceVersion,
+			Name:           "pass-api",
+			Action:         "pass",
+			CacheCondition: "is_api",
+		},
+	}, nil
+}
+
+func listCacheSettingsError(_ *fastly.ListCacheSettingsInput) ([]*fastly.CacheSetting, error) {
+	return nil, errTest
+}
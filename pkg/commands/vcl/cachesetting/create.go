@@ -0,0 +1,126 @@
+package cachesetting
+
+import (
+	"io"
+
+	"github.com/fastly/go-fastly/v8/fastly"
+
+	"github.com/fastly/cli/pkg/argparser"
+	"github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/global"
+	"github.com/fastly/cli/pkg/text"
+)
+
+// CacheSettingActions are the allowed input values for the --action flag.
+// Reference: https://developer.fastly.com/reference/api/vcl-services/cache-settings/
+var CacheSettingActions = []string{"cache", "pass", "restart"}
+
+// CreateCommand calls the Fastly API to create an appropriate resource.
+type CreateCommand struct {
+	argparser.Base
+
+	// Required.
+	name           string
+	serviceVersion argparser.OptionalServiceVersion
+
+	// Optional.
+	action         argparser.OptionalString
+	autoClone      argparser.OptionalAutoClone
+	cacheCondition argparser.OptionalString
+	serviceName    argparser.OptionalServiceNameID
+	staleTTL       argparser.OptionalInt
+	ttl            argparser.OptionalInt
+}
+
+// NewCreateCommand returns a usable command registered under the parent.
+func NewCreateCommand(parent argparser.Registerer, g *global.Data) *CreateCommand {
+	c := CreateCommand{
+		Base: argparser.Base{
+			Globals: g,
+		},
+	}
+	c.CmdClause = parent.Command("create", "Create a cache setting on a Fastly service version").Alias("add")
+
+	// Required.
+	c.CmdClause.Flag("name", "Cache setting name").Short('n').Required().StringVar(&c.name)
+	c.RegisterFlag(argparser.StringFlagOpts{
+		Name:        argparser.FlagVersionName,
+		Description: argparser.FlagVersionDesc,
+		Dst:         &c.serviceVersion.Value,
+		Required:    true,
+	})
+
+	// Optional.
+	c.RegisterAutoCloneFlag(argparser.AutoCloneFlagOpts{
+		Action: c.autoClone.Set,
+		Dst:    &c.autoClone.Value,
+	})
+	c.CmdClause.Flag("action", "Action to take in vcl_fetch").HintOptions(CacheSettingActions...).Action(c.action.Set).EnumVar(&c.action.Value, CacheSettingActions...)
+	c.CmdClause.Flag("cache-condition", "Name of the cache condition controlling when this cache setting applies").Action(c.cacheCondition.Set).StringVar(&c.cacheCondition.Value)
+	c.CmdClause.Flag("stale-ttl", "Maximum time in seconds to serve stale content if the backend fails (stale-if-error)").Action(c.staleTTL.Set).IntVar(&c.staleTTL.Value)
+	c.CmdClause.Flag("ttl", "Maximum time in seconds to consider the object fresh in the cache").Action(c.ttl.Set).IntVar(&c.ttl.Value)
+	c.RegisterFlag(argparser.StringFlagOpts{
+		Name:        argparser.FlagServiceIDName,
+		Description: argparser.FlagServiceIDDesc,
+		Dst:         &g.Manifest.Flag.ServiceID,
+		Short:       's',
+	})
+	c.RegisterFlag(argparser.StringFlagOpts{
+		Action:      c.serviceName.Set,
+		Name:        argparser.FlagServiceName,
+		Description: argparser.FlagServiceDesc,
+		Dst:         &c.serviceName.Value,
+	})
+
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *CreateCommand) Exec(_ io.Reader, out io.Writer) error {
+	serviceID, serviceVersion, err := argparser.ServiceDetails(argparser.ServiceDetailsOpts{
+		AutoCloneFlag:      c.autoClone,
+		APIClient:          c.Globals.APIClient,
+		Manifest:           *c.Globals.Manifest,
+		Out:                out,
+		ServiceNameFlag:    c.serviceName,
+		ServiceVersionFlag: c.serviceVersion,
+		VerboseMode:        c.Globals.Flags.Verbose,
+	})
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]any{
+			"Service ID":      serviceID,
+			"Service Version": errors.ServiceVersion(serviceVersion),
+		})
+		return err
+	}
+
+	input := fastly.CreateCacheSettingInput{
+		Name:           &c.name,
+		ServiceID:      serviceID,
+		ServiceVersion: serviceVersion.Number,
+	}
+	if c.action.WasSet {
+		input.Action = fastly.CacheSettingActionPtr(fastly.CacheSettingAction(c.action.Value))
+	}
+	if c.cacheCondition.WasSet {
+		input.CacheCondition = &c.cacheCondition.Value
+	}
+	if c.staleTTL.WasSet {
+		input.StaleTTL = &c.staleTTL.Value
+	}
+	if c.ttl.WasSet {
+		input.TTL = &c.ttl.Value
+	}
+
+	r, err := c.Globals.APIClient.CreateCacheSetting(&input)
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]any{
+			"Service ID":      serviceID,
+			"Service Version": serviceVersion.Number,
+		})
+		return err
+	}
+
+	text.Success(out, "Created cache setting %s (service %s version %d)", r.Name, r.ServiceID, r.ServiceVersion)
+	return nil
+}
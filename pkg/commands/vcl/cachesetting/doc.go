@@ -0,0 +1,2 @@
+// Package cachesetting contains commands to inspect and manipulate Fastly service cache settings.
+package cachesetting
@@ -0,0 +1,374 @@
+package gzip_test
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/fastly/go-fastly/v8/fastly"
+
+	"github.com/fastly/cli/pkg/app"
+	"github.com/fastly/cli/pkg/global"
+	"github.com/fastly/cli/pkg/mock"
+	"github.com/fastly/cli/pkg/testutil"
+)
+
+func TestGzipCreate(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Args:      args("vcl gzip create --version 3 --name text"),
+			WantError: "error reading service: no service ID found",
+		},
+		{
+			Args:      args("vcl gzip create --service-id 123 --version 3"),
+			WantError: "error parsing arguments: required flag --name not provided",
+		},
+		{
+			Args: args("vcl gzip create --service-id 123 --version 1 --name text --content-types text/html --extensions html --autoclone"),
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				CloneVersionFn: testutil.CloneVersionResult(4),
+				CreateGzipFn:   createGzipOK,
+			},
+			WantOutput: "Created gzip configuration text (service 123 version 4)",
+		},
+		{
+			Args: args("vcl gzip create --service-id 123 --version 3 --name text"),
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				CreateGzipFn:   createGzipError,
+			},
+			WantError: errTest.Error(),
+		},
+	}
+	for testcaseIdx := range scenarios {
+		testcase := &scenarios[testcaseIdx]
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			app.Init = func(_ []string, _ io.Reader) (*global.Data, error) {
+				opts := testutil.MockGlobalData(testcase.Args, &stdout)
+				opts.APIClientFactory = mock.APIClient(testcase.API)
+				return opts, nil
+			}
+			err := app.Run(testcase.Args, nil)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.WantOutput)
+		})
+	}
+}
+
+func TestGzipDelete(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Args:      args("vcl gzip delete --service-id 123 --version 1"),
+			WantError: "error parsing arguments: required flag --name not provided",
+		},
+		{
+			Args: args("vcl gzip delete --service-id 123 --version 1 --name text --autoclone"),
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				CloneVersionFn: testutil.CloneVersionResult(4),
+				DeleteGzipFn:   deleteGzipError,
+			},
+			WantError: errTest.Error(),
+		},
+		{
+			Args: args("vcl gzip delete --service-id 123 --version 1 --name text --autoclone"),
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				CloneVersionFn: testutil.CloneVersionResult(4),
+				DeleteGzipFn:   deleteGzipOK,
+			},
+			WantOutput: "Deleted gzip configuration text (service 123 version 4)",
+		},
+	}
+	for testcaseIdx := range scenarios {
+		testcase := &scenarios[testcaseIdx]
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			app.Init = func(_ []string, _ io.Reader) (*global.Data, error) {
+				opts := testutil.MockGlobalData(testcase.Args, &stdout)
+				opts.APIClientFactory = mock.APIClient(testcase.API)
+				return opts, nil
+			}
+			err := app.Run(testcase.Args, nil)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.WantOutput)
+		})
+	}
+}
+
+func TestGzipUpdate(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Args:      args("vcl gzip update --service-id 123 --version 1 --new-name text-new"),
+			WantError: "error parsing arguments: required flag --name not provided",
+		},
+		{
+			Args:      args("vcl gzip update --service-id 123 --version 1 --name text --autoclone"),
+			WantError: "error parsing arguments: must provide either --new-name, --cache-condition, --content-types or --extensions to update gzip configuration",
+		},
+		{
+			Args: args("vcl gzip update --service-id 123 --version 1 --name text --new-name text-new --autoclone"),
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				CloneVersionFn: testutil.CloneVersionResult(4),
+				UpdateGzipFn:   updateGzipError,
+			},
+			WantError: errTest.Error(),
+		},
+		{
+			Args: args("vcl gzip update --service-id 123 --version 1 --name text --new-name text-new --autoclone"),
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				CloneVersionFn: testutil.CloneVersionResult(4),
+				UpdateGzipFn:   updateGzipOK,
+			},
+			WantOutput: "Updated gzip configuration text-new (service 123 version 4)",
+		},
+	}
+	for testcaseIdx := range scenarios {
+		testcase := &scenarios[testcaseIdx]
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			app.Init = func(_ []string, _ io.Reader) (*global.Data, error) {
+				opts := testutil.MockGlobalData(testcase.Args, &stdout)
+				opts.APIClientFactory = mock.APIClient(testcase.API)
+				return opts, nil
+			}
+			err := app.Run(testcase.Args, nil)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.WantOutput)
+		})
+	}
+}
+
+func TestGzipDescribe(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Args:      args("vcl gzip describe --service-id 123 --version 1"),
+			WantError: "error parsing arguments: required flag --name not provided",
+		},
+		{
+			Args: args("vcl gzip describe --service-id 123 --version 1 --name text"),
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				GetGzipFn:      getGzipError,
+			},
+			WantError: errTest.Error(),
+		},
+		{
+			Args: args("vcl gzip describe --service-id 123 --version 1 --name text"),
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				GetGzipFn:      getGzipOK,
+			},
+			WantOutput: describeGzipOutput,
+		},
+	}
+	for testcaseIdx := range scenarios {
+		testcase := &scenarios[testcaseIdx]
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			app.Init = func(_ []string, _ io.Reader) (*global.Data, error) {
+				opts := testutil.MockGlobalData(testcase.Args, &stdout)
+				opts.APIClientFactory = mock.APIClient(testcase.API)
+				return opts, nil
+			}
+			err := app.Run(testcase.Args, nil)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertString(t, testcase.WantOutput, stdout.String())
+		})
+	}
+}
+
+func TestGzipList(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Args: args("vcl gzip list --service-id 123 --version 1"),
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				ListGzipsFn:    listGzipsOK,
+			},
+			WantOutput: listGzipsShortOutput,
+		},
+		{
+			Args: args("vcl gzip list --service-id 123 --version 1 --verbose"),
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				ListGzipsFn:    listGzipsOK,
+			},
+			WantOutput: listGzipsVerboseOutput,
+		},
+		{
+			Args: args("vcl gzip list --service-id 123 --version 1 --json"),
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				ListGzipsFn:    listGzipsOK,
+			},
+			WantOutput: listGzipsJSONOutput,
+		},
+		{
+			Args: args("vcl gzip list --service-id 123 --version 1"),
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				ListGzipsFn:    listGzipsError,
+			},
+			WantError: errTest.Error(),
+		},
+	}
+	for testcaseIdx := range scenarios {
+		testcase := &scenarios[testcaseIdx]
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			app.Init = func(_ []string, _ io.Reader) (*global.Data, error) {
+				opts := testutil.MockGlobalData(testcase.Args, &stdout)
+				opts.APIClientFactory = mock.APIClient(testcase.API)
+				return opts, nil
+			}
+			err := app.Run(testcase.Args, nil)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertString(t, testcase.WantOutput, stdout.String())
+		})
+	}
+}
+
+var describeGzipOutput = "\n" + strings.TrimSpace(`
+Service ID: 123
+Version: 1
+Name: text
+Cache condition: 
+Content types: text/html text/css
+Extensions: html css
+`) + "\n"
+
+var listGzipsShortOutput = strings.TrimSpace(`
+SERVICE  VERSION  NAME     CONTENT TYPES           EXTENSIONS
+123      1        text     text/html text/css      html css
+123      1        scripts  application/javascript  js
+`) + "\n"
+
+var listGzipsVerboseOutput = strings.TrimSpace(`
+Fastly API endpoint: https://api.fastly.com
+Fastly API token provided via config file (profile: user)
+
+Service ID (via --service-id): 123
+
+Version: 1
+	Gzip 1/2
+		Name: text
+		Cache condition: 
+		Content types: text/html text/css
+		Extensions: html css
+	Gzip 2/2
+		Name: scripts
+		Cache condition: is_script
+		Content types: application/javascript
+		Extensions: js
+`) + "\n\n"
+
+var listGzipsJSONOutput = strings.TrimSpace(`
+[
+  {
+    "CacheCondition": "",
+    "ContentTypes": "text/html text/css",
+    "CreatedAt": null,
+    "DeletedAt": null,
+    "Extensions": "html css",
+    "Name": "text",
+    "ServiceID": "123",
+    "ServiceVersion": 1,
+    "UpdatedAt": null
+  },
+  {
+    "CacheCondition": "is_script",
+    "ContentTypes": "application/javascript",
+    "CreatedAt": null,
+    "DeletedAt": null,
+    "Extensions": "js",
+    "Name": "scripts",
+    "ServiceID": "123",
+    "ServiceVersion": 1,
+    "UpdatedAt": null
+  }
+]
+`) + "\n"
+
+var errTest = errors.New("fixture error")
+
+func createGzipOK(i *fastly.CreateGzipInput) (*fastly.Gzip, error) {
+	return &fastly.Gzip{
+		ServiceID:      i.ServiceID,
+		ServiceVersion: i.ServiceVersion,
+		Name:           *i.Name,
+	}, nil
+}
+
+func createGzipError(_ *fastly.CreateGzipInput) (*fastly.Gzip, error) {
+	return nil, errTest
+}
+
+func deleteGzipOK(_ *fastly.DeleteGzipInput) error {
+	return nil
+}
+
+func deleteGzipError(_ *fastly.DeleteGzipInput) error {
+	return errTest
+}
+
+func updateGzipOK(i *fastly.UpdateGzipInput) (*fastly.Gzip, error) {
+	return &fastly.Gzip{
+		ServiceID:      i.ServiceID,
+		ServiceVersion: i.ServiceVersion,
+		Name:           *i.NewName,
+	}, nil
+}
+
+func updateGzipError(_ *fastly.UpdateGzipInput) (*fastly.Gzip, error) {
+	return nil, errTest
+}
+
+func getGzipOK(i *fastly.GetGzipInput) (*fastly.Gzip, error) {
+	return &fastly.Gzip{
+		ServiceID:      i.ServiceID,
+		ServiceVersion: i.ServiceVersion,
+		Name:           i.Name,
+		ContentTypes:   "text/html text/css",
+		Extensions:     "html css",
+	}, nil
+}
+
+func getGzipError(_ *fastly.GetGzipInput) (*fastly.Gzip, error) {
+	return nil, errTest
+}
+
+func listGzipsOK(i *fastly.ListGzipsInput) ([]*fastly.Gzip, error) {
+	return []*fastly.Gzip{
+		{
+			ServiceID:      i.ServiceID,
+			ServiceVersion: i.ServiceVersion,
+			Name:           "text",
+			ContentTypes:   "text/html text/css",
+			Extensions:     "html css",
+		},
+		{
+			ServiceID:      i.ServiceID,
+			ServiceVersion: i.ServiceVersion,
+			Name:           "scripts",
+			ContentTypes:   "application/javascript",
+			Extensions:     "js",
+			CacheCondition: "is_script",
+		},
+	}, nil
+}
+
+func listGzipsError(_ *fastly.ListGzipsInput) ([]*fastly.Gzip, error) {
+	return nil, errTest
+}
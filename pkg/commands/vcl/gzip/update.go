@@ -0,0 +1,128 @@
+package gzip
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/fastly/go-fastly/v8/fastly"
+
+	"github.com/fastly/cli/pkg/argparser"
+	"github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/global"
+	"github.com/fastly/cli/pkg/text"
+)
+
+// UpdateCommand calls the Fastly API to update an appropriate resource.
+type UpdateCommand struct {
+	argparser.Base
+
+	// Required.
+	name           string
+	serviceVersion argparser.OptionalServiceVersion
+
+	// Optional.
+	autoClone      argparser.OptionalAutoClone
+	cacheCondition argparser.OptionalString
+	contentTypes   argparser.OptionalString
+	extensions     argparser.OptionalString
+	newName        argparser.OptionalString
+	serviceName    argparser.OptionalServiceNameID
+}
+
+// NewUpdateCommand returns a usable command registered under the parent.
+func NewUpdateCommand(parent argparser.Registerer, g *global.Data) *UpdateCommand {
+	c := UpdateCommand{
+		Base: argparser.Base{
+			Globals: g,
+		},
+	}
+	c.CmdClause = parent.Command("update", "Update a gzip configuration on a Fastly service version")
+
+	// Required.
+	c.CmdClause.Flag("name", "Gzip name").Short('n').Required().StringVar(&c.name)
+	c.RegisterFlag(argparser.StringFlagOpts{
+		Name:        argparser.FlagVersionName,
+		Description: argparser.FlagVersionDesc,
+		Dst:         &c.serviceVersion.Value,
+		Required:    true,
+	})
+
+	// Optional.
+	c.RegisterAutoCloneFlag(argparser.AutoCloneFlagOpts{
+		Action: c.autoClone.Set,
+		Dst:    &c.autoClone.Value,
+	})
+	c.CmdClause.Flag("new-name", "New gzip configuration name").Action(c.newName.Set).StringVar(&c.newName.Value)
+	c.CmdClause.Flag("cache-condition", "Name of the cache condition controlling when this gzip configuration applies").Action(c.cacheCondition.Set).StringVar(&c.cacheCondition.Value)
+	c.CmdClause.Flag("content-types", "Space-separated list of content types to compress").Action(c.contentTypes.Set).StringVar(&c.contentTypes.Value)
+	c.CmdClause.Flag("extensions", "Space-separated list of file extensions to compress").Action(c.extensions.Set).StringVar(&c.extensions.Value)
+	c.RegisterFlag(argparser.StringFlagOpts{
+		Name:        argparser.FlagServiceIDName,
+		Description: argparser.FlagServiceIDDesc,
+		Dst:         &g.Manifest.Flag.ServiceID,
+		Short:       's',
+	})
+	c.RegisterFlag(argparser.StringFlagOpts{
+		Action:      c.serviceName.Set,
+		Name:        argparser.FlagServiceName,
+		Description: argparser.FlagServiceDesc,
+		Dst:         &c.serviceName.Value,
+	})
+
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *UpdateCommand) Exec(_ io.Reader, out io.Writer) error {
+	// If no argument are provided, error with useful message.
+	if !c.newName.WasSet && !c.cacheCondition.WasSet && !c.contentTypes.WasSet && !c.extensions.WasSet {
+		return fmt.Errorf("error parsing arguments: must provide either --new-name, --cache-condition, --content-types or --extensions to update gzip configuration")
+	}
+
+	serviceID, serviceVersion, err := argparser.ServiceDetails(argparser.ServiceDetailsOpts{
+		AutoCloneFlag:      c.autoClone,
+		APIClient:          c.Globals.APIClient,
+		Manifest:           *c.Globals.Manifest,
+		Out:                out,
+		ServiceNameFlag:    c.serviceName,
+		ServiceVersionFlag: c.serviceVersion,
+		VerboseMode:        c.Globals.Flags.Verbose,
+	})
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]any{
+			"Service ID":      serviceID,
+			"Service Version": errors.ServiceVersion(serviceVersion),
+		})
+		return err
+	}
+
+	input := fastly.UpdateGzipInput{
+		Name:           c.name,
+		ServiceID:      serviceID,
+		ServiceVersion: serviceVersion.Number,
+	}
+	if c.newName.WasSet {
+		input.NewName = &c.newName.Value
+	}
+	if c.cacheCondition.WasSet {
+		input.CacheCondition = &c.cacheCondition.Value
+	}
+	if c.contentTypes.WasSet {
+		input.ContentTypes = &c.contentTypes.Value
+	}
+	if c.extensions.WasSet {
+		input.Extensions = &c.extensions.Value
+	}
+
+	r, err := c.Globals.APIClient.UpdateGzip(&input)
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]any{
+			"Service ID":      serviceID,
+			"Service Version": serviceVersion.Number,
+		})
+		return err
+	}
+
+	text.Success(out, "Updated gzip configuration %s (service %s version %d)", r.Name, r.ServiceID, r.ServiceVersion)
+	return nil
+}
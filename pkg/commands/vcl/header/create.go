@@ -0,0 +1,165 @@
+package header
+
+import (
+	"io"
+
+	"github.com/fastly/go-fastly/v8/fastly"
+
+	"github.com/fastly/cli/pkg/argparser"
+	"github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/global"
+	"github.com/fastly/cli/pkg/text"
+)
+
+// HeaderActions are the allowed input values for the --action flag.
+// Reference: https://developer.fastly.com/reference/api/vcl-services/header/
+var HeaderActions = []string{"set", "append", "delete", "regex", "regex_repeat"}
+
+// HeaderTypes are the allowed input values for the --type flag.
+// Reference: https://developer.fastly.com/reference/api/vcl-services/header/
+var HeaderTypes = []string{"request", "fetch", "cache", "response"}
+
+// CreateCommand calls the Fastly API to create an appropriate resource.
+type CreateCommand struct {
+	argparser.Base
+
+	// Required.
+	name           string
+	serviceVersion argparser.OptionalServiceVersion
+
+	// Optional.
+	action            argparser.OptionalString
+	autoClone         argparser.OptionalAutoClone
+	cacheCondition    argparser.OptionalString
+	dst               argparser.OptionalString
+	headerType        argparser.OptionalString
+	ignoreIfSet       argparser.OptionalBool
+	priority          argparser.OptionalInt
+	regex             argparser.OptionalString
+	requestCondition  argparser.OptionalString
+	responseCondition argparser.OptionalString
+	serviceName       argparser.OptionalServiceNameID
+	src               argparser.OptionalString
+	substitution      argparser.OptionalString
+}
+
+// NewCreateCommand returns a usable command registered under the parent.
+func NewCreateCommand(parent argparser.Registerer, g *global.Data) *CreateCommand {
+	c := CreateCommand{
+		Base: argparser.Base{
+			Globals: g,
+		},
+	}
+	c.CmdClause = parent.Command("create", "Create a header on a Fastly service version").Alias("add")
+
+	// Required.
+	c.CmdClause.Flag("name", "Header name").Short('n').Required().StringVar(&c.name)
+	c.RegisterFlag(argparser.StringFlagOpts{
+		Name:        argparser.FlagVersionName,
+		Description: argparser.FlagVersionDesc,
+		Dst:         &c.serviceVersion.Value,
+		Required:    true,
+	})
+
+	// Optional.
+	c.RegisterAutoCloneFlag(argparser.AutoCloneFlagOpts{
+		Action: c.autoClone.Set,
+		Dst:    &c.autoClone.Value,
+	})
+	c.CmdClause.Flag("action", "Action to perform on the header").HintOptions(HeaderActions...).Action(c.action.Set).EnumVar(&c.action.Value, HeaderActions...)
+	c.CmdClause.Flag("cache-condition", "Name of the cache condition controlling when this header applies").Action(c.cacheCondition.Set).StringVar(&c.cacheCondition.Value)
+	c.CmdClause.Flag("dst", "Header to set (e.g. http.X-Example)").Action(c.dst.Set).StringVar(&c.dst.Value)
+	c.CmdClause.Flag("ignore-if-set", "Don't add the header if it's already set (only applies to the 'set' action)").Action(c.ignoreIfSet.Set).BoolVar(&c.ignoreIfSet.Value)
+	c.CmdClause.Flag("priority", "Priority determines execution order (lower numbers execute first)").Action(c.priority.Set).IntVar(&c.priority.Value)
+	c.CmdClause.Flag("regex", "Regular expression to use (only applies to the 'regex' and 'regex_repeat' actions)").Action(c.regex.Set).StringVar(&c.regex.Value)
+	c.CmdClause.Flag("request-condition", "Name of the request condition controlling when this header applies").Action(c.requestCondition.Set).StringVar(&c.requestCondition.Value)
+	c.CmdClause.Flag("response-condition", "Name of the response condition controlling when this header applies").Action(c.responseCondition.Set).StringVar(&c.responseCondition.Value)
+	c.CmdClause.Flag("src", "Variable to be used as the source of the header content (doesn't apply to the 'delete' action)").Action(c.src.Set).StringVar(&c.src.Value)
+	c.CmdClause.Flag("substitution", "Value to substitute in place of the regular expression (only applies to the 'regex' and 'regex_repeat' actions)").Action(c.substitution.Set).StringVar(&c.substitution.Value)
+	c.CmdClause.Flag("type", "Type of header, which determines where in the request lifecycle it applies").HintOptions(HeaderTypes...).Action(c.headerType.Set).EnumVar(&c.headerType.Value, HeaderTypes...)
+	c.RegisterFlag(argparser.StringFlagOpts{
+		Name:        argparser.FlagServiceIDName,
+		Description: argparser.FlagServiceIDDesc,
+		Dst:         &g.Manifest.Flag.ServiceID,
+		Short:       's',
+	})
+	c.RegisterFlag(argparser.StringFlagOpts{
+		Action:      c.serviceName.Set,
+		Name:        argparser.FlagServiceName,
+		Description: argparser.FlagServiceDesc,
+		Dst:         &c.serviceName.Value,
+	})
+
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *CreateCommand) Exec(_ io.Reader, out io.Writer) error {
+	serviceID, serviceVersion, err := argparser.ServiceDetails(argparser.ServiceDetailsOpts{
+		AutoCloneFlag:      c.autoClone,
+		APIClient:          c.Globals.APIClient,
+		Manifest:           *c.Globals.Manifest,
+		Out:                out,
+		ServiceNameFlag:    c.serviceName,
+		ServiceVersionFlag: c.serviceVersion,
+		VerboseMode:        c.Globals.Flags.Verbose,
+	})
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]any{
+			"Service ID":      serviceID,
+			"Service Version": errors.ServiceVersion(serviceVersion),
+		})
+		return err
+	}
+
+	input := fastly.CreateHeaderInput{
+		Name:           &c.name,
+		ServiceID:      serviceID,
+		ServiceVersion: serviceVersion.Number,
+	}
+	if c.action.WasSet {
+		input.Action = fastly.HeaderActionPtr(fastly.HeaderAction(c.action.Value))
+	}
+	if c.cacheCondition.WasSet {
+		input.CacheCondition = &c.cacheCondition.Value
+	}
+	if c.dst.WasSet {
+		input.Destination = &c.dst.Value
+	}
+	if c.ignoreIfSet.WasSet {
+		input.IgnoreIfSet = fastly.CBool(c.ignoreIfSet.Value)
+	}
+	if c.priority.WasSet {
+		input.Priority = &c.priority.Value
+	}
+	if c.regex.WasSet {
+		input.Regex = &c.regex.Value
+	}
+	if c.requestCondition.WasSet {
+		input.RequestCondition = &c.requestCondition.Value
+	}
+	if c.responseCondition.WasSet {
+		input.ResponseCondition = &c.responseCondition.Value
+	}
+	if c.src.WasSet {
+		input.Source = &c.src.Value
+	}
+	if c.substitution.WasSet {
+		input.Substitution = &c.substitution.Value
+	}
+	if c.headerType.WasSet {
+		input.Type = fastly.HeaderTypePtr(fastly.HeaderType(c.headerType.Value))
+	}
+
+	r, err := c.Globals.APIClient.CreateHeader(&input)
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]any{
+			"Service ID":      serviceID,
+			"Service Version": serviceVersion.Number,
+		})
+		return err
+	}
+
+	text.Success(out, "Created header %s (service %s version %d)", r.Name, r.ServiceID, r.ServiceVersion)
+	return nil
+}
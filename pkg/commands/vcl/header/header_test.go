@@ -0,0 +1,425 @@
+package header_test
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/fastly/go-fastly/v8/fastly"
+
+	"github.com/fastly/cli/pkg/app"
+	"github.com/fastly/cli/pkg/global"
+	"github.com/fastly/cli/pkg/mock"
+	"github.com/fastly/cli/pkg/testutil"
+)
+
+func TestHeaderCreate(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Args:      args("vcl header create --version 3 --name set-host"),
+			WantError: "error reading service: no service ID found",
+		},
+		{
+			Args:      args("vcl header create --service-id 123 --version 3"),
+			WantError: "error parsing arguments: required flag --name not provided",
+		},
+		{
+			Args:      args("vcl header create --service-id 123 --version 3 --name set-host --action move"),
+			WantError: "error parsing arguments: enum value must be one of set,append,delete,regex,regex_repeat, got 'move'",
+		},
+		{
+			Args: args("vcl header create --service-id 123 --version 1 --name set-host --action set --type request --dst http.Host --src req.http.X-Host --ignore-if-set --autoclone"),
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				CloneVersionFn: testutil.CloneVersionResult(4),
+				CreateHeaderFn: createHeaderOK,
+			},
+			WantOutput: "Created header set-host (service 123 version 4)",
+		},
+		{
+			Args: args("vcl header create --service-id 123 --version 3 --name set-host"),
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				CreateHeaderFn: createHeaderError,
+			},
+			WantError: errTest.Error(),
+		},
+	}
+	for testcaseIdx := range scenarios {
+		testcase := &scenarios[testcaseIdx]
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			app.Init = func(_ []string, _ io.Reader) (*global.Data, error) {
+				opts := testutil.MockGlobalData(testcase.Args, &stdout)
+				opts.APIClientFactory = mock.APIClient(testcase.API)
+				return opts, nil
+			}
+			err := app.Run(testcase.Args, nil)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.WantOutput)
+		})
+	}
+}
+
+func TestHeaderDelete(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Args:      args("vcl header delete --service-id 123 --version 1"),
+			WantError: "error parsing arguments: required flag --name not provided",
+		},
+		{
+			Args: args("vcl header delete --service-id 123 --version 1 --name set-host --autoclone"),
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				CloneVersionFn: testutil.CloneVersionResult(4),
+				DeleteHeaderFn: deleteHeaderError,
+			},
+			WantError: errTest.Error(),
+		},
+		{
+			Args: args("vcl header delete --service-id 123 --version 1 --name set-host --autoclone"),
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				CloneVersionFn: testutil.CloneVersionResult(4),
+				DeleteHeaderFn: deleteHeaderOK,
+			},
+			WantOutput: "Deleted header set-host (service 123 version 4)",
+		},
+	}
+	for testcaseIdx := range scenarios {
+		testcase := &scenarios[testcaseIdx]
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			app.Init = func(_ []string, _ io.Reader) (*global.Data, error) {
+				opts := testutil.MockGlobalData(testcase.Args, &stdout)
+				opts.APIClientFactory = mock.APIClient(testcase.API)
+				return opts, nil
+			}
+			err := app.Run(testcase.Args, nil)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.WantOutput)
+		})
+	}
+}
+
+func TestHeaderUpdate(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Args:      args("vcl header update --service-id 123 --version 1 --new-name set-host-new"),
+			WantError: "error parsing arguments: required flag --name not provided",
+		},
+		{
+			Args:      args("vcl header update --service-id 123 --version 1 --name set-host --autoclone"),
+			WantError: "error parsing arguments: must provide either --new-name, --action, --cache-condition, --dst, --ignore-if-set, --priority, --regex, --request-condition, --response-condition, --src, --substitution or --type to update header",
+		},
+		{
+			Args: args("vcl header update --service-id 123 --version 1 --name set-host --new-name set-host-new --autoclone"),
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				CloneVersionFn: testutil.CloneVersionResult(4),
+				UpdateHeaderFn: updateHeaderError,
+			},
+			WantError: errTest.Error(),
+		},
+		{
+			Args: args("vcl header update --service-id 123 --version 1 --name set-host --new-name set-host-new --autoclone"),
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				CloneVersionFn: testutil.CloneVersionResult(4),
+				UpdateHeaderFn: updateHeaderOK,
+			},
+			WantOutput: "Updated header set-host-new (service 123 version 4)",
+		},
+	}
+	for testcaseIdx := range scenarios {
+		testcase := &scenarios[testcaseIdx]
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			app.Init = func(_ []string, _ io.Reader) (*global.Data, error) {
+				opts := testutil.MockGlobalData(testcase.Args, &stdout)
+				opts.APIClientFactory = mock.APIClient(testcase.API)
+				return opts, nil
+			}
+			err := app.Run(testcase.Args, nil)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.WantOutput)
+		})
+	}
+}
+
+func TestHeaderDescribe(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Args:      args("vcl header describe --service-id 123 --version 1"),
+			WantError: "error parsing arguments: required flag --name not provided",
+		},
+		{
+			Args: args("vcl header describe --service-id 123 --version 1 --name set-host"),
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				GetHeaderFn:    getHeaderError,
+			},
+			WantError: errTest.Error(),
+		},
+		{
+			Args: args("vcl header describe --service-id 123 --version 1 --name set-host"),
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				GetHeaderFn:    getHeaderOK,
+			},
+			WantOutput: describeHeaderOutput,
+		},
+	}
+	for testcaseIdx := range scenarios {
+		testcase := &scenarios[testcaseIdx]
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			app.Init = func(_ []string, _ io.Reader) (*global.Data, error) {
+				opts := testutil.MockGlobalData(testcase.Args, &stdout)
+				opts.APIClientFactory = mock.APIClient(testcase.API)
+				return opts, nil
+			}
+			err := app.Run(testcase.Args, nil)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertString(t, testcase.WantOutput, stdout.String())
+		})
+	}
+}
+
+func TestHeaderList(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Args: args("vcl header list --service-id 123 --version 1"),
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				ListHeadersFn:  listHeadersOK,
+			},
+			WantOutput: listHeadersShortOutput,
+		},
+		{
+			Args: args("vcl header list --service-id 123 --version 1 --verbose"),
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				ListHeadersFn:  listHeadersOK,
+			},
+			WantOutput: listHeadersVerboseOutput,
+		},
+		{
+			Args: args("vcl header list --service-id 123 --version 1 --json"),
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				ListHeadersFn:  listHeadersOK,
+			},
+			WantOutput: listHeadersJSONOutput,
+		},
+		{
+			Args: args("vcl header list --service-id 123 --version 1"),
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				ListHeadersFn:  listHeadersError,
+			},
+			WantError: errTest.Error(),
+		},
+	}
+	for testcaseIdx := range scenarios {
+		testcase := &scenarios[testcaseIdx]
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			app.Init = func(_ []string, _ io.Reader) (*global.Data, error) {
+				opts := testutil.MockGlobalData(testcase.Args, &stdout)
+				opts.APIClientFactory = mock.APIClient(testcase.API)
+				return opts, nil
+			}
+			err := app.Run(testcase.Args, nil)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertString(t, testcase.WantOutput, stdout.String())
+		})
+	}
+}
+
+var describeHeaderOutput = "\n" + strings.TrimSpace(`
+Service ID: 123
+Version: 1
+Name: set-host
+Action: set
+Cache condition: 
+Destination: http.Host
+Ignore if set: false
+Priority: 10
+Regex: 
+Request condition: 
+Response condition: 
+Source: req.http.X-Host
+Substitution: 
+Type: request
+`) + "\n"
+
+var listHeadersShortOutput = strings.TrimSpace(`
+SERVICE  VERSION  NAME          TYPE      ACTION  DESTINATION  SOURCE           PRIORITY
+123      1        set-host      request   set     http.Host    req.http.X-Host  10
+123      1        strip-server  response  delete  http.Server                   100
+`) + "\n"
+
+var listHeadersVerboseOutput = strings.TrimSpace(`
+Fastly API endpoint: https://api.fastly.com
+Fastly API token provided via config file (profile: user)
+
+Service ID (via --service-id): 123
+
+Version: 1
+	Header 1/2
+		Name: set-host
+		Action: set
+		Cache condition: 
+		Destination: http.Host
+		Ignore if set: false
+		Priority: 10
+		Regex: 
+		Request condition: 
+		Response condition: 
+		Source: req.http.X-Host
+		Substitution: 
+		Type: request
+	Header 2/2
+		Name: strip-server
+		Action: delete
+		Cache condition: 
+		Destination: http.Server
+		Ignore if set: false
+		Priority: 100
+		Regex: 
+		Request condition: 
+		Response condition: 
+		Source: 
+		Substitution: 
+		Type: response
+`) + "\n\n"
+
+var listHeadersJSONOutput = strings.TrimSpace(`
+[
+  {
+    "Action": "set",
+    "CacheCondition": "",
+    "CreatedAt": null,
+    "DeletedAt": null,
+    "Destination": "http.Host",
+    "IgnoreIfSet": false,
+    "Name": "set-host",
+    "Priority": 10,
+    "Regex": "",
+    "RequestCondition": "",
+    "ResponseCondition": "",
+    "ServiceID": "123",
+    "ServiceVersion": 1,
+    "Source": "req.http.X-Host",
+    "Substitution": "",
+    "Type": "request",
+    "UpdatedAt": null
+  },
+  {
+    "Action": "delete",
+    "CacheCondition": "",
+    "CreatedAt": null,
+    "DeletedAt": null,
+    "Destination": "http.Server",
+    "IgnoreIfSet": false,
+    "Name": "strip-server",
+    "Priority": 100,
+    "Regex": "",
+    "RequestCondition": "",
+    "ResponseCondition": "",
+    "ServiceID": "123",
+    "ServiceVersion": 1,
+    "Source": "",
+    "Substitution": "",
+    "Type": "response",
+    "UpdatedAt": null
+  }
+]
+`) + "\n"
+
+var errTest = errors.New("fixture error")
+
+func createHeaderOK(i *fastly.CreateHeaderInput) (*fastly.Header, error) {
+	return &fastly.Header{
+		ServiceID:      i.ServiceID,
+		ServiceVersion: i.ServiceVersion,
+		Name:           *i.Name,
+	}, nil
+}
+
+func createHeaderError(_ *fastly.CreateHeaderInput) (*fastly.Header, error) {
+	return nil, errTest
+}
+
+func deleteHeaderOK(_ *fastly.DeleteHeaderInput) error {
+	return nil
+}
+
+func deleteHeaderError(_ *fastly.DeleteHeaderInput) error {
+	return errTest
+}
+
+func updateHeaderOK(i *fastly.UpdateHeaderInput) (*fastly.Header, error) {
+	return &fastly.Header{
+		ServiceID:      i.ServiceID,
+		ServiceVersion: i.ServiceVersion,
+		Name:           *i.NewName,
+	}, nil
+}
+
+func updateHeaderError(_ *fastly.UpdateHeaderInput) (*fastly.Header, error) {
+	return nil, errTest
+}
+
+func getHeaderOK(i *fastly.GetHeaderInput) (*fastly.Header, error) {
+	return &fastly.Header{
+		ServiceID:      i.ServiceID,
+		ServiceVersion: i.ServiceVersion,
+		Name:           i.Name,
+		Action:         "set",
+		Type:           "request",
+		Destination:    "http.Host",
+		Source:         "req.http.X-Host",
+		Priority:       10,
+	}, nil
+}
+
+func getHeaderError(_ *fastly.GetHeaderInput) (*fastly.Header, error) {
+	return nil, errTest
+}
+
+func listHeadersOK(i *fastly.ListHeadersInput) ([]*fastly.Header, error) {
+	return []*fastly.Header{
+		{
+			ServiceID:      i.ServiceID,
+			ServiceVersion: i.ServiceVersion,
+			Name:           "set-host",
+			Action:         "set",
+			Type:           "request",
+			Destination:    "http.Host",
+			Source:         "req.http.X-Host",
+			Priority:       10,
+		},
+		{
+			ServiceID:      i.ServiceID,
+			ServiceVersion: i.ServiceVersion,
+			Name:           "strip-server",
+			Action:         "delete",
+			Type:           "response",
+			Destination:    "http.Server",
+			Priority:       100,
+		},
+	}, nil
+}
+
+func listHeadersError(_ *fastly.ListHeadersInput) ([]*fastly.Header, error) {
+	return nil, errTest
+}
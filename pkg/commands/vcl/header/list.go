@@ -0,0 +1,132 @@
+package header
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/fastly/go-fastly/v8/fastly"
+
+	"github.com/fastly/cli/pkg/argparser"
+	"github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/global"
+	"github.com/fastly/cli/pkg/text"
+)
+
+// ListCommand calls the Fastly API to list appropriate resources.
+type ListCommand struct {
+	argparser.Base
+	argparser.JSONOutput
+
+	// Required.
+	serviceVersion argparser.OptionalServiceVersion
+
+	// Optional.
+	serviceName argparser.OptionalServiceNameID
+}
+
+// NewListCommand returns a usable command registered under the parent.
+func NewListCommand(parent argparser.Registerer, g *global.Data) *ListCommand {
+	c := ListCommand{
+		Base: argparser.Base{
+			Globals: g,
+		},
+	}
+	c.CmdClause = parent.Command("list", "List headers on a Fastly service version")
+
+	// Required.
+	c.RegisterFlag(argparser.StringFlagOpts{
+		Name:        argparser.FlagVersionName,
+		Description: argparser.FlagVersionDesc,
+		Dst:         &c.serviceVersion.Value,
+		Required:    true,
+	})
+
+	// Optional.
+	c.RegisterFlagBool(c.JSONFlag()) // --json
+	c.RegisterFlag(argparser.StringFlagOpts{
+		Name:        argparser.FlagServiceIDName,
+		Description: argparser.FlagServiceIDDesc,
+		Dst:         &g.Manifest.Flag.ServiceID,
+		Short:       's',
+	})
+	c.RegisterFlag(argparser.StringFlagOpts{
+		Action:      c.serviceName.Set,
+		Name:        argparser.FlagServiceName,
+		Description: argparser.FlagServiceDesc,
+		Dst:         &c.serviceName.Value,
+	})
+
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *ListCommand) Exec(_ io.Reader, out io.Writer) error {
+	if c.Globals.Verbose() && c.JSONOutput.Enabled {
+		return errors.ErrInvalidVerboseJSONCombo
+	}
+
+	serviceID, serviceVersion, err := argparser.ServiceDetails(argparser.ServiceDetailsOpts{
+		AllowActiveLocked:  true,
+		APIClient:          c.Globals.APIClient,
+		Manifest:           *c.Globals.Manifest,
+		Out:                out,
+		ServiceNameFlag:    c.serviceName,
+		ServiceVersionFlag: c.serviceVersion,
+		VerboseMode:        c.Globals.Flags.Verbose,
+	})
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]any{
+			"Service ID":      serviceID,
+			"Service Version": errors.ServiceVersion(serviceVersion),
+		})
+		return err
+	}
+
+	input := fastly.ListHeadersInput{
+		ServiceID:      serviceID,
+		ServiceVersion: serviceVersion.Number,
+	}
+
+	o, err := c.Globals.APIClient.ListHeaders(&input)
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]any{
+			"Service ID":      serviceID,
+			"Service Version": serviceVersion.Number,
+		})
+		return err
+	}
+
+	if ok, err := c.WriteJSON(out, o); ok {
+		return err
+	}
+
+	if !c.Globals.Verbose() {
+		tw := text.NewTable(out)
+		tw.AddHeader("SERVICE", "VERSION", "NAME", "TYPE", "ACTION", "DESTINATION", "SOURCE", "PRIORITY")
+		for _, r := range o {
+			tw.AddLine(r.ServiceID, r.ServiceVersion, r.Name, r.Type, r.Action, r.Destination, r.Source, r.Priority)
+		}
+		tw.Print()
+		return nil
+	}
+
+	fmt.Fprintf(out, "Version: %d\n", input.ServiceVersion)
+	for i, r := range o {
+		fmt.Fprintf(out, "\tHeader %d/%d\n", i+1, len(o))
+		fmt.Fprintf(out, "\t\tName: %s\n", r.Name)
+		fmt.Fprintf(out, "\t\tAction: %s\n", r.Action)
+		fmt.Fprintf(out, "\t\tCache condition: %s\n", r.CacheCondition)
+		fmt.Fprintf(out, "\t\tDestination: %s\n", r.Destination)
+		fmt.Fprintf(out, "\t\tIgnore if set: %t\n", r.IgnoreIfSet)
+		fmt.Fprintf(out, "\t\tPriority: %d\n", r.Priority)
+		fmt.Fprintf(out, "\t\tRegex: %s\n", r.Regex)
+		fmt.Fprintf(out, "\t\tRequest condition: %s\n", r.RequestCondition)
+		fmt.Fprintf(out, "\t\tResponse condition: %s\n", r.ResponseCondition)
+		fmt.Fprintf(out, "\t\tSource: %s\n", r.Source)
+		fmt.Fprintf(out, "\t\tSubstitution: %s\n", r.Substitution)
+		fmt.Fprintf(out, "\t\tType: %s\n", r.Type)
+	}
+	fmt.Fprintln(out)
+
+	return nil
+}
@@ -0,0 +1,168 @@
+package header
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/fastly/go-fastly/v8/fastly"
+
+	"github.com/fastly/cli/pkg/argparser"
+	"github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/global"
+	"github.com/fastly/cli/pkg/text"
+)
+
+// UpdateCommand calls the Fastly API to update an appropriate resource.
+type UpdateCommand struct {
+	argparser.Base
+
+	// Required.
+	name           string
+	serviceVersion argparser.OptionalServiceVersion
+
+	// Optional.
+	action            argparser.OptionalString
+	autoClone         argparser.OptionalAutoClone
+	cacheCondition    argparser.OptionalString
+	dst               argparser.OptionalString
+	headerType        argparser.OptionalString
+	ignoreIfSet       argparser.OptionalBool
+	newName           argparser.OptionalString
+	priority          argparser.OptionalInt
+	regex             argparser.OptionalString
+	requestCondition  argparser.OptionalString
+	responseCondition argparser.OptionalString
+	serviceName       argparser.OptionalServiceNameID
+	src               argparser.OptionalString
+	substitution      argparser.OptionalString
+}
+
+// NewUpdateCommand returns a usable command registered under the parent.
+func NewUpdateCommand(parent argparser.Registerer, g *global.Data) *UpdateCommand {
+	c := UpdateCommand{
+		Base: argparser.Base{
+			Globals: g,
+		},
+	}
+	c.CmdClause = parent.Command("update", "Update a header on a Fastly service version")
+
+	// Required.
+	c.CmdClause.Flag("name", "Header name").Short('n').Required().StringVar(&c.name)
+	c.RegisterFlag(argparser.StringFlagOpts{
+		Name:        argparser.FlagVersionName,
+		Description: argparser.FlagVersionDesc,
+		Dst:         &c.serviceVersion.Value,
+		Required:    true,
+	})
+
+	// Optional.
+	c.RegisterAutoCloneFlag(argparser.AutoCloneFlagOpts{
+		Action: c.autoClone.Set,
+		Dst:    &c.autoClone.Value,
+	})
+	c.CmdClause.Flag("new-name", "New header name").Action(c.newName.Set).StringVar(&c.newName.Value)
+	c.CmdClause.Flag("action", "Action to perform on the header").HintOptions(HeaderActions...).Action(c.action.Set).EnumVar(&c.action.Value, HeaderActions...)
+	c.CmdClause.Flag("cache-condition", "Name of the cache condition controlling when this header applies").Action(c.cacheCondition.Set).StringVar(&c.cacheCondition.Value)
+	c.CmdClause.Flag("dst", "Header to set (e.g. http.X-Example)").Action(c.dst.Set).StringVar(&c.dst.Value)
+	c.CmdClause.Flag("ignore-if-set", "Don't add the header if it's already set (only applies to the 'set' action)").Action(c.ignoreIfSet.Set).BoolVar(&c.ignoreIfSet.Value)
+	c.CmdClause.Flag("priority", "Priority determines execution order (lower numbers execute first)").Action(c.priority.Set).IntVar(&c.priority.Value)
+	c.CmdClause.Flag("regex", "Regular expression to use (only applies to the 'regex' and 'regex_repeat' actions)").Action(c.regex.Set).StringVar(&c.regex.Value)
+	c.CmdClause.Flag("request-condition", "Name of the request condition controlling when this header applies").Action(c.requestCondition.Set).StringVar(&c.requestCondition.Value)
+	c.CmdClause.Flag("response-condition", "Name of the response condition controlling when this header applies").Action(c.responseCondition.Set).StringVar(&c.responseCondition.Value)
+	c.CmdClause.Flag("src", "Variable to be used as the source of the header content (doesn't apply to the 'delete' action)").Action(c.src.Set).StringVar(&c.src.Value)
+	c.CmdClause.Flag("substitution", "Value to substitute in place of the regular expression (only applies to the 'regex' and 'regex_repeat' actions)").Action(c.substitution.Set).StringVar(&c.substitution.Value)
+	c.CmdClause.Flag("type", "Type of header, which determines where in the request lifecycle it applies").HintOptions(HeaderTypes...).Action(c.headerType.Set).EnumVar(&c.headerType.Value, HeaderTypes...)
+	c.RegisterFlag(argparser.StringFlagOpts{
+		Name:        argparser.FlagServiceIDName,
+		Description: argparser.FlagServiceIDDesc,
+		Dst:         &g.Manifest.Flag.ServiceID,
+		Short:       's',
+	})
+	c.RegisterFlag(argparser.StringFlagOpts{
+		Action:      c.serviceName.Set,
+		Name:        argparser.FlagServiceName,
+		Description: argparser.FlagServiceDesc,
+		Dst:         &c.serviceName.Value,
+	})
+
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *UpdateCommand) Exec(_ io.Reader, out io.Writer) error {
+	// If no argument are provided, error with useful message.
+	if !c.newName.WasSet && !c.action.WasSet && !c.cacheCondition.WasSet && !c.dst.WasSet && !c.ignoreIfSet.WasSet && !c.priority.WasSet && !c.regex.WasSet && !c.requestCondition.WasSet && !c.responseCondition.WasSet && !c.src.WasSet && !c.substitution.WasSet && !c.headerType.WasSet {
+		return fmt.Errorf("error parsing arguments: must provide either --new-name, --action, --cache-condition, --dst, --ignore-if-set, --priority, --regex, --request-condition, --response-condition, --src, --substitution or --type to update header")
+	}
+
+	serviceID, serviceVersion, err := argparser.ServiceDetails(argparser.ServiceDetailsOpts{
+		AutoCloneFlag:      c.autoClone,
+		APIClient:          c.Globals.APIClient,
+		Manifest:           *c.Globals.Manifest,
+		Out:                out,
+		ServiceNameFlag:    c.serviceName,
+		ServiceVersionFlag: c.serviceVersion,
+		VerboseMode:        c.Globals.Flags.Verbose,
+	})
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]any{
+			"Service ID":      serviceID,
+			"Service Version": errors.ServiceVersion(serviceVersion),
+		})
+		return err
+	}
+
+	input := fastly.UpdateHeaderInput{
+		Name:           c.name,
+		ServiceID:      serviceID,
+		ServiceVersion: serviceVersion.Number,
+	}
+	if c.newName.WasSet {
+		input.NewName = &c.newName.Value
+	}
+	if c.action.WasSet {
+		input.Action = fastly.HeaderActionPtr(fastly.HeaderAction(c.action.Value))
+	}
+	if c.cacheCondition.WasSet {
+		input.CacheCondition = &c.cacheCondition.Value
+	}
+	if c.dst.WasSet {
+		input.Destination = &c.dst.Value
+	}
+	if c.ignoreIfSet.WasSet {
+		input.IgnoreIfSet = fastly.CBool(c.ignoreIfSet.Value)
+	}
+	if c.priority.WasSet {
+		input.Priority = &c.priority.Value
+	}
+	if c.regex.WasSet {
+		input.Regex = &c.regex.Value
+	}
+	if c.requestCondition.WasSet {
+		input.RequestCondition = &c.requestCondition.Value
+	}
+	if c.responseCondition.WasSet {
+		input.ResponseCondition = &c.responseCondition.Value
+	}
+	if c.src.WasSet {
+		input.Source = &c.src.Value
+	}
+	if c.substitution.WasSet {
+		input.Substitution = &c.substitution.Value
+	}
+	if c.headerType.WasSet {
+		input.Type = fastly.HeaderTypePtr(fastly.HeaderType(c.headerType.Value))
+	}
+
+	r, err := c.Globals.APIClient.UpdateHeader(&input)
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]any{
+			"Service ID":      serviceID,
+			"Service Version": serviceVersion.Number,
+		})
+		return err
+	}
+
+	text.Success(out, "Updated header %s (service %s version %d)", r.Name, r.ServiceID, r.ServiceVersion)
+	return nil
+}
@@ -0,0 +1,165 @@
+package requestsetting
+
+import (
+	"io"
+
+	"github.com/fastly/go-fastly/v8/fastly"
+
+	"github.com/fastly/cli/pkg/argparser"
+	"github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/global"
+	"github.com/fastly/cli/pkg/text"
+)
+
+// RequestSettingActions are the allowed input values for the --action flag.
+// Reference: https://developer.fastly.com/reference/api/vcl-services/request-settings/
+var RequestSettingActions = []string{"lookup", "pass"}
+
+// RequestSettingXFFs are the allowed input values for the --xff flag.
+// Reference: https://developer.fastly.com/reference/api/vcl-services/request-settings/
+var RequestSettingXFFs = []string{"clear", "leave", "append", "append_all", "overwrite"}
+
+// CreateCommand calls the Fastly API to create an appropriate resource.
+type CreateCommand struct {
+	argparser.Base
+
+	// Required.
+	name           string
+	serviceVersion argparser.OptionalServiceVersion
+
+	// Optional.
+	action           argparser.OptionalString
+	autoClone        argparser.OptionalAutoClone
+	bypassBusyWait   argparser.OptionalBool
+	defaultHost      argparser.OptionalString
+	forceMiss        argparser.OptionalBool
+	forceSSL         argparser.OptionalBool
+	geoHeaders       argparser.OptionalBool
+	hashKeys         argparser.OptionalString
+	maxStaleAge      argparser.OptionalInt
+	requestCondition argparser.OptionalString
+	serviceName      argparser.OptionalServiceNameID
+	timerSupport     argparser.OptionalBool
+	xff              argparser.OptionalString
+}
+
+// NewCreateCommand returns a usable command registered under the parent.
+func NewCreateCommand(parent argparser.Registerer, g *global.Data) *CreateCommand {
+	c := CreateCommand{
+		Base: argparser.Base{
+			Globals: g,
+		},
+	}
+	c.CmdClause = parent.Command("create", "Create a request setting on a Fastly service version").Alias("add")
+
+	// Required.
+	c.CmdClause.Flag("name", "Request setting name").Short('n').Required().StringVar(&c.name)
+	c.RegisterFlag(argparser.StringFlagOpts{
+		Name:        argparser.FlagVersionName,
+		Description: argparser.FlagVersionDesc,
+		Dst:         &c.serviceVersion.Value,
+		Required:    true,
+	})
+
+	// Optional.
+	c.RegisterAutoCloneFlag(argparser.AutoCloneFlagOpts{
+		Action: c.autoClone.Set,
+		Dst:    &c.autoClone.Value,
+	})
+	c.CmdClause.Flag("action", "Action to take in vcl_recv, terminating request handling").HintOptions(RequestSettingActions...).Action(c.action.Set).EnumVar(&c.action.Value, RequestSettingActions...)
+	c.CmdClause.Flag("bypass-busy-wait", "Disable collapsed forwarding, so requests don't wait for other requests to the origin").Action(c.bypassBusyWait.Set).BoolVar(&c.bypassBusyWait.Value)
+	c.CmdClause.Flag("default-host", "Value to set the Host header to if the request doesn't have one").Action(c.defaultHost.Set).StringVar(&c.defaultHost.Value)
+	c.CmdClause.Flag("force-miss", "Force a cache miss for the request, replacing the cached object if the content is cacheable").Action(c.forceMiss.Set).BoolVar(&c.forceMiss.Value)
+	c.CmdClause.Flag("force-ssl", "Force the request to use TLS, redirecting non-TLS requests").Action(c.forceSSL.Set).BoolVar(&c.forceSSL.Value)
+	c.CmdClause.Flag("geo-headers", "Inject the Fastly-Geo-Country, Fastly-Geo-City and Fastly-Geo-Region request headers").Action(c.geoHeaders.Set).BoolVar(&c.geoHeaders.Value)
+	c.CmdClause.Flag("hash-keys", "Comma separated list of VCL request fields to include in the hash key").Action(c.hashKeys.Set).StringVar(&c.hashKeys.Value)
+	c.CmdClause.Flag("max-stale-age", "Maximum age in seconds of stale content served by stale-if-error or stale-while-revalidate").Action(c.maxStaleAge.Set).IntVar(&c.maxStaleAge.Value)
+	c.CmdClause.Flag("request-condition", "Name of the request condition controlling when this request setting applies").Action(c.requestCondition.Set).StringVar(&c.requestCondition.Value)
+	c.CmdClause.Flag("timer-support", "Inject the X-Timer header into the request to view origin fetch durations").Action(c.timerSupport.Set).BoolVar(&c.timerSupport.Value)
+	c.CmdClause.Flag("xff", "How to handle the X-Forwarded-For request header").HintOptions(RequestSettingXFFs...).Action(c.xff.Set).EnumVar(&c.xff.Value, RequestSettingXFFs...)
+	c.RegisterFlag(argparser.StringFlagOpts{
+		Name:        argparser.FlagServiceIDName,
+		Description: argparser.FlagServiceIDDesc,
+		Dst:         &g.Manifest.Flag.ServiceID,
+		Short:       's',
+	})
+	c.RegisterFlag(argparser.StringFlagOpts{
+		Action:      c.serviceName.Set,
+		Name:        argparser.FlagServiceName,
+		Description: argparser.FlagServiceDesc,
+		Dst:         &c.serviceName.Value,
+	})
+
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *CreateCommand) Exec(_ io.Reader, out io.Writer) error {
+	serviceID, serviceVersion, err := argparser.ServiceDetails(argparser.ServiceDetailsOpts{
+		AutoCloneFlag:      c.autoClone,
+		APIClient:          c.Globals.APIClient,
+		Manifest:           *c.Globals.Manifest,
+		Out:                out,
+		ServiceNameFlag:    c.serviceName,
+		ServiceVersionFlag: c.serviceVersion,
+		VerboseMode:        c.Globals.Flags.Verbose,
+	})
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]any{
+			"Service ID":      serviceID,
+			"Service Version": errors.ServiceVersion(serviceVersion),
+		})
+		return err
+	}
+
+	input := fastly.CreateRequestSettingInput{
+		Name:           &c.name,
+		ServiceID:      serviceID,
+		ServiceVersion: serviceVersion.Number,
+	}
+	if c.action.WasSet {
+		input.Action = fastly.RequestSettingActionPtr(fastly.RequestSettingAction(c.action.Value))
+	}
+	if c.bypassBusyWait.WasSet {
+		input.BypassBusyWait = fastly.CBool(c.bypassBusyWait.Value)
+	}
+	if c.defaultHost.WasSet {
+		input.DefaultHost = &c.defaultHost.Value
+	}
+	if c.forceMiss.WasSet {
+		input.ForceMiss = fastly.CBool(c.forceMiss.Value)
+	}
+	if c.forceSSL.WasSet {
+		input.ForceSSL = fastly.CBool(c.forceSSL.Value)
+	}
+	if c.geoHeaders.WasSet {
+		input.GeoHeaders = fastly.CBool(c.geoHeaders.Value)
+	}
+	if c.hashKeys.WasSet {
+		input.HashKeys = &c.hashKeys.Value
+	}
+	if c.maxStaleAge.WasSet {
+		input.MaxStaleAge = &c.maxStaleAge.Value
+	}
+	if c.requestCondition.WasSet {
+		input.RequestCondition = &c.requestCondition.Value
+	}
+	if c.timerSupport.WasSet {
+		input.TimerSupport = fastly.CBool(c.timerSupport.Value)
+	}
+	if c.xff.WasSet {
+		input.XForwardedFor = fastly.RequestSettingXFFPtr(fastly.RequestSettingXFF(c.xff.Value))
+	}
+
+	r, err := c.Globals.APIClient.CreateRequestSetting(&input)
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]any{
+			"Service ID":      serviceID,
+			"Service Version": serviceVersion.Number,
+		})
+		return err
+	}
+
+	text.Success(out, "Created request setting %s (service %s version %d)", r.Name, r.ServiceID, r.ServiceVersion)
+	return nil
+}
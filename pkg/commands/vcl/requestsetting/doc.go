@@ -0,0 +1,2 @@
+// Package requestsetting contains commands to inspect and manipulate Fastly service request settings.
+package requestsetting
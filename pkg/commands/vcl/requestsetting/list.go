@@ -0,0 +1,132 @@
+package requestsetting
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/fastly/go-fastly/v8/fastly"
+
+	"github.com/fastly/cli/pkg/argparser"
+	"github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/global"
+	"github.com/fastly/cli/pkg/text"
+)
+
+// ListCommand calls the Fastly API to list appropriate resources.
+type ListCommand struct {
+	argparser.Base
+	argparser.JSONOutput
+
+	// Required.
+	serviceVersion argparser.OptionalServiceVersion
+
+	// Optional.
+	serviceName argparser.OptionalServiceNameID
+}
+
+// NewListCommand returns a usable command registered under the parent.
+func NewListCommand(parent argparser.Registerer, g *global.Data) *ListCommand {
+	c := ListCommand{
+		Base: argparser.Base{
+			Globals: g,
+		},
+	}
+	c.CmdClause = parent.Command("list", "List request settings on a Fastly service version")
+
+	// Required.
+	c.RegisterFlag(argparser.StringFlagOpts{
+		Name:        argparser.FlagVersionName,
+		Description: argparser.FlagVersionDesc,
+		Dst:         &c.serviceVersion.Value,
+		Required:    true,
+	})
+
+	// Optional.
+	c.RegisterFlagBool(c.JSONFlag()) // --json
+	c.RegisterFlag(argparser.StringFlagOpts{
+		Name:        argparser.FlagServiceIDName,
+		Description: argparser.FlagServiceIDDesc,
+		Dst:         &g.Manifest.Flag.ServiceID,
+		Short:       's',
+	})
+	c.RegisterFlag(argparser.StringFlagOpts{
+		Action:      c.serviceName.Set,
+		Name:        argparser.FlagServiceName,
+		Description: argparser.FlagServiceDesc,
+		Dst:         &c.serviceName.Value,
+	})
+
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *ListCommand) Exec(_ io.Reader, out io.Writer) error {
+	if c.Globals.Verbose() && c.JSONOutput.Enabled {
+		return errors.ErrInvalidVerboseJSONCombo
+	}
+
+	serviceID, serviceVersion, err := argparser.ServiceDetails(argparser.ServiceDetailsOpts{
+		AllowActiveLocked:  true,
+		APIClient:          c.Globals.APIClient,
+		Manifest:           *c.Globals.Manifest,
+		Out:                out,
+		ServiceNameFlag:    c.serviceName,
+		ServiceVersionFlag: c.serviceVersion,
+		VerboseMode:        c.Globals.Flags.Verbose,
+	})
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]any{
+			"Service ID":      serviceID,
+			"Service Version": errors.ServiceVersion(serviceVersion),
+		})
+		return err
+	}
+
+	input := fastly.ListRequestSettingsInput{
+		ServiceID:      serviceID,
+		ServiceVersion: serviceVersion.Number,
+	}
+
+	o, err := c.Globals.APIClient.ListRequestSettings(&input)
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]any{
+			"Service ID":      serviceID,
+			"Service Version": serviceVersion.Number,
+		})
+		return err
+	}
+
+	if ok, err := c.WriteJSON(out, o); ok {
+		return err
+	}
+
+	if !c.Globals.Verbose() {
+		tw := text.NewTable(out)
+		tw.AddHeader("SERVICE", "VERSION", "NAME", "ACTION", "DEFAULT HOST", "XFF", "FORCE SSL")
+		for _, r := range o {
+			tw.AddLine(r.ServiceID, r.ServiceVersion, r.Name, r.Action, r.DefaultHost, r.XForwardedFor, r.ForceSSL)
+		}
+		tw.Print()
+		return nil
+	}
+
+	fmt.Fprintf(out, "Version: %d\n", input.ServiceVersion)
+	for i, r := range o {
+		fmt.Fprintf(out, "\tRequest setting %d/%d\n", i+1, len(o))
+		fmt.Fprintf(out, "\t\tName: %s\n", r.Name)
+		fmt.Fprintf(out, "\t\tAction: %s\n", r.Action)
+		fmt.Fprintf(out, "\t\tBypass busy wait: %t\n", r.BypassBusyWait)
+		fmt.Fprintf(out, "\t\tDefault host: %s\n", r.DefaultHost)
+		fmt.Fprintf(out, "\t\tForce miss: %t\n", r.ForceMiss)
+		fmt.Fprintf(out, "\t\tForce SSL: %t\n", r.ForceSSL)
+		fmt.Fprintf(out, "\t\tGeo headers: %t\n", r.GeoHeaders)
+		fmt.Fprintf(out, "\t\tHash keys: %s\n", r.HashKeys)
+		fmt.Fprintf(out, "\t\tMax stale age: %d\n", r.MaxStaleAge)
+		fmt.Fprintf(out, "\t\tRequest condition: %s\n", r.RequestCondition)
+		fmt.Fprintf(out, "\t\tTimer support: %t\n", r.TimerSupport)
+		fmt.Fprintf(out, "\t\tX-Forwarded-For: %s\n", r.XForwardedFor)
+	}
+	fmt.Fprintln(out)
+
+	return nil
+}
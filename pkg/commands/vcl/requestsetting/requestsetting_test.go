@@ -0,0 +1,425 @@
+package requestsetting_test
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/fastly/go-fastly/v8/fastly"
+
+	"github.com/fastly/cli/pkg/app"
+	"github.com/fastly/cli/pkg/global"
+	"github.com/fastly/cli/pkg/mock"
+	"github.com/fastly/cli/pkg/testutil"
+)
+
+func TestRequestSettingCreate(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Args:      args("vcl request-setting create --version 3 --name force-tls"),
+			WantError: "error reading service: no service ID found",
+		},
+		{
+			Args:      args("vcl request-setting create --service-id 123 --version 3"),
+			WantError: "error parsing arguments: required flag --name not provided",
+		},
+		{
+			Args:      args("vcl request-setting create --service-id 123 --version 3 --name force-tls --xff replace"),
+			WantError: "error parsing arguments: enum value must be one of clear,leave,append,append_all,overwrite, got 'replace'",
+		},
+		{
+			Args: args("vcl request-setting create --service-id 123 --version 1 --name force-tls --force-ssl --xff append --max-stale-age 60 --autoclone"),
+			API: mock.API{
+				ListVersionsFn:         testutil.ListVersions,
+				CloneVersionFn:         testutil.CloneVersionResult(4),
+				CreateRequestSettingFn: createRequestSettingOK,
+			},
+			WantOutput: "Created request setting force-tls (service 123 version 4)",
+		},
+		{
+			Args: args("vcl request-setting create --service-id 123 --version 3 --name force-tls"),
+			API: mock.API{
+				ListVersionsFn:         testutil.ListVersions,
+				CreateRequestSettingFn: createRequestSettingError,
+			},
+			WantError: errTest.Error(),
+		},
+	}
+	for testcaseIdx := range scenarios {
+		testcase := &scenarios[testcaseIdx]
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			app.Init = func(_ []string, _ io.Reader) (*global.Data, error) {
+				opts := testutil.MockGlobalData(testcase.Args, &stdout)
+				opts.APIClientFactory = mock.APIClient(testcase.API)
+				return opts, nil
+			}
+			err := app.Run(testcase.Args, nil)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.WantOutput)
+		})
+	}
+}
+
+func TestRequestSettingDelete(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Args:      args("vcl request-setting delete --service-id 123 --version 1"),
+			WantError: "error parsing arguments: required flag --name not provided",
+		},
+		{
+			Args: args("vcl request-setting delete --service-id 123 --version 1 --name force-tls --autoclone"),
+			API: mock.API{
+				ListVersionsFn:         testutil.ListVersions,
+				CloneVersionFn:         testutil.CloneVersionResult(4),
+				DeleteRequestSettingFn: deleteRequestSettingError,
+			},
+			WantError: errTest.Error(),
+		},
+		{
+			Args: args("vcl request-setting delete --service-id 123 --version 1 --name force-tls --autoclone"),
+			API: mock.API{
+				ListVersionsFn:         testutil.ListVersions,
+				CloneVersionFn:         testutil.CloneVersionResult(4),
+				DeleteRequestSettingFn: deleteRequestSettingOK,
+			},
+			WantOutput: "Deleted request setting force-tls (service 123 version 4)",
+		},
+	}
+	for testcaseIdx := range scenarios {
+		testcase := &scenarios[testcaseIdx]
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			app.Init = func(_ []string, _ io.Reader) (*global.Data, error) {
+				opts := testutil.MockGlobalData(testcase.Args, &stdout)
+				opts.APIClientFactory = mock.APIClient(testcase.API)
+				return opts, nil
+			}
+			err := app.Run(testcase.Args, nil)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.WantOutput)
+		})
+	}
+}
+
+func TestRequestSettingUpdate(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Args:      args("vcl request-setting update --service-id 123 --version 1 --new-name force-tls-new"),
+			WantError: "error parsing arguments: required flag --name not provided",
+		},
+		{
+			Args:      args("vcl request-setting update --service-id 123 --version 1 --name force-tls --autoclone"),
+			WantError: "error parsing arguments: must provide either --new-name, --action, --bypass-busy-wait, --default-host, --force-miss, --force-ssl, --geo-headers, --hash-keys, --max-stale-age, --request-condition, --timer-support or --xff to update request setting",
+		},
+		{
+			Args: args("vcl request-setting update --service-id 123 --version 1 --name force-tls --new-name force-tls-new --autoclone"),
+			API: mock.API{
+				ListVersionsFn:         testutil.ListVersions,
+				CloneVersionFn:         testutil.CloneVersionResult(4),
+				UpdateRequestSettingFn: updateRequestSettingError,
+			},
+			WantError: errTest.Error(),
+		},
+		{
+			Args: args("vcl request-setting update --service-id 123 --version 1 --name force-tls --new-name force-tls-new --autoclone"),
+			API: mock.API{
+				ListVersionsFn:         testutil.ListVersions,
+				CloneVersionFn:         testutil.CloneVersionResult(4),
+				UpdateRequestSettingFn: updateRequestSettingOK,
+			},
+			WantOutput: "Updated request setting force-tls-new (service 123 version 4)",
+		},
+	}
+	for testcaseIdx := range scenarios {
+		testcase := &scenarios[testcaseIdx]
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			app.Init = func(_ []string, _ io.Reader) (*global.Data, error) {
+				opts := testutil.MockGlobalData(testcase.Args, &stdout)
+				opts.APIClientFactory = mock.APIClient(testcase.API)
+				return opts, nil
+			}
+			err := app.Run(testcase.Args, nil)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.WantOutput)
+		})
+	}
+}
+
+func TestRequestSettingDescribe(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Args:      args("vcl request-setting describe --service-id 123 --version 1"),
+			WantError: "error parsing arguments: required flag --name not provided",
+		},
+		{
+			Args: args("vcl request-setting describe --service-id 123 --version 1 --name force-tls"),
+			API: mock.API{
+				ListVersionsFn:      testutil.ListVersions,
+				GetRequestSettingFn: getRequestSettingError,
+			},
+			WantError: errTest.Error(),
+		},
+		{
+			Args: args("vcl request-setting describe --service-id 123 --version 1 --name force-tls"),
+			API: mock.API{
+				ListVersionsFn:      testutil.ListVersions,
+				GetRequestSettingFn: getRequestSettingOK,
+			},
+			WantOutput: describeRequestSettingOutput,
+		},
+	}
+	for testcaseIdx := range scenarios {
+		testcase := &scenarios[testcaseIdx]
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			app.Init = func(_ []string, _ io.Reader) (*global.Data, error) {
+				opts := testutil.MockGlobalData(testcase.Args, &stdout)
+				opts.APIClientFactory = mock.APIClient(testcase.API)
+				return opts, nil
+			}
+			err := app.Run(testcase.Args, nil)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertString(t, testcase.WantOutput, stdout.String())
+		})
+	}
+}
+
+func TestRequestSettingList(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Args: args("vcl request-setting list --service-id 123 --version 1"),
+			API: mock.API{
+				ListVersionsFn:        testutil.ListVersions,
+				ListRequestSettingsFn: listRequestSettingsOK,
+			},
+			WantOutput: listRequestSettingsShortOutput,
+		},
+		{
+			Args: args("vcl request-setting list --service-id 123 --version 1 --verbose"),
+			API: mock.API{
+				ListVersionsFn:        testutil.ListVersions,
+				ListRequestSettingsFn: listRequestSettingsOK,
+			},
+			WantOutput: listRequestSettingsVerboseOutput,
+		},
+		{
+			Args: args("vcl request-setting list --service-id 123 --version 1 --json"),
+			API: mock.API{
+				ListVersionsFn:        testutil.ListVersions,
+				ListRequestSettingsFn: listRequestSettingsOK,
+			},
+			WantOutput: listRequestSettingsJSONOutput,
+		},
+		{
+			Args: args("vcl request-setting list --service-id 123 --version 1"),
+			API: mock.API{
+				ListVersionsFn:        testutil.ListVersions,
+				ListRequestSettingsFn: listRequestSettingsError,
+			},
+			WantError: errTest.Error(),
+		},
+	}
+	for testcaseIdx := range scenarios {
+		testcase := &scenarios[testcaseIdx]
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			app.Init = func(_ []string, _ io.Reader) (*global.Data, error) {
+				opts := testutil.MockGlobalData(testcase.Args, &stdout)
+				opts.APIClientFactory = mock.APIClient(testcase.API)
+				return opts, nil
+			}
+			err := app.Run(testcase.Args, nil)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertString(t, testcase.WantOutput, stdout.String())
+		})
+	}
+}
+
+var describeRequestSettingOutput = "\n" + strings.TrimSpace(`
+Service ID: 123
+Version: 1
+Name: force-tls
+Action: lookup
+Bypass busy wait: false
+Default host: 
+Force miss: false
+Force SSL: true
+Geo headers: false
+Hash keys: 
+Max stale age: 60
+Request condition: 
+Timer support: true
+X-Forwarded-For: append
+`) + "\n"
+
+var listRequestSettingsShortOutput = strings.TrimSpace(`
+SERVICE  VERSION  NAME        ACTION  DEFAULT HOST       XFF     FORCE SSL
+123      1        force-tls   lookup                     append  true
+123      1        pass-admin  pass    admin.example.com  leave   false
+`) + "\n"
+
+var listRequestSettingsVerboseOutput = strings.TrimSpace(`
+Fastly API endpoint: https://api.fastly.com
+Fastly API token provided via config file (profile: user)
+
+Service ID (via --service-id): 123
+
+Version: 1
+	Request setting 1/2
+		Name: force-tls
+		Action: lookup
+		Bypass busy wait: false
+		Default host: 
+		Force miss: false
+		Force SSL: true
+		Geo headers: false
+		Hash keys: 
+		Max stale age: 60
+		Request condition: 
+		Timer support: true
+		X-Forwarded-For: append
+	Request setting 2/2
+		Name: pass-admin
+		Action: pass
+		Bypass busy wait: false
+		Default host: admin.example.com
+		Force miss: false
+		Force SSL: false
+		Geo headers: false
+		Hash keys: 
+		Max stale age: 0
+		Request condition: is_admin
+		Timer support: false
+		X-Forwarded-For: leave
+`) + "\n\n"
+
+var listRequestSettingsJSONOutput = strings.TrimSpace(`
+[
+  {
+    "Action": "lookup",
+    "BypassBusyWait": false,
+    "CreatedAt": null,
+    "DefaultHost": "",
+    "DeletedAt": null,
+    "ForceMiss": false,
+    "ForceSSL": true,
+    "GeoHeaders": false,
+    "HashKeys": "",
+    "MaxStaleAge": 60,
+    "Name": "force-tls",
+    "RequestCondition": "",
+    "ServiceID": "123",
+    "ServiceVersion": 1,
+    "TimerSupport": true,
+    "UpdatedAt": null,
+    "XForwardedFor": "append"
+  },
+  {
+    "Action": "pass",
+    "BypassBusyWait": false,
+    "CreatedAt": null,
+    "DefaultHost": "admin.example.com",
+    "DeletedAt": null,
+    "ForceMiss": false,
+    "ForceSSL": false,
+    "GeoHeaders": false,
+    "HashKeys": "",
+    "MaxStaleAge": 0,
+    "Name": "pass-admin",
+    "RequestCondition": "is_admin",
+    "ServiceID": "123",
+    "ServiceVersion": 1,
+    "TimerSupport": false,
+    "UpdatedAt": null,
+    "XForwardedFor": "leave"
+  }
+]
+`) + "\n"
+
+var errTest = errors.New("fixture error")
+
+func createRequestSettingOK(i *fastly.CreateRequestSettingInput) (*fastly.RequestSetting, error) {
+	return &fastly.RequestSetting{
+		ServiceID:      i.ServiceID,
+		ServiceVersion: i.ServiceVersion,
+		Name:           *i.Name,
+	}, nil
+}
+
+func createRequestSettingError(_ *fastly.CreateRequestSettingInput) (*fastly.RequestSetting, error) {
+	return nil, errTest
+}
+
+func deleteRequestSettingOK(_ *fastly.DeleteRequestSettingInput) error {
+	return nil
+}
+
+func deleteRequestSettingError(_ *fastly.DeleteRequestSettingInput) error {
+	return errTest
+}
+
+func updateRequestSettingOK(i *fastly.UpdateRequestSettingInput) (*fastly.RequestSetting, error) {
+	return &fastly.RequestSetting{
+		ServiceID:      i.ServiceID,
+		ServiceVersion: i.ServiceVersion,
+		Name:           *i.NewName,
+	}, nil
+}
+
+func updateRequestSettingError(_ *fastly.UpdateRequestSettingInput) (*fastly.RequestSetting, error) {
+	return nil, errTest
+}
+
+func getRequestSettingOK(i *fastly.GetRequestSettingInput) (*fastly.RequestSetting, error) {
+	return &fastly.RequestSetting{
+		ServiceID:      i.ServiceID,
+		ServiceVersion: i.ServiceVersion,
+		Name:           i.Name,
+		Action:         "lookup",
+		XForwardedFor:  "append",
+		ForceSSL:       true,
+		MaxStaleAge:    60,
+		TimerSupport:   true,
+	}, nil
+}
+
+func getRequestSettingError(_ *fastly.GetRequestSettingInput) (*fastly.RequestSetting, error) {
+	return nil, errTest
+}
+
+func listRequestSettingsOK(i *fastly.ListRequestSettingsInput) ([]*fastly.RequestSetting, error) {
+	return []*fastly.RequestSetting{
+		{
+			ServiceID:      i.ServiceID,
+			ServiceVersion: i.ServiceVersion,
+			Name:           "force-tls",
+			Action:         "lookup",
+			XForwardedFor:  "append",
+			ForceSSL:       true,
+			MaxStaleAge:    60,
+			TimerSupport:   true,
+		},
+		{
+			ServiceID:        i.ServiceID,
+			ServiceVersion:   i.ServiceVersion,
+			Name:             "pass-admin",
+			Action:           "pass",
+			DefaultHost:      "admin.example.com",
+			XForwardedFor:    "leave",
+			RequestCondition: "is_admin",
+		},
+	}, nil
+}
+
+func listRequestSettingsError(_ *fastly.ListRequestSettingsInput) ([]*fastly.RequestSetting, error) {
+	return nil, errTest
+}
@@ -0,0 +1,397 @@
+package responseobject_test
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/fastly/go-fastly/v8/fastly"
+
+	"github.com/fastly/cli/pkg/app"
+	"github.com/fastly/cli/pkg/global"
+	"github.com/fastly/cli/pkg/mock"
+	"github.com/fastly/cli/pkg/testutil"
+)
+
+func TestResponseObjectCreate(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Args:      args("vcl response-object create --version 3 --name not-found"),
+			WantError: "error reading service: no service ID found",
+		},
+		{
+			Args:      args("vcl response-object create --service-id 123 --version 3"),
+			WantError: "error parsing arguments: required flag --name not provided",
+		},
+		{
+			Args: args("vcl response-object create --service-id 123 --version 1 --name not-found --status 404 --content-type text/plain --request-condition is_missing --autoclone"),
+			API: mock.API{
+				ListVersionsFn:         testutil.ListVersions,
+				CloneVersionFn:         testutil.CloneVersionResult(4),
+				CreateResponseObjectFn: createResponseObjectOK,
+			},
+			WantOutput: "Created response object not-found (service 123 version 4)",
+		},
+		{
+			Args: args("vcl response-object create --service-id 123 --version 3 --name not-found"),
+			API: mock.API{
+				ListVersionsFn:         testutil.ListVersions,
+				CreateResponseObjectFn: createResponseObjectError,
+			},
+			WantError: errTest.Error(),
+		},
+	}
+	for testcaseIdx := range scenarios {
+		testcase := &scenarios[testcaseIdx]
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			app.Init = func(_ []string, _ io.Reader) (*global.Data, error) {
+				opts := testutil.MockGlobalData(testcase.Args, &stdout)
+				opts.APIClientFactory = mock.APIClient(testcase.API)
+				return opts, nil
+			}
+			err := app.Run(testcase.Args, nil)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.WantOutput)
+		})
+	}
+}
+
+func TestResponseObjectDelete(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Args:      args("vcl response-object delete --service-id 123 --version 1"),
+			WantError: "error parsing arguments: required flag --name not provided",
+		},
+		{
+			Args: args("vcl response-object delete --service-id 123 --version 1 --name not-found --autoclone"),
+			API: mock.API{
+				ListVersionsFn:         testutil.ListVersions,
+				CloneVersionFn:         testutil.CloneVersionResult(4),
+				DeleteResponseObjectFn: deleteResponseObjectError,
+			},
+			WantError: errTest.Error(),
+		},
+		{
+			Args: args("vcl response-object delete --service-id 123 --version 1 --name not-found --autoclone"),
+			API: mock.API{
+				ListVersionsFn:         testutil.ListVersions,
+				CloneVersionFn:         testutil.CloneVersionResult(4),
+				DeleteResponseObjectFn: deleteResponseObjectOK,
+			},
+			WantOutput: "Deleted response object not-found (service 123 version 4)",
+		},
+	}
+	for testcaseIdx := range scenarios {
+		testcase := &scenarios[testcaseIdx]
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			app.Init = func(_ []string, _ io.Reader) (*global.Data, error) {
+				opts := testutil.MockGlobalData(testcase.Args, &stdout)
+				opts.APIClientFactory = mock.APIClient(testcase.API)
+				return opts, nil
+			}
+			err := app.Run(testcase.Args, nil)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.WantOutput)
+		})
+	}
+}
+
+func TestResponseObjectUpdate(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Args:      args("vcl response-object update --service-id 123 --version 1 --new-name not-found-new"),
+			WantError: "error parsing arguments: required flag --name not provided",
+		},
+		{
+			Args:      args("vcl response-object update --service-id 123 --version 1 --name not-found --autoclone"),
+			WantError: "error parsing arguments: must provide either --new-name, --cache-condition, --content, --content-type, --request-condition, --response or --status to update response object",
+		},
+		{
+			Args: args("vcl response-object update --service-id 123 --version 1 --name not-found --new-name not-found-new --autoclone"),
+			API: mock.API{
+				ListVersionsFn:         testutil.ListVersions,
+				CloneVersionFn:         testutil.CloneVersionResult(4),
+				UpdateResponseObjectFn: updateResponseObjectError,
+			},
+			WantError: errTest.Error(),
+		},
+		{
+			Args: args("vcl response-object update --service-id 123 --version 1 --name not-found --new-name not-found-new --autoclone"),
+			API: mock.API{
+				ListVersionsFn:         testutil.ListVersions,
+				CloneVersionFn:         testutil.CloneVersionResult(4),
+				UpdateResponseObjectFn: updateResponseObjectOK,
+			},
+			WantOutput: "Updated response object not-found-new (service 123 version 4)",
+		},
+	}
+	for testcaseIdx := range scenarios {
+		testcase := &scenarios[testcaseIdx]
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			app.Init = func(_ []string, _ io.Reader) (*global.Data, error) {
+				opts := testutil.MockGlobalData(testcase.Args, &stdout)
+				opts.APIClientFactory = mock.APIClient(testcase.API)
+				return opts, nil
+			}
+			err := app.Run(testcase.Args, nil)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.WantOutput)
+		})
+	}
+}
+
+func TestResponseObjectDescribe(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Args:      args("vcl response-object describe --service-id 123 --version 1"),
+			WantError: "error parsing arguments: required flag --name not provided",
+		},
+		{
+			Args: args("vcl response-object describe --service-id 123 --version 1 --name not-found"),
+			API: mock.API{
+				ListVersionsFn:      testutil.ListVersions,
+				GetResponseObjectFn: getResponseObjectError,
+			},
+			WantError: errTest.Error(),
+		},
+		{
+			Args: args("vcl response-object describe --service-id 123 --version 1 --name not-found"),
+			API: mock.API{
+				ListVersionsFn:      testutil.ListVersions,
+				GetResponseObjectFn: getResponseObjectOK,
+			},
+			WantOutput: describeResponseObjectOutput,
+		},
+	}
+	for testcaseIdx := range scenarios {
+		testcase := &scenarios[testcaseIdx]
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			app.Init = func(_ []string, _ io.Reader) (*global.Data, error) {
+				opts := testutil.MockGlobalData(testcase.Args, &stdout)
+				opts.APIClientFactory = mock.APIClient(testcase.API)
+				return opts, nil
+			}
+			err := app.Run(testcase.Args, nil)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertString(t, testcase.WantOutput, stdout.String())
+		})
+	}
+}
+
+func TestResponseObjectList(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Args: args("vcl response-object list --service-id 123 --version 1"),
+			API: mock.API{
+				ListVersionsFn:        testutil.ListVersions,
+				ListResponseObjectsFn: listResponseObjectsOK,
+			},
+			WantOutput: listResponseObjectsShortOutput,
+		},
+		{
+			Args: args("vcl response-object list --service-id 123 --version 1 --verbose"),
+			API: mock.API{
+				ListVersionsFn:        testutil.ListVersions,
+				ListResponseObjectsFn: listResponseObjectsOK,
+			},
+			WantOutput: listResponseObjectsVerboseOutput,
+		},
+		{
+			Args: args("vcl response-object list --service-id 123 --version 1 --json"),
+			API: mock.API{
+				ListVersionsFn:        testutil.ListVersions,
+				ListResponseObjectsFn: listResponseObjectsOK,
+			},
+			WantOutput: listResponseObjectsJSONOutput,
+		},
+		{
+			Args: args("vcl response-object list --service-id 123 --version 1"),
+			API: mock.API{
+				ListVersionsFn:        testutil.ListVersions,
+				ListResponseObjectsFn: listResponseObjectsError,
+			},
+			WantError: errTest.Error(),
+		},
+	}
+	for testcaseIdx := range scenarios {
+		testcase := &scenarios[testcaseIdx]
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			app.Init = func(_ []string, _ io.Reader) (*global.Data, error) {
+				opts := testutil.MockGlobalData(testcase.Args, &stdout)
+				opts.APIClientFactory = mock.APIClient(testcase.API)
+				return opts, nil
+			}
+			err := app.Run(testcase.Args, nil)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertString(t, testcase.WantOutput, stdout.String())
+		})
+	}
+}
+
+var describeResponseObjectOutput = "\n" + strings.TrimSpace(`
+Service ID: 123
+Version: 1
+Name: not-found
+Cache condition: 
+Content: Page not found
+Content type: text/plain
+Request condition: is_missing
+Response: Not Found
+Status: 404
+`) + "\n"
+
+var listResponseObjectsShortOutput = strings.TrimSpace(`
+SERVICE  VERSION  NAME         STATUS  RESPONSE             CONTENT TYPE  REQUEST CONDITION
+123      1        not-found    404     Not Found            text/plain    is_missing
+123      1        maintenance  503     Service Unavailable  text/plain    in_maintenance
+`) + "\n"
+
+var listResponseObjectsVerboseOutput = strings.TrimSpace(`
+Fastly API endpoint: https://api.fastly.com
+Fastly API token provided via config file (profile: user)
+
+Service ID (via --service-id): 123
+
+Version: 1
+	Response object 1/2
+		Name: not-found
+		Cache condition: 
+		Content: Page not found
+		Content type: text/plain
+		Request condition: is_missing
+		Response: Not Found
+		Status: 404
+	Response object 2/2
+		Name: maintenance
+		Cache condition: 
+		Content: Back soon
+		Content type: text/plain
+		Request condition: in_maintenance
+		Response: Service Unavailable
+		Status: 503
+`) + "\n\n"
+
+var listResponseObjectsJSONOutput = strings.TrimSpace(`
+[
+  {
+    "CacheCondition": "",
+    "Content": "Page not found",
+    "ContentType": "text/plain",
+    "CreatedAt": null,
+    "DeletedAt": null,
+    "Name": "not-found",
+    "RequestCondition": "is_missing",
+    "Response": "Not Found",
+    "ServiceID": "123",
+    "ServiceVersion": 1,
+    "Status": 404,
+    "UpdatedAt": null
+  },
+  {
+    "CacheCondition": "",
+    "Content": "Back soon",
+    "ContentType": "text/plain",
+    "CreatedAt": null,
+    "DeletedAt": null,
+    "Name": "maintenance",
+    "RequestCondition": "in_maintenance",
+    "Response": "Service Unavailable",
+    "ServiceID": "123",
+    "ServiceVersion": 1,
+    "Status": 503,
+    "UpdatedAt": null
+  }
+]
+`) + "\n"
+
+var errTest = errors.New("fixture error")
+
+func createResponseObjectOK(i *fastly.CreateResponseObjectInput) (*fastly.ResponseObject, error) {
+	return &fastly.ResponseObject{
+		ServiceID:      i.ServiceID,
+		ServiceVersion: i.ServiceVersion,
+		Name:           *i.Name,
+	}, nil
+}
+
+func createResponseObjectError(_ *fastly.CreateResponseObjectInput) (*fastly.ResponseObject, error) {
+	return nil, errTest
+}
+
+func deleteResponseObjectOK(_ *fastly.DeleteResponseObjectInput) error {
+	return nil
+}
+
+func deleteResponseObjectError(_ *fastly.DeleteResponseObjectInput) error {
+	return errTest
+}
+
+func updateResponseObjectOK(i *fastly.UpdateResponseObjectInput) (*fastly.ResponseObject, error) {
+	return &fastly.ResponseObject{
+		ServiceID:      i.ServiceID,
+		ServiceVersion: i.ServiceVersion,
+		Name:           *i.NewName,
+	}, nil
+}
+
+func updateResponseObjectError(_ *fastly.UpdateResponseObjectInput) (*fastly.ResponseObject, error) {
+	return nil, errTest
+}
+
+func getResponseObjectOK(i *fastly.GetResponseObjectInput) (*fastly.ResponseObject, error) {
+	return &fastly.ResponseObject{
+		ServiceID:        i.ServiceID,
+		ServiceVersion:   i.ServiceVersion,
+		Name:             i.Name,
+		Status:           404,
+		Response:         "Not Found",
+		Content:          "Page not found",
+		ContentType:      "text/plain",
+		RequestCondition: "is_missing",
+	}, nil
+}
+
+func getResponseObjectError(_ *fastly.GetResponseObjectInput) (*fastly.ResponseObject, error) {
+	return nil, errTest
+}
+
+func listResponseObjectsOK(i *fastly.ListResponseObjectsInput) ([]*fastly.ResponseObject, error) {
+	return []*fastly.ResponseObject{
+		{
+			ServiceID:        i.ServiceID,
+			ServiceVersion:   i.ServiceVersion,
+			Name:             "not-found",
+			Status:           404,
+			Response:         "Not Found",
+			Content:          "Page not found",
+			ContentType:      "text/plain",
+			RequestCondition: "is_missing",
+		},
+		{
+			ServiceID:        i.ServiceID,
+			ServiceVersion:   i.ServiceVersion,
+			Name:             "maintenance",
+			Status:           503,
+			Response:         "Service Unavailable",
+			Content:          "Back soon",
+			ContentType:      "text/plain",
+			RequestCondition: "in_maintenance",
+		},
+	}, nil
+}
+
+func listResponseObjectsError(_ *fastly.ListResponseObjectsInput) ([]*fastly.ResponseObject, error) {
+	return nil, errTest
+}
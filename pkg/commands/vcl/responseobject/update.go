@@ -0,0 +1,143 @@
+package responseobject
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/fastly/go-fastly/v8/fastly"
+
+	"github.com/fastly/cli/pkg/argparser"
+	"github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/global"
+	"github.com/fastly/cli/pkg/text"
+)
+
+// UpdateCommand calls the Fastly API to update an appropriate resource.
+type UpdateCommand struct {
+	argparser.Base
+
+	// Required.
+	name           string
+	serviceVersion argparser.OptionalServiceVersion
+
+	// Optional.
+	autoClone        argparser.OptionalAutoClone
+	cacheCondition   argparser.OptionalString
+	content          argparser.OptionalString
+	contentType      argparser.OptionalString
+	newName          argparser.OptionalString
+	requestCondition argparser.OptionalString
+	response         argparser.OptionalString
+	serviceName      argparser.OptionalServiceNameID
+	status           argparser.OptionalInt
+}
+
+// NewUpdateCommand returns a usable command registered under the parent.
+func NewUpdateCommand(parent argparser.Registerer, g *global.Data) *UpdateCommand {
+	c := UpdateCommand{
+		Base: argparser.Base{
+			Globals: g,
+		},
+	}
+	c.CmdClause = parent.Command("update", "Update a response object on a Fastly service version")
+
+	// Required.
+	c.CmdClause.Flag("name", "Response object name").Short('n').Required().StringVar(&c.name)
+	c.RegisterFlag(argparser.StringFlagOpts{
+		Name:        argparser.FlagVersionName,
+		Description: argparser.FlagVersionDesc,
+		Dst:         &c.serviceVersion.Value,
+		Required:    true,
+	})
+
+	// Optional.
+	c.RegisterAutoCloneFlag(argparser.AutoCloneFlagOpts{
+		Action: c.autoClone.Set,
+		Dst:    &c.autoClone.Value,
+	})
+	c.CmdClause.Flag("new-name", "New response object name").Action(c.newName.Set).StringVar(&c.newName.Value)
+	c.CmdClause.Flag("cache-condition", "Name of the cache condition controlling when this response object applies").Action(c.cacheCondition.Set).StringVar(&c.cacheCondition.Value)
+	c.CmdClause.Flag("content", "Content to deliver for the response object").Action(c.content.Set).StringVar(&c.content.Value)
+	c.CmdClause.Flag("content-type", "MIME type of the content").Action(c.contentType.Set).StringVar(&c.contentType.Value)
+	c.CmdClause.Flag("request-condition", "Name of the request condition controlling when this response object applies").Action(c.requestCondition.Set).StringVar(&c.requestCondition.Value)
+	c.CmdClause.Flag("response", "HTTP response reason phrase (e.g. 'Not Found')").Action(c.response.Set).StringVar(&c.response.Value)
+	c.CmdClause.Flag("status", "HTTP status code").Action(c.status.Set).IntVar(&c.status.Value)
+	c.RegisterFlag(argparser.StringFlagOpts{
+		Name:        argparser.FlagServiceIDName,
+		Description: argparser.FlagServiceIDDesc,
+		Dst:         &g.Manifest.Flag.ServiceID,
+		Short:       's',
+	})
+	c.RegisterFlag(argparser.StringFlagOpts{
+		Action:      c.serviceName.Set,
+		Name:        argparser.FlagServiceName,
+		Description: argparser.FlagServiceDesc,
+		Dst:         &c.serviceName.Value,
+	})
+
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *UpdateCommand) Exec(_ io.Reader, out io.Writer) error {
+	// If no argument are provided, error with useful message.
+	if !c.newName.WasSet && !c.cacheCondition.WasSet && !c.content.WasSet && !c.contentType.WasSet && !c.requestCondition.WasSet && !c.response.WasSet && !c.status.WasSet {
+		return fmt.Errorf("error parsing arguments: must provide either --new-name, --cache-condition, --content, --content-type, --request-condition, --response or --status to update response object")
+	}
+
+	serviceID, serviceVersion, err := argparser.ServiceDetails(argparser.ServiceDetailsOpts{
+		AutoCloneFlag:      c.autoClone,
+		APIClient:          c.Globals.APIClient,
+		Manifest:           *c.Globals.Manifest,
+		Out:                out,
+		ServiceNameFlag:    c.serviceName,
+		ServiceVersionFlag: c.serviceVersion,
+		VerboseMode:        c.Globals.Flags.Verbose,
+	})
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]any{
+			"Service ID":      serviceID,
+			"Service Version": errors.ServiceVersion(serviceVersion),
+		})
+		return err
+	}
+
+	input := fastly.UpdateResponseObjectInput{
+		Name:           c.name,
+		ServiceID:      serviceID,
+		ServiceVersion: serviceVersion.Number,
+	}
+	if c.newName.WasSet {
+		input.NewName = &c.newName.Value
+	}
+	if c.cacheCondition.WasSet {
+		input.CacheCondition = &c.cacheCondition.Value
+	}
+	if c.content.WasSet {
+		input.Content = &c.content.Value
+	}
+	if c.contentType.WasSet {
+		input.ContentType = &c.contentType.Value
+	}
+	if c.requestCondition.WasSet {
+		input.RequestCondition = &c.requestCondition.Value
+	}
+	if c.response.WasSet {
+		input.Response = &c.response.Value
+	}
+	if c.status.WasSet {
+		input.Status = &c.status.Value
+	}
+
+	r, err := c.Globals.APIClient.UpdateResponseObject(&input)
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]any{
+			"Service ID":      serviceID,
+			"Service Version": serviceVersion.Number,
+		})
+		return err
+	}
+
+	text.Success(out, "Updated response object %s (service %s version %d)", r.Name, r.ServiceID, r.ServiceVersion)
+	return nil
+}
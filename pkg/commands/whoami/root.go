@@ -7,9 +7,11 @@ import (
 	"net/http"
 	"sort"
 	"strconv"
+	"strings"
 
 	"github.com/fastly/cli/pkg/api/undocumented"
 	"github.com/fastly/cli/pkg/argparser"
+	fsterr "github.com/fastly/cli/pkg/errors"
 	"github.com/fastly/cli/pkg/global"
 	"github.com/fastly/cli/pkg/useragent"
 )
@@ -85,6 +87,7 @@ func (c *RootCommand) Exec(_ io.Reader, out io.Writer) error {
 		fmt.Fprintf(out, "Token expires at: %s\n", response.Token.ExpiresAt)
 	}
 	fmt.Fprintf(out, "Token scope: %s\n", response.Token.Scope)
+	c.displayTokenServices(out)
 	fmt.Fprintf(out, "Service count: %d\n", len(response.Services))
 	for _, k := range keys {
 		fmt.Fprintf(out, "\t%s (%s)\n", response.Services[k], k)
@@ -93,6 +96,25 @@ func (c *RootCommand) Exec(_ io.Reader, out io.Writer) error {
 	return nil
 }
 
+// displayTokenServices displays the services the token is restricted to.
+//
+// NOTE: The /verify response doesn't include the services restriction of the
+// token, so the token details are retrieved separately. A failure to do so is
+// reported but isn't fatal.
+func (c *RootCommand) displayTokenServices(out io.Writer) {
+	t, err := c.Globals.APIClient.GetTokenSelf()
+	if err != nil {
+		c.Globals.ErrLog.Add(err)
+		fmt.Fprintf(out, "Token services: unavailable (%s)\n", fsterr.Deduce(err).Inner)
+		return
+	}
+	services := "all"
+	if len(t.Services) > 0 {
+		services = strings.Join(t.Services, ", ")
+	}
+	fmt.Fprintf(out, "Token services: %s\n", services)
+}
+
 // VerifyResponse models the Fastly API response for the whoami command.
 type VerifyResponse struct {
 	Customer Customer          `json:"customer"`
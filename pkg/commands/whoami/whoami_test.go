@@ -10,11 +10,14 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/fastly/go-fastly/v8/fastly"
+
 	"github.com/fastly/cli/pkg/api"
 	"github.com/fastly/cli/pkg/app"
 	"github.com/fastly/cli/pkg/config"
 	"github.com/fastly/cli/pkg/env"
 	"github.com/fastly/cli/pkg/global"
+	"github.com/fastly/cli/pkg/mock"
 	"github.com/fastly/cli/pkg/testutil"
 )
 
@@ -23,6 +26,7 @@ func TestWhoami(t *testing.T) {
 	for _, testcase := range []struct {
 		name       string
 		args       []string
+		api        mock.API
 		env        config.Environment
 		client     api.HTTPClient
 		wantError  string
@@ -36,10 +40,20 @@ func TestWhoami(t *testing.T) {
 		},
 		{
 			name:       "basic response verbose",
+			api:        mock.API{GetTokenSelfFn: getToken},
 			args:       args("whoami -v"),
 			client:     testutil.WhoamiVerifyClient(testutil.WhoamiBasicResponse),
 			wantOutput: basicOutputVerbose,
 		},
+		{
+			name: "verbose response with a token restricted to services",
+			args: args("whoami -v"),
+			api: mock.API{GetTokenSelfFn: func() (*fastly.Token, error) {
+				return &fastly.Token{ID: "abcdefg", Services: []string{"1xxaa", "2baba"}}, nil
+			}},
+			client:     testutil.WhoamiVerifyClient(testutil.WhoamiBasicResponse),
+			wantOutput: "Token scope: global\nToken services: 1xxaa, 2baba\n",
+		},
 		{
 			name:      "500 from API",
 			args:      args("whoami"),
@@ -54,6 +68,7 @@ func TestWhoami(t *testing.T) {
 		},
 		{
 			name:   "alternative endpoint from flag",
+			api:    mock.API{GetTokenSelfFn: getToken},
 			args:   args("whoami --api=https://staging.fastly.com -v"),
 			client: testutil.WhoamiVerifyClient(testutil.WhoamiBasicResponse),
 			wantOutput: strings.ReplaceAll(basicOutputVerbose,
@@ -63,6 +78,7 @@ func TestWhoami(t *testing.T) {
 		},
 		{
 			name:   "alternative endpoint from environment",
+			api:    mock.API{GetTokenSelfFn: getToken},
 			args:   args("whoami -v"),
 			env:    config.Environment{APIEndpoint: "https://alternative.example.com"},
 			client: testutil.WhoamiVerifyClient(testutil.WhoamiBasicResponse),
@@ -76,6 +92,7 @@ func TestWhoami(t *testing.T) {
 			var stdout bytes.Buffer
 			opts := testutil.MockGlobalData(testcase.args, &stdout)
 			opts.Env = testcase.env
+			opts.APIClientFactory = mock.APIClient(testcase.api)
 			opts.HTTPClient = testcase.client
 			app.Init = func(_ []string, _ io.Reader) (*global.Data, error) {
 				return opts, nil
@@ -122,7 +139,12 @@ Token ID: abcdefg
 Token name: Token name
 Token created at: 2019-01-01T12:00:00Z
 Token scope: global
+Token services: all
 Service count: 2
 	First service (1xxaa)
 	Second service (2baba)
 `) + "\n"
+
+func getToken() (*fastly.Token, error) {
+	return &fastly.Token{ID: "abcdefg", Scope: fastly.GlobalScope}, nil
+}
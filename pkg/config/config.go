@@ -10,6 +10,7 @@ import (
 
 	toml "github.com/pelletier/go-toml"
 
+	"github.com/fastly/cli/pkg/credentials"
 	"github.com/fastly/cli/pkg/env"
 	fsterr "github.com/fastly/cli/pkg/errors"
 	"github.com/fastly/cli/pkg/filesystem"
@@ -61,6 +62,8 @@ type LegacyUser struct {
 type Fastly struct {
 	APIEndpoint     string `toml:"api_endpoint"`
 	AccountEndpoint string `toml:"account_endpoint"`
+	// MaxRetries is the maximum number of times a failed API call is retried.
+	MaxRetries *int `toml:"max_retries,omitempty"`
 }
 
 // WasmMetadata represents what metadata will be collected.
@@ -86,6 +89,9 @@ type CLI struct {
 	// MetadataNoticeDisplayed indicates if the user has been notified of the
 	// metadata behaviours being enabled by default and how they can opt-out.
 	MetadataNoticeDisplayed bool `toml:"metadata_notice_displayed"`
+	// TokenExpiryWarningDays is the number of days before a profile token
+	// expires that the user is warned (a negative value disables the warning).
+	TokenExpiryWarningDays int `toml:"token_expiry_warning_days,omitempty"`
 	// Version indicates the CLI configuration version.
 	// It is updated each time a change is made to the config structure.
 	Version string `toml:"version"`
@@ -148,6 +154,9 @@ type Profile struct {
 	AccessTokenCreated int64 `toml:"access_token_created" json:"access_token_created"`
 	// AccessTokenTTL indicates when the access token needs to be replaced.
 	AccessTokenTTL int `toml:"access_token_ttl" json:"access_token_ttl"`
+	// CredentialRef identifies the tokens within the credential store (when
+	// they're not kept in the config file).
+	CredentialRef string `toml:"credential_ref,omitempty" json:"credential_ref,omitempty"`
 	// Default indicates if the profile is the default profile to use.
 	Default bool `toml:"default" json:"default"`
 	// Email is the email address associated with the token.
@@ -160,6 +169,18 @@ type Profile struct {
 	RefreshTokenTTL int `toml:"refresh_token_ttl" json:"refresh_token_ttl"`
 	// Token is a temporary token used to interact with the Fastly API.
 	Token string `toml:"token" json:"token"`
+	// TokenExpiresAt indicates when the token expires (a Unix timestamp).
+	// It's recorded whenever the token details are retrieved from the API.
+	TokenExpiresAt int64 `toml:"token_expires_at,omitempty" json:"token_expires_at,omitempty"`
+}
+
+// Credentials represents where the profile tokens are stored.
+type Credentials struct {
+	// Helper is the credential helper executable (store = "helper").
+	Helper string `toml:"helper,omitempty"`
+	// Store is the credential store type ("config", "file" or "helper").
+	// The tokens are kept in the config file when unset.
+	Store string `toml:"store,omitempty"`
 }
 
 // StarterKitLanguages represents language specific starter kits.
@@ -192,6 +213,8 @@ type File struct {
 	CLI CLI `toml:"cli"`
 	// ConfigVersion is the version of the config.
 	ConfigVersion int `toml:"config_version"`
+	// Credentials represents where the profile tokens are stored.
+	Credentials Credentials `toml:"credentials,omitempty"`
 	// Fastly represents fastly specific configuration.
 	Fastly Fastly `toml:"fastly"`
 	// Language represents C@E language specific configuration.
@@ -226,6 +249,18 @@ type File struct {
 	// but it means we need to expose Setter methods.
 	autoYes        bool
 	nonInteractive bool
+
+	// credentialStore keeps the profile tokens outside of the config file.
+	//
+	// NOTE: The tokens are loaded lazily (see LoadCredentials) so the store is
+	// only accessed (e.g. prompting for a passphrase) when a token is needed.
+	credentialStore credentials.Store
+	// loadedCredentials are the tokens loaded from (or written to) the store,
+	// keyed by profile name, so unchanged tokens aren't written back.
+	loadedCredentials map[string]credentials.Credentials
+	// storedRefs are the store references of each profile, keyed by profile
+	// name, so the tokens of a deleted profile can be erased.
+	storedRefs map[string]string
 }
 
 // SetAutoYes sets the associated flag value.
@@ -240,6 +275,71 @@ func (f *File) SetNonInteractive(v bool) {
 	f.nonInteractive = v
 }
 
+// SetCredentialStore sets the store used for the profile tokens.
+// A nil store keeps the tokens in the config file.
+func (f *File) SetCredentialStore(s credentials.Store) {
+	f.credentialStore = s
+	f.loadedCredentials = make(map[string]credentials.Credentials)
+	f.storedRefs = make(map[string]string)
+	for name, p := range f.Profiles {
+		if p.CredentialRef != "" {
+			f.storedRefs[name] = p.CredentialRef
+		}
+	}
+}
+
+// CredentialStore returns the store used for the profile tokens (nil if the
+// tokens are kept in the config file).
+func (f *File) CredentialStore() credentials.Store {
+	return f.credentialStore
+}
+
+// LoadCredentials populates the tokens of the named profile from the
+// credential store. It's a no-op if the profile's tokens aren't in the store.
+func (f *File) LoadCredentials(name string) error {
+	p, ok := f.Profiles[name]
+	if !ok || p.CredentialRef == "" {
+		return nil
+	}
+	if _, ok := f.loadedCredentials[name]; ok {
+		return nil
+	}
+	if f.credentialStore == nil {
+		return fsterr.RemediationError{
+			Inner:       fmt.Errorf("the tokens of profile '%s' are in a credential store, but no credential store is configured", name),
+			Remediation: "Configure the credential store using `fastly config --credential-store`.",
+		}
+	}
+
+	c, err := f.credentialStore.Get(p.CredentialRef)
+	if err != nil {
+		if errors.Is(err, credentials.ErrNotFound) {
+			return fsterr.RemediationError{
+				Inner:       fmt.Errorf("the tokens of profile '%s' weren't found in the credential store", name),
+				Remediation: fmt.Sprintf("Run `fastly profile update %s` to provide a new token.", name),
+			}
+		}
+		return fmt.Errorf("failed to load the tokens of profile '%s': %w", name, err)
+	}
+
+	// NOTE: Tokens already in memory (e.g. a plaintext token not yet migrated
+	// to the store) take precedence.
+	if p.AccessToken == "" {
+		p.AccessToken = c.AccessToken
+	}
+	if p.RefreshToken == "" {
+		p.RefreshToken = c.RefreshToken
+	}
+	if p.Token == "" {
+		p.Token = c.Token
+	}
+	if f.loadedCredentials == nil {
+		f.loadedCredentials = make(map[string]credentials.Credentials)
+	}
+	f.loadedCredentials[name] = c
+	return nil
+}
+
 // NOTE: Static 👇 is public for the sake of the test suite.
 
 // Static is the embedded configuration file used by the CLI.
@@ -417,7 +517,23 @@ func (f *File) UseStatic(path string) error {
 }
 
 // Write encodes in-memory data to disk.
+//
+// NOTE: When a credential store is set, the profile tokens are written to the
+// store and only a reference to them is written to the config file (this also
+// migrates any tokens still in the config file).
 func (f *File) Write(path string) error {
+	if f.credentialStore != nil {
+		profiles, err := f.storeCredentials()
+		if err != nil {
+			return err
+		}
+		original := f.Profiles
+		f.Profiles = profiles
+		defer func() {
+			f.Profiles = original
+		}()
+	}
+
 	// gosec flagged this:
 	// G304 (CWE-22): Potential file inclusion via variable
 	//
@@ -441,6 +557,77 @@ func (f *File) Write(path string) error {
 	return nil
 }
 
+// storeCredentials writes the profile tokens to the credential store and
+// returns a copy of the profiles with the tokens replaced by a reference.
+func (f *File) storeCredentials() (Profiles, error) {
+	if f.loadedCredentials == nil {
+		f.loadedCredentials = make(map[string]credentials.Credentials)
+	}
+	if f.storedRefs == nil {
+		f.storedRefs = make(map[string]string)
+	}
+
+	profiles := make(Profiles, len(f.Profiles))
+	for name, p := range f.Profiles {
+		c := credentials.Credentials{
+			AccessToken:  p.AccessToken,
+			RefreshToken: p.RefreshToken,
+			Token:        p.Token,
+		}
+		redacted := *p
+		redacted.AccessToken = ""
+		redacted.RefreshToken = ""
+		redacted.Token = ""
+		profiles[name] = &redacted
+
+		loaded, wasLoaded := f.loadedCredentials[name]
+		switch {
+		case c.Empty() && !wasLoaded:
+			// The tokens weren't loaded (or the profile has none), so the existing
+			// reference (if any) is kept.
+			continue
+		case c.Empty():
+			// The tokens were removed.
+			if p.CredentialRef != "" {
+				if err := f.credentialStore.Delete(p.CredentialRef); err != nil {
+					return nil, fmt.Errorf("failed to erase the tokens of profile '%s': %w", name, err)
+				}
+			}
+			p.CredentialRef = ""
+			redacted.CredentialRef = ""
+			delete(f.loadedCredentials, name)
+			delete(f.storedRefs, name)
+			continue
+		}
+
+		if p.CredentialRef == "" {
+			p.CredentialRef = credentials.Ref(name)
+			redacted.CredentialRef = p.CredentialRef
+		}
+		if !wasLoaded || loaded != c {
+			if err := f.credentialStore.Set(p.CredentialRef, c); err != nil {
+				return nil, fmt.Errorf("failed to store the tokens of profile '%s': %w", name, err)
+			}
+			f.loadedCredentials[name] = c
+		}
+		f.storedRefs[name] = p.CredentialRef
+	}
+
+	// Erase the tokens of deleted profiles.
+	for name, ref := range f.storedRefs {
+		if p, ok := f.Profiles[name]; ok && p.CredentialRef == ref {
+			continue
+		}
+		if err := f.credentialStore.Delete(ref); err != nil {
+			return nil, fmt.Errorf("failed to erase the tokens of profile '%s': %w", name, err)
+		}
+		delete(f.storedRefs, name)
+		delete(f.loadedCredentials, name)
+	}
+
+	return profiles, nil
+}
+
 // Environment represents all of the configuration parameters that can come
 // from environment variables.
 type Environment struct {
@@ -450,8 +637,16 @@ type Environment struct {
 	APIEndpoint string
 	// APIToken is the env var we look in for the Fastly API token.
 	APIToken string
+	// CredentialsPassphrase is the passphrase of the encrypted credentials file.
+	CredentialsPassphrase string
 	// DebugMode indicates to the CLI it can display debug information.
 	DebugMode string
+	// MaxRetries is the env var we look in for the maximum number of times a
+	// failed API call is retried.
+	MaxRetries string
+	// SSODevice indicates if user wants to use the OAuth device authorization
+	// flow for SSO. 1: enabled, 0: disabled.
+	SSODevice string
 	// UseSSO indicates if user wants to use SSO/OAuth token flow.
 	// 1: enabled, 0: disabled.
 	UseSSO string
@@ -466,7 +661,10 @@ func (e *Environment) Read(state map[string]string) {
 	e.AccountEndpoint = state[env.AccountEndpoint]
 	e.APIEndpoint = state[env.APIEndpoint]
 	e.APIToken = state[env.APIToken]
+	e.CredentialsPassphrase = state[env.CredentialsPassphrase]
 	e.DebugMode = state[env.DebugMode]
+	e.MaxRetries = state[env.MaxRetries]
+	e.SSODevice = state[env.SSODevice]
 	e.UseSSO = state[env.UseSSO]
 	e.WasmMetadataDisable = state[env.WasmMetadataDisable]
 }
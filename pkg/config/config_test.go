@@ -3,6 +3,7 @@ package config_test
 import (
 	"bytes"
 	_ "embed"
+	"errors"
 	"os"
 	"path/filepath"
 	"strings"
@@ -11,6 +12,7 @@ import (
 	toml "github.com/pelletier/go-toml"
 
 	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/credentials"
 	fsterr "github.com/fastly/cli/pkg/errors"
 	"github.com/fastly/cli/pkg/testutil"
 )
@@ -391,3 +393,62 @@ func TestNeedsUpdating(t *testing.T) {
 		})
 	}
 }
+
+// TestCredentialStore validates the profile tokens are moved to the credential
+// store when the config is written, and loaded from it on demand.
+func TestCredentialStore(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, config.FileName)
+	newStore := func() credentials.Store {
+		return credentials.NewFileStore(credentials.Opts{Dir: dir, Passphrase: "secret"})
+	}
+
+	f := config.File{
+		Profiles: config.Profiles{
+			"user":  &config.Profile{Default: true, Email: "testing@fastly.com", Token: "foobar"},
+			"other": &config.Profile{Token: "bazqux"},
+		},
+	}
+	f.SetCredentialStore(newStore())
+	if err := f.Write(path); err != nil {
+		t.Fatal(err)
+	}
+	if f.Profiles["user"].Token != "foobar" {
+		t.Fatal("expected the in-memory token to be unchanged")
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	testutil.AssertStringDoesntContain(t, string(data), "foobar")
+	testutil.AssertStringContains(t, string(data), `credential_ref = "fastly-cli://profile/user"`)
+
+	f = config.File{}
+	if err := toml.Unmarshal(data, &f); err != nil {
+		t.Fatal(err)
+	}
+	if err := f.LoadCredentials("user"); err == nil {
+		t.Fatal("expected an error when no credential store is configured")
+	}
+	store := newStore()
+	f.SetCredentialStore(store)
+	if err := f.LoadCredentials("user"); err != nil {
+		t.Fatal(err)
+	}
+	if f.Profiles["user"].Token != "foobar" {
+		t.Fatalf("wanted token: foobar, got: %s", f.Profiles["user"].Token)
+	}
+
+	// Deleting a profile erases its tokens from the store.
+	delete(f.Profiles, "other")
+	if err := f.Write(path); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := newStore().Get(credentials.Ref("other")); !errors.Is(err, credentials.ErrNotFound) {
+		t.Fatalf("want ErrNotFound, got: %v", err)
+	}
+	if _, err := newStore().Get(credentials.Ref("user")); err != nil {
+		t.Fatal(err)
+	}
+}
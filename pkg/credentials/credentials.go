@@ -0,0 +1,92 @@
+package credentials
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+)
+
+// The supported store types (the `store` field of the [credentials] section
+// in config.toml).
+const (
+	// StoreConfig keeps the tokens in config.toml (the default).
+	StoreConfig = "config"
+	// StoreFile keeps the tokens in an encrypted file alongside config.toml.
+	StoreFile = "file"
+	// StoreHelper delegates to an external credential helper executable.
+	StoreHelper = "helper"
+)
+
+// ErrNotFound indicates the store has no credentials for the reference.
+var ErrNotFound = errors.New("credentials not found")
+
+// Credentials are the secrets of a profile.
+type Credentials struct {
+	// AccessToken is used to acquire an API token.
+	AccessToken string `json:"access_token,omitempty"`
+	// RefreshToken is used to acquire a new access token when it expires.
+	RefreshToken string `json:"refresh_token,omitempty"`
+	// Token is used to interact with the Fastly API.
+	Token string `json:"token,omitempty"`
+}
+
+// Empty indicates if there are no secrets.
+func (c Credentials) Empty() bool {
+	return c == Credentials{}
+}
+
+// Store persists credentials, identified by a reference, outside of the CLI
+// config file.
+type Store interface {
+	// Get returns the credentials (ErrNotFound if there are none).
+	Get(ref string) (Credentials, error)
+	// Set persists the credentials.
+	Set(ref string, c Credentials) error
+	// Delete removes the credentials (it's not an error if there are none).
+	Delete(ref string) error
+}
+
+// Ref returns the reference of the credentials of the named profile.
+//
+// NOTE: It's formatted as a URL because some credential helpers (e.g. the
+// macOS keychain helper) require the 'server URL' to be one.
+func Ref(profile string) string {
+	return "fastly-cli://profile/" + url.PathEscape(profile)
+}
+
+// Opts represents the inputs for New.
+type Opts struct {
+	// Dir is the directory of the CLI config file.
+	Dir string
+	// Helper is the credential helper executable (StoreHelper).
+	Helper string
+	// In is the user's terminal stdin stream (used to prompt for a passphrase).
+	In io.Reader
+	// NonInteractive indicates the user can't be prompted.
+	NonInteractive bool
+	// Out is the user's terminal stdout stream.
+	Out io.Writer
+	// Passphrase is used to encrypt the credentials file (StoreFile). The user
+	// is prompted for it when empty.
+	Passphrase string
+	// Store is the store type.
+	Store string
+}
+
+// New returns the store configured by opts, or nil when the credentials are
+// kept in the config file.
+func New(opts Opts) (Store, error) {
+	switch opts.Store {
+	case "", StoreConfig:
+		return nil, nil
+	case StoreFile:
+		return NewFileStore(opts), nil
+	case StoreHelper:
+		if opts.Helper == "" {
+			return nil, errors.New("the credential helper store requires the [credentials] 'helper' field to be set")
+		}
+		return NewHelperStore(opts.Helper), nil
+	}
+	return nil, fmt.Errorf("unrecognised credential store '%s' (expected '%s', '%s' or '%s')", opts.Store, StoreConfig, StoreFile, StoreHelper)
+}
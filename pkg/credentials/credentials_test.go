@@ -0,0 +1,134 @@
+package credentials_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/fastly/cli/pkg/credentials"
+	"github.com/fastly/cli/pkg/testutil"
+)
+
+func TestFileStore(t *testing.T) {
+	dir := t.TempDir()
+	ref := credentials.Ref("user")
+	want := credentials.Credentials{
+		AccessToken:  "access",
+		RefreshToken: "refresh",
+		Token:        "token",
+	}
+
+	s := credentials.NewFileStore(credentials.Opts{Dir: dir, Passphrase: "secret"})
+	if _, err := s.Get(ref); !errors.Is(err, credentials.ErrNotFound) {
+		t.Fatalf("want ErrNotFound, got: %v", err)
+	}
+	if err := s.Set(ref, want); err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := os.ReadFile(filepath.Join(dir, credentials.FileName))
+	if err != nil {
+		t.Fatal(err)
+	}
+	testutil.AssertStringDoesntContain(t, string(data), "refresh")
+
+	// A new store must decrypt the file with the same passphrase.
+	s = credentials.NewFileStore(credentials.Opts{Dir: dir, Passphrase: "secret"})
+	got, err := s.Get(ref)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != want {
+		t.Fatalf("want %+v, got %+v", want, got)
+	}
+
+	s = credentials.NewFileStore(credentials.Opts{Dir: dir, Passphrase: "wrong"})
+	_, err = s.Get(ref)
+	testutil.AssertErrorContains(t, err, "failed to decrypt credentials file")
+
+	s = credentials.NewFileStore(credentials.Opts{Dir: dir, NonInteractive: true})
+	_, err = s.Get(ref)
+	testutil.AssertErrorContains(t, err, "a passphrase is required")
+
+	s = credentials.NewFileStore(credentials.Opts{Dir: dir, Passphrase: "secret"})
+	if err := s.Delete(ref); err != nil {
+		t.Fatal(err)
+	}
+	s = credentials.NewFileStore(credentials.Opts{Dir: dir, Passphrase: "secret"})
+	if _, err := s.Get(ref); !errors.Is(err, credentials.ErrNotFound) {
+		t.Fatalf("want ErrNotFound, got: %v", err)
+	}
+}
+
+// helperScript is a credential helper storing each secret in a file named
+// after the action's input (within the directory of the script).
+const helperScript = `#!/bin/sh
+dir=$(dirname "$0")
+case "$1" in
+get)
+  ref=$(cat)
+  file="$dir/$(echo "$ref" | tr -c 'a-zA-Z0-9\n' '_')"
+  if [ ! -f "$file" ]; then
+    echo "credentials not found in native keychain"
+    exit 1
+  fi
+  cat "$file"
+  ;;
+store)
+  input=$(cat)
+  ref=$(echo "$input" | sed 's/.*"ServerURL":"\([^"]*\)".*/\1/')
+  echo "$input" > "$dir/$(echo "$ref" | tr -c 'a-zA-Z0-9\n' '_')"
+  ;;
+erase)
+  ref=$(cat)
+  rm -f "$dir/$(echo "$ref" | tr -c 'a-zA-Z0-9\n' '_')"
+  ;;
+*)
+  exit 1
+  ;;
+esac
+`
+
+func TestHelperStore(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("the test credential helper is a shell script")
+	}
+
+	dir := t.TempDir()
+	helper := filepath.Join(dir, "fastly-credential-helper")
+	if err := os.WriteFile(helper, []byte(helperScript), 0o700); err != nil { // #nosec G306
+		t.Fatal(err)
+	}
+
+	s, err := credentials.New(credentials.Opts{Helper: helper, Store: credentials.StoreHelper})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ref := credentials.Ref("user")
+	want := credentials.Credentials{Token: "token"}
+	if _, err := s.Get(ref); !errors.Is(err, credentials.ErrNotFound) {
+		t.Fatalf("want ErrNotFound, got: %v", err)
+	}
+	if err := s.Set(ref, want); err != nil {
+		t.Fatal(err)
+	}
+	got, err := s.Get(ref)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != want {
+		t.Fatalf("want %+v, got %+v", want, got)
+	}
+	if err := s.Delete(ref); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.Get(ref); !errors.Is(err, credentials.ErrNotFound) {
+		t.Fatalf("want ErrNotFound, got: %v", err)
+	}
+
+	_, err = credentials.New(credentials.Opts{Store: credentials.StoreHelper})
+	testutil.AssertErrorContains(t, err, "requires the [credentials] 'helper' field")
+}
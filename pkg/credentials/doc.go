@@ -0,0 +1,4 @@
+// Package credentials stores the secrets of a profile (API, access and refresh
+// tokens) outside of the CLI's config.toml, either within an encrypted file or
+// using an external credential helper.
+package credentials
@@ -0,0 +1,262 @@
+package credentials
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"golang.org/x/crypto/scrypt"
+
+	"github.com/fastly/cli/pkg/env"
+	fsterr "github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/text"
+)
+
+// FileName is the name of the encrypted credentials file (within the CLI
+// config directory).
+const FileName = "credentials.enc"
+
+// FilePermissions is the file permissions for the encrypted credentials file.
+const FilePermissions = 0o600
+
+// The scrypt parameters used to derive the encryption key from the passphrase.
+// These are recorded in the file so they can be changed in future.
+const (
+	scryptN      = 32768
+	scryptR      = 8
+	scryptP      = 1
+	scryptKeyLen = 32
+)
+
+// encryptedFile is the content of the encrypted credentials file.
+//
+// The credentials are encoded as a JSON object (keyed by reference) and
+// encrypted using AES-256-GCM, with a key derived from the passphrase using
+// scrypt.
+type encryptedFile struct {
+	Ciphertext []byte `json:"ciphertext"`
+	KDF        string `json:"kdf"`
+	N          int    `json:"n"`
+	Nonce      []byte `json:"nonce"`
+	P          int    `json:"p"`
+	R          int    `json:"r"`
+	Salt       []byte `json:"salt"`
+	Version    int    `json:"version"`
+}
+
+// FileStore keeps credentials in a passphrase encrypted file.
+type FileStore struct {
+	credentials    map[string]Credentials
+	in             io.Reader
+	key            []byte
+	loaded         bool
+	nonInteractive bool
+	out            io.Writer
+	passphrase     string
+	path           string
+	salt           []byte
+}
+
+// NewFileStore returns a store for the encrypted file within opts.Dir.
+func NewFileStore(opts Opts) *FileStore {
+	return &FileStore{
+		in:             opts.In,
+		nonInteractive: opts.NonInteractive,
+		out:            opts.Out,
+		passphrase:     opts.Passphrase,
+		path:           filepath.Join(opts.Dir, FileName),
+	}
+}
+
+// Get implements the Store interface.
+func (s *FileStore) Get(ref string) (Credentials, error) {
+	if err := s.load(); err != nil {
+		return Credentials{}, err
+	}
+	c, ok := s.credentials[ref]
+	if !ok {
+		return c, ErrNotFound
+	}
+	return c, nil
+}
+
+// Set implements the Store interface.
+func (s *FileStore) Set(ref string, c Credentials) error {
+	if err := s.load(); err != nil {
+		return err
+	}
+	if existing, ok := s.credentials[ref]; ok && existing == c {
+		return nil
+	}
+	s.credentials[ref] = c
+	return s.save()
+}
+
+// Delete implements the Store interface.
+func (s *FileStore) Delete(ref string) error {
+	if err := s.load(); err != nil {
+		return err
+	}
+	if _, ok := s.credentials[ref]; !ok {
+		return nil
+	}
+	delete(s.credentials, ref)
+	return s.save()
+}
+
+// load decrypts the credentials file (once). A missing file is treated as
+// having no credentials.
+func (s *FileStore) load() error {
+	if s.loaded {
+		return nil
+	}
+
+	// gosec flagged this:
+	// G304 (CWE-22): Potential file inclusion via variable
+	// Disabling as the file is within the CLI config directory.
+	// #nosec
+	data, err := os.ReadFile(s.path)
+	if errors.Is(err, os.ErrNotExist) {
+		s.credentials = make(map[string]Credentials)
+		s.loaded = true
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading credentials file '%s': %w", s.path, err)
+	}
+
+	var f encryptedFile
+	if err := json.Unmarshal(data, &f); err != nil {
+		return fmt.Errorf("error parsing credentials file '%s': %w", s.path, err)
+	}
+	if f.Version != 1 || f.KDF != "scrypt" {
+		return fmt.Errorf("unsupported credentials file '%s' (version %d, kdf %s)", s.path, f.Version, f.KDF)
+	}
+
+	passphrase, err := s.readPassphrase()
+	if err != nil {
+		return err
+	}
+	key, err := scrypt.Key([]byte(passphrase), f.Salt, f.N, f.R, f.P, scryptKeyLen)
+	if err != nil {
+		return fmt.Errorf("error deriving credentials file key: %w", err)
+	}
+	gcm, err := newGCM(key)
+	if err != nil {
+		return err
+	}
+	plaintext, err := gcm.Open(nil, f.Nonce, f.Ciphertext, nil)
+	if err != nil {
+		return fsterr.RemediationError{
+			Inner:       fmt.Errorf("failed to decrypt credentials file '%s'", s.path),
+			Remediation: fmt.Sprintf("Check the passphrase (%s) is correct.", env.CredentialsPassphrase),
+		}
+	}
+
+	credentials := make(map[string]Credentials)
+	if err := json.Unmarshal(plaintext, &credentials); err != nil {
+		return fmt.Errorf("error parsing decrypted credentials file '%s': %w", s.path, err)
+	}
+
+	s.credentials = credentials
+	s.key = key
+	s.salt = f.Salt
+	s.loaded = true
+	return nil
+}
+
+// save encrypts the credentials and writes them to disk.
+//
+// NOTE: A new file is encrypted with a key derived from a new salt, otherwise
+// the key derived when the file was loaded is reused (with a new nonce).
+func (s *FileStore) save() error {
+	if s.key == nil {
+		passphrase, err := s.readPassphrase()
+		if err != nil {
+			return err
+		}
+		salt := make([]byte, 16)
+		if _, err := rand.Read(salt); err != nil {
+			return err
+		}
+		key, err := scrypt.Key([]byte(passphrase), salt, scryptN, scryptR, scryptP, scryptKeyLen)
+		if err != nil {
+			return fmt.Errorf("error deriving credentials file key: %w", err)
+		}
+		s.key, s.salt = key, salt
+	}
+
+	plaintext, err := json.Marshal(s.credentials)
+	if err != nil {
+		return err
+	}
+	gcm, err := newGCM(s.key)
+	if err != nil {
+		return err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(encryptedFile{
+		Ciphertext: gcm.Seal(nil, nonce, plaintext, nil),
+		KDF:        "scrypt",
+		N:          scryptN,
+		Nonce:      nonce,
+		P:          scryptP,
+		R:          scryptR,
+		Salt:       s.salt,
+		Version:    1,
+	}, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(s.path), 0o700); err != nil {
+		return err
+	}
+	if err := os.WriteFile(s.path, data, FilePermissions); err != nil {
+		return fmt.Errorf("error writing credentials file '%s': %w", s.path, err)
+	}
+	return nil
+}
+
+// readPassphrase returns the configured passphrase, otherwise the user is
+// prompted for it.
+func (s *FileStore) readPassphrase() (string, error) {
+	if s.passphrase != "" {
+		return s.passphrase, nil
+	}
+	if s.nonInteractive || s.in == nil || s.out == nil {
+		return "", fsterr.RemediationError{
+			Inner:       errors.New("a passphrase is required to access the encrypted credentials file"),
+			Remediation: fmt.Sprintf("Set the passphrase using the %s environment variable.", env.CredentialsPassphrase),
+		}
+	}
+	passphrase, err := text.InputSecure(s.out, "Passphrase for the encrypted credentials file: ", s.in, func(v string) error {
+		if v == "" {
+			return errors.New("passphrase can't be empty")
+		}
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+	text.Break(s.out)
+	s.passphrase = passphrase
+	return passphrase, nil
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,119 @@
+package credentials
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os/exec"
+	"strings"
+)
+
+// HelperUsername is the username recorded with the credentials by a helper.
+const HelperUsername = "fastly-cli"
+
+// helperCredentials is the message exchanged with a credential helper.
+//
+// NOTE: The protocol is the one used by Docker credential helpers
+// (https://github.com/docker/docker-credential-helpers), so existing helpers
+// (e.g. docker-credential-osxkeychain, docker-credential-pass) can be used.
+type helperCredentials struct {
+	ServerURL string `json:"ServerURL"`
+	Username  string `json:"Username"`
+	Secret    string `json:"Secret"`
+}
+
+// HelperStore delegates to an external credential helper executable.
+//
+// The helper is run with one of the actions 'get', 'store' or 'erase':
+//
+//   - get: the reference is written to stdin, and a JSON object with the
+//     ServerURL, Username and Secret fields is expected on stdout.
+//   - store: a JSON object with the ServerURL, Username and Secret fields is
+//     written to stdin.
+//   - erase: the reference is written to stdin.
+//
+// The Secret is the JSON encoded credentials.
+type HelperStore struct {
+	helper string
+}
+
+// NewHelperStore returns a store using the helper executable.
+func NewHelperStore(helper string) *HelperStore {
+	return &HelperStore{helper: helper}
+}
+
+// Get implements the Store interface.
+func (s *HelperStore) Get(ref string) (Credentials, error) {
+	var c Credentials
+	out, err := s.run("get", []byte(ref))
+	if err != nil {
+		if isHelperNotFound(out, err) {
+			return c, ErrNotFound
+		}
+		return c, err
+	}
+
+	var hc helperCredentials
+	if err := json.Unmarshal(out, &hc); err != nil {
+		return c, fmt.Errorf("error parsing credential helper '%s' response: %w", s.helper, err)
+	}
+	if err := json.Unmarshal([]byte(hc.Secret), &c); err != nil {
+		return c, fmt.Errorf("error parsing credentials from credential helper '%s': %w", s.helper, err)
+	}
+	return c, nil
+}
+
+// Set implements the Store interface.
+func (s *HelperStore) Set(ref string, c Credentials) error {
+	secret, err := json.Marshal(c)
+	if err != nil {
+		return err
+	}
+	data, err := json.Marshal(helperCredentials{
+		ServerURL: ref,
+		Username:  HelperUsername,
+		Secret:    string(secret),
+	})
+	if err != nil {
+		return err
+	}
+	_, err = s.run("store", data)
+	return err
+}
+
+// Delete implements the Store interface.
+func (s *HelperStore) Delete(ref string) error {
+	out, err := s.run("erase", []byte(ref))
+	if err != nil && !isHelperNotFound(out, err) {
+		return err
+	}
+	return nil
+}
+
+// run executes the helper action, returning its output.
+func (s *HelperStore) run(action string, input []byte) ([]byte, error) {
+	var stdout, stderr bytes.Buffer
+	// gosec flagged this:
+	// G204 (CWE-78): Subprocess launched with variable
+	// Disabling as the helper is configured by the user.
+	// #nosec
+	cmd := exec.Command(s.helper, action)
+	cmd.Stdin = bytes.NewReader(input)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		msg := strings.TrimSpace(stdout.String() + " " + stderr.String())
+		if msg != "" {
+			return []byte(msg), fmt.Errorf("credential helper '%s %s' failed: %w: %s", s.helper, action, err, msg)
+		}
+		return nil, fmt.Errorf("credential helper '%s %s' failed: %w", s.helper, action, err)
+	}
+	return stdout.Bytes(), nil
+}
+
+// isHelperNotFound indicates the helper failed because it has no credentials
+// for the reference (Docker helpers report 'credentials not found in native
+// keychain').
+func isHelperNotFound(out []byte, err error) bool {
+	return err != nil && strings.Contains(strings.ToLower(string(out)), "credentials not found")
+}
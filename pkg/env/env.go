@@ -24,6 +24,14 @@ const (
 	// #nosec
 	APIToken = "FASTLY_API_TOKEN"
 
+	// CredentialsPassphrase is the env var we look in for the passphrase of the
+	// encrypted credentials file (the 'file' credential store).
+	// gosec flagged this:
+	// G101 (CWE-798): Potential hardcoded credentials
+	// Disabling as we use the value in the command help output.
+	// #nosec
+	CredentialsPassphrase = "FASTLY_CREDENTIALS_PASSPHRASE"
+
 	// CustomerID is the env var we look in for a Customer ID.
 	CustomerID = "FASTLY_CUSTOMER_ID"
 
@@ -31,9 +39,18 @@ const (
 	// Set to "true" to enable debug mode.
 	DebugMode = "FASTLY_DEBUG_MODE"
 
+	// MaxRetries is the env var we look in for the maximum number of times a
+	// failed API call is retried.
+	MaxRetries = "FASTLY_MAX_RETRIES"
+
 	// ServiceID is the env var we look in for the required Service ID.
 	ServiceID = "FASTLY_SERVICE_ID"
 
+	// SSODevice enables the OAuth device authorization flow for SSO, which
+	// doesn't require a browser on the same machine as the CLI.
+	// Assigned value should be a boolean 1/0 (enable/disable).
+	SSODevice = "FASTLY_SSO_DEVICE"
+
 	// UseSSO enables the CLI to validate the token as an OAuth token.
 	// These tokens aren't traditional tokens generated by the UI.
 	// Instead they generated via an OAuth flow (producing access/refresh tokens).
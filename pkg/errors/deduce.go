@@ -13,8 +13,8 @@ import (
 // Deduce attempts to deduce a RemediationError from a plain error. If the error
 // is already a RemediationError it is returned directly. Certain deep error
 // types, like a Fastly SDK HTTPError, are detected and converted in appropriate
-// cases to e.g. AuthRemediation. If no specific remediation can be suggested, a
-// remediation to file a bug is used.
+// cases to e.g. AuthRemediation (401) or TokenScopeRemediation (403). If no
+// specific remediation can be suggested, a remediation to file a bug is used.
 func Deduce(err error) RemediationError {
 	var re RemediationError
 	if errors.As(err, &re) {
@@ -25,8 +25,15 @@ func Deduce(err error) RemediationError {
 	if errors.As(err, &httpError) {
 		remediation := BugRemediation
 
-		if httpError.StatusCode == http.StatusUnauthorized {
+		switch {
+		case httpError.StatusCode == http.StatusUnauthorized:
 			remediation = AuthRemediation
+		case httpError.StatusCode == http.StatusForbidden:
+			remediation = TokenScopeRemediation
+		case httpError.StatusCode == http.StatusTooManyRequests:
+			remediation = RateLimitRemediation
+		case httpError.StatusCode >= http.StatusInternalServerError:
+			remediation = ServerErrorRemediation
 		}
 
 		return RemediationError{Inner: SimplifyFastlyError(*httpError), Remediation: remediation}
@@ -17,6 +17,9 @@ func TestDeduce(t *testing.T) {
 		re2             = errors.RemediationError{Inner: fmt.Errorf("bar"), Remediation: "Reticulate your splines."}
 		http503         = &fastly.HTTPError{StatusCode: http.StatusInternalServerError}
 		http401         = &fastly.HTTPError{StatusCode: http.StatusUnauthorized}
+		http403         = &fastly.HTTPError{StatusCode: http.StatusForbidden}
+		http404         = &fastly.HTTPError{StatusCode: http.StatusNotFound}
+		http429         = &fastly.HTTPError{StatusCode: http.StatusTooManyRequests}
 		wrappedNotExist = fmt.Errorf("couldn't do the thing: %w", os.ErrNotExist)
 	)
 
@@ -38,13 +41,28 @@ func TestDeduce(t *testing.T) {
 		{
 			name:  "fastly.HTTPError 503",
 			input: http503,
-			want:  errors.RemediationError{Inner: errors.SimplifyFastlyError(*http503), Remediation: errors.BugRemediation},
+			want:  errors.RemediationError{Inner: errors.SimplifyFastlyError(*http503), Remediation: errors.ServerErrorRemediation},
+		},
+		{
+			name:  "fastly.HTTPError 404",
+			input: http404,
+			want:  errors.RemediationError{Inner: errors.SimplifyFastlyError(*http404), Remediation: errors.BugRemediation},
+		},
+		{
+			name:  "fastly.HTTPError 429",
+			input: http429,
+			want:  errors.RemediationError{Inner: errors.SimplifyFastlyError(*http429), Remediation: errors.RateLimitRemediation},
 		},
 		{
 			name:  "fastly.HTTPError 401",
 			input: http401,
 			want:  errors.RemediationError{Inner: errors.SimplifyFastlyError(*http401), Remediation: errors.AuthRemediation},
 		},
+		{
+			name:  "fastly.HTTPError 403",
+			input: http403,
+			want:  errors.RemediationError{Inner: errors.SimplifyFastlyError(*http403), Remediation: errors.TokenScopeRemediation},
+		},
 		{
 			name:  "wrapped os.ErrNotExist",
 			input: wrappedNotExist,
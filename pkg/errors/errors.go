@@ -117,6 +117,13 @@ var ErrInvalidVerboseJSONCombo = RemediationError{
 	Remediation: "Use either --verbose or --json, not both.",
 }
 
+// ErrInvalidRecordReplayCombo means the user provided both a --record and
+// --replay flag which are mutually exclusive behaviours.
+var ErrInvalidRecordReplayCombo = RemediationError{
+	Inner:       fmt.Errorf("invalid flag combination, --record and --replay"),
+	Remediation: "Use either --record or --replay, not both.",
+}
+
 // ErrInvalidDeleteAllJSONKeyCombo means the user provided both a --all and
 // --json flag which are mutually exclusive behaviours.
 var ErrInvalidDeleteAllJSONKeyCombo = RemediationError{
@@ -59,12 +59,34 @@ var AuthRemediation = fmt.Sprintf(strings.Join([]string{
 	"Verify that the token is still valid via `fastly whoami`.",
 }, " "), env.APIToken)
 
+// TokenScopeRemediation suggests checking the scope and services of the
+// provided token.
+var TokenScopeRemediation = strings.Join([]string{
+	"This error may be caused by a Fastly API token without the required scope (e.g. a 'global:read' token can't modify a service)",
+	"or a token restricted to other services.",
+	"Check the scope, services and expiry of the token via `fastly whoami --verbose` or `fastly profile list`,",
+	"and if necessary create a token with the required scope (e.g. `fastly auth-token create --scope global`).",
+}, " ")
+
 // NetworkRemediation suggests, somewhat unhelpfully, to try again later.
 var NetworkRemediation = strings.Join([]string{
 	"This error may be caused by transient network issues.",
 	"Please verify your network connection and DNS configuration, and try again.",
 }, " ")
 
+// RateLimitRemediation suggests waiting for the API rate limit to reset.
+var RateLimitRemediation = fmt.Sprintf(strings.Join([]string{
+	"This error is caused by exceeding the Fastly API rate limit.",
+	"Wait for the rate limit to reset and try again, or allow more retries via --max-retries",
+	"or the environment variable %s.",
+}, " "), env.MaxRetries)
+
+// ServerErrorRemediation suggests the API error may be transient.
+var ServerErrorRemediation = fmt.Sprintf(strings.Join([]string{
+	"This error may be caused by a transient issue with the Fastly API.",
+	"Please try again, or allow more retries via --max-retries or the environment variable %s.",
+}, " "), env.MaxRetries)
+
 // HostRemediation suggests there might be an issue with the local host.
 var HostRemediation = strings.Join([]string{
 	"This error may be caused by a problem with your host environment, for example",
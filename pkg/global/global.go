@@ -2,6 +2,7 @@ package global
 
 import (
 	"io"
+	"strconv"
 
 	"github.com/fastly/cli/pkg/api"
 	"github.com/fastly/cli/pkg/auth"
@@ -18,6 +19,10 @@ const DefaultAPIEndpoint = "https://api.fastly.com"
 // DefaultAccountEndpoint is the default Fastly Accounts endpoint.
 const DefaultAccountEndpoint = "https://accounts.fastly.com"
 
+// DefaultMaxRetries is the default number of times a failed API call is
+// retried.
+const DefaultMaxRetries = 3
+
 // APIClientFactory creates a Fastly API client (modeled as an api.Interface)
 // from a user-provided API token. It exists as a type in order to parameterize
 // the Run helper with it: in the real CLI, we can use NewClient from the Fastly
@@ -94,6 +99,7 @@ type Data struct {
 //   - The --token flag.
 //   - The FASTLY_API_TOKEN environment variable.
 //   - The --profile flag's associated token.
+//   - The `profile` project file (.fastly) field's associated profile token.
 //   - The `profile` manifest field's associated profile token.
 //   - The 'default' profile associated token (if there is one).
 func (d *Data) Token() (string, lookup.Source) {
@@ -107,32 +113,50 @@ func (d *Data) Token() (string, lookup.Source) {
 		return d.Env.APIToken, lookup.SourceEnvironment
 	}
 
-	// --profile
-	if d.Flags.Profile != "" {
-		for k, v := range d.Config.Profiles {
-			if k == d.Flags.Profile {
-				return v.Token, lookup.SourceFile
-			}
-		}
+	// --profile, fastly.toml, .fastly or the default profile
+	if name, p := d.Profile(); p != nil {
+		return d.profileToken(name, p), lookup.SourceFile
 	}
 
-	// `profile` field in fastly.toml
-	if d.Manifest.File.Profile != "" {
-		for k, v := range d.Config.Profiles {
-			if k == d.Manifest.File.Profile {
-				return v.Token, lookup.SourceFile
-			}
+	return "", lookup.SourceUndefined
+}
+
+// Profile yields the profile used for API requests (nil if there isn't one).
+//
+// Order of precedence:
+//   - The --profile flag.
+//   - The `profile` manifest field.
+//   - The `profile` project file (.fastly) field.
+//   - The 'default' profile (if there is one).
+//
+// NOTE: A named profile that doesn't exist is skipped.
+func (d *Data) Profile() (string, *config.Profile) {
+	for _, name := range []string{d.Flags.Profile, d.Manifest.File.Profile, d.Manifest.Project.Profile} {
+		if name == "" {
+			continue
+		}
+		if p, ok := d.Config.Profiles[name]; ok {
+			return name, p
 		}
 	}
-
-	// [profile] section in app config
-	for _, v := range d.Config.Profiles {
+	for k, v := range d.Config.Profiles {
 		if v.Default {
-			return v.Token, lookup.SourceFile
+			return k, v
 		}
 	}
+	return "", nil
+}
 
-	return "", lookup.SourceUndefined
+// profileToken returns the token of the profile, loading it from the
+// credential store if necessary.
+//
+// NOTE: A failure to load the token is logged, and reported later when the
+// token is processed (see app.processToken).
+func (d *Data) profileToken(name string, p *config.Profile) string {
+	if err := d.Config.LoadCredentials(name); err != nil {
+		d.ErrLog.Add(err)
+	}
+	return p.Token
 }
 
 // Verbose yields the verbose flag, which can only be set via flags.
@@ -150,6 +174,10 @@ func (d *Data) APIEndpoint() (string, lookup.Source) {
 		return d.Env.APIEndpoint, lookup.SourceEnvironment
 	}
 
+	if d.Manifest.Project.APIEndpoint != "" {
+		return d.Manifest.Project.APIEndpoint, lookup.SourceProject
+	}
+
 	if d.Config.Fastly.APIEndpoint != DefaultAPIEndpoint && d.Config.Fastly.APIEndpoint != "" {
 		return d.Config.Fastly.APIEndpoint, lookup.SourceFile
 	}
@@ -174,6 +202,27 @@ func (d *Data) AccountEndpoint() (string, lookup.Source) {
 	return DefaultAccountEndpoint, lookup.SourceDefault // this method should not fail
 }
 
+// MaxRetries yields the maximum number of times a failed API call is retried.
+//
+// NOTE: An invalid FASTLY_MAX_RETRIES value is ignored.
+func (d *Data) MaxRetries() (int, lookup.Source) {
+	if d.Flags.MaxRetries >= 0 {
+		return d.Flags.MaxRetries, lookup.SourceFlag
+	}
+
+	if d.Env.MaxRetries != "" {
+		if i, err := strconv.Atoi(d.Env.MaxRetries); err == nil && i >= 0 {
+			return i, lookup.SourceEnvironment
+		}
+	}
+
+	if d.Config.Fastly.MaxRetries != nil && *d.Config.Fastly.MaxRetries >= 0 {
+		return *d.Config.Fastly.MaxRetries, lookup.SourceFile
+	}
+
+	return DefaultMaxRetries, lookup.SourceDefault
+}
+
 // Flags represents all of the configuration parameters that can be set with
 // explicit flags. Consumers should bind their flag values to these fields
 // directly.
@@ -191,12 +240,21 @@ type Flags struct {
 	AutoYes bool
 	// Debug enables the CLI's debug mode.
 	Debug bool
+	// DryRun prints API calls that modify resources instead of making them.
+	DryRun bool
+	// MaxRetries is the maximum number of times a failed API call is retried.
+	// A negative value indicates the flag wasn't set.
+	MaxRetries int
 	// NonInteractive auto-resolves all prompts.
 	NonInteractive bool
 	// Profile indicates the profile to use (consequently the 'token' used).
 	Profile string
 	// Quiet silences all output except direct command output.
 	Quiet bool
+	// Record is a directory to record HTTP exchanges into (see Replay).
+	Record string
+	// Replay is a directory to replay recorded HTTP exchanges from.
+	Replay string
 	// SSO enables to SSO authentication tokens for the current profile.
 	SSO bool
 	// Token is an override for a profile (when passed SSO is disabled).
@@ -19,4 +19,7 @@ const (
 
 	// SourceDefault indicates the parameter came from a program default.
 	SourceDefault
+
+	// SourceProject indicates the parameter came from the `.fastly` project file.
+	SourceProject
 )
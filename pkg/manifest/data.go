@@ -4,6 +4,7 @@ import (
 	"os"
 
 	"github.com/fastly/cli/pkg/env"
+	"github.com/fastly/cli/pkg/project"
 )
 
 // Data holds global-ish manifest data from manifest files, and flag sources.
@@ -11,11 +12,18 @@ import (
 // including the place the parameter came from, which is a requirement.
 //
 // If the same parameter is defined in multiple places, it is resolved according
-// to the following priority order: the manifest file (lowest priority) and then
-// environment variables (where applicable), and explicit flags (highest priority).
+// to the following priority order: the `.fastly` project file (lowest
+// priority), the manifest file, environment variables (where applicable), and
+// then explicit flags (highest priority).
+//
+// NOTE: The project file may be found in a parent directory (e.g. the root of a
+// monorepo), so it only fills the gaps left by the manifest file.
 type Data struct {
 	File File
 	Flag Flag
+	// Project is the `.fastly` project file (if any) found by walking up from
+	// the current directory.
+	Project project.File
 }
 
 // Authors yields an Authors.
@@ -63,5 +71,9 @@ func (d *Data) ServiceID() (string, Source) {
 		return d.File.ServiceID, SourceFile
 	}
 
+	if d.Project.ServiceID != "" {
+		return d.Project.ServiceID, SourceProject
+	}
+
 	return "", SourceUndefined
 }
@@ -169,6 +169,18 @@ func (f *File) ReadError() error {
 	return f.readError
 }
 
+// Reset clears the manifest content so the File can be reused to Read a
+// different manifest, while keeping Args and the error log, output and quiet
+// settings.
+func (f *File) Reset() {
+	*f = File{
+		Args:   f.Args,
+		errLog: f.errLog,
+		output: f.output,
+		quiet:  f.quiet,
+	}
+}
+
 // SetErrLog sets an instance of errors.LogInterface.
 func (f *File) SetErrLog(errLog fsterr.LogInterface) {
 	f.errLog = errLog
@@ -250,8 +262,17 @@ type Scripts struct {
 	EnvVars []string `toml:"env_vars,omitempty"`
 	// PostBuild is executed after the build step.
 	PostBuild string `toml:"post_build,omitempty"`
+	// PostDeploy is executed after the deploy step.
+	// The service ID, version and URL are exposed as environment variables.
+	PostDeploy string `toml:"post_deploy,omitempty"`
 	// PostInit is executed after the init step.
 	PostInit string `toml:"post_init,omitempty"`
+	// PreBuild is executed before the build step.
+	PreBuild string `toml:"pre_build,omitempty"`
+	// PreDeploy is executed before the deploy step.
+	PreDeploy string `toml:"pre_deploy,omitempty"`
+	// Test is executed after the build step (and any post_build script).
+	Test string `toml:"test,omitempty"`
 
 	// Private field used to revert modifications to EnvVars from EnvFile.
 	// See File.ParseEnvFile() and File.Write() methods for details.
@@ -31,6 +31,9 @@ const (
 	// SourceFlag indicates the parameter came from an explicit flag.
 	SourceFlag
 
+	// SourceProject indicates the parameter came from the `.fastly` project file.
+	SourceProject
+
 	// SpecIntro informs the user of what the manifest file is for.
 	SpecIntro = "This file describes a Fastly Compute package. To learn more visit:"
 
@@ -13,6 +13,7 @@ import (
 	"github.com/fastly/cli/pkg/env"
 	fsterr "github.com/fastly/cli/pkg/errors"
 	"github.com/fastly/cli/pkg/manifest"
+	"github.com/fastly/cli/pkg/project"
 	"github.com/fastly/cli/pkg/testutil"
 	"github.com/fastly/cli/pkg/threadsafe"
 )
@@ -226,11 +227,21 @@ func TestDataServiceID(t *testing.T) {
 	}
 
 	// SourceFile
+	//
+	// NOTE: The project file (.fastly) only fills the gaps of the manifest.
 	t.Setenv(env.ServiceID, "")
-	_, src = d.ServiceID()
-	if src != manifest.SourceFile {
+	d.Project = project.File{ServiceID: "789"}
+	sid, src := d.ServiceID()
+	if src != manifest.SourceFile || sid != "456" {
 		t.Fatal("expected SourceFile")
 	}
+
+	// SourceProject
+	d.File = manifest.File{}
+	sid, src = d.ServiceID()
+	if src != manifest.SourceProject || sid != "789" {
+		t.Fatal("expected SourceProject")
+	}
 }
 
 // This test validates that manually added changes, such as the toml
@@ -1,6 +1,10 @@
 package profile
 
 import (
+	"time"
+
+	"github.com/fastly/go-fastly/v8/fastly"
+
 	"github.com/fastly/cli/pkg/config"
 )
 
@@ -10,6 +14,10 @@ const DefaultName = "user"
 // DoesNotExist describes an output error/warning message.
 const DoesNotExist = "the profile '%s' does not exist"
 
+// TokenExpiryWarningDays is the default number of days before a token expires
+// that the user is warned (see the [cli] token_expiry_warning_days field).
+const TokenExpiryWarningDays = 7
+
 // NoDefaults describes an output warning message.
 const NoDefaults = "At least one account profile should be set as the 'default'. Run `fastly profile update <NAME>` and ensure the profile is set to be the default."
 
@@ -110,3 +118,23 @@ func Edit(name string, p config.Profiles, opts ...EditOption) (config.Profiles,
 	}
 	return p, ok
 }
+
+// TokenExpiry returns when the token expires as a Unix timestamp (zero if it
+// doesn't expire).
+func TokenExpiry(t *fastly.Token) int64 {
+	if t == nil || t.ExpiresAt == nil || t.ExpiresAt.IsZero() {
+		return 0
+	}
+	return t.ExpiresAt.Unix()
+}
+
+// TokenExpiresWithin reports whether the profile's token is known to expire
+// (or have expired) within the number of days from now, along with when it
+// expires.
+func TokenExpiresWithin(p *config.Profile, days int, now time.Time) (time.Time, bool) {
+	if p == nil || p.TokenExpiresAt == 0 || days < 0 {
+		return time.Time{}, false
+	}
+	expires := time.Unix(p.TokenExpiresAt, 0)
+	return expires, expires.Before(now.AddDate(0, 0, days))
+}
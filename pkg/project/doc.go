@@ -0,0 +1,3 @@
+// Package project reads the per-directory `.fastly` project file, which binds
+// a directory (and its subdirectories) to a profile, service and API endpoint.
+package project
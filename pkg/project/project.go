@@ -0,0 +1,153 @@
+package project
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	toml "github.com/pelletier/go-toml"
+
+	fsterr "github.com/fastly/cli/pkg/errors"
+)
+
+// Filename is the name of the project file.
+const Filename = ".fastly"
+
+// deniedFlags are the global flags that can't be set in the project file.
+//
+// NOTE: A project file may come from a cloned repository, so it mustn't be able
+// to suppress confirmations or change the token or the endpoints it's sent to.
+var deniedFlags = map[string]bool{
+	"accept-defaults": true,
+	"account":         true,
+	"api":             true,
+	"auto-yes":        true,
+	"non-interactive": true,
+	"token":           true,
+}
+
+// File represents the `.fastly` project file.
+//
+// Example:
+//
+//	profile = "staging"
+//	service_id = "SU1Z0isxPaozGVKXdv0eY"
+//	api_endpoint = "https://api.fastly.com"
+//	flags = ["--verbose", "--max-retries=5"]
+type File struct {
+	// APIEndpoint is the Fastly API endpoint.
+	APIEndpoint string `toml:"api_endpoint,omitempty"`
+	// Flags are global flags applied to every command (unless also provided).
+	Flags []string `toml:"flags,omitempty"`
+	// Profile is the name of the profile account to use.
+	Profile string `toml:"profile,omitempty"`
+	// ServiceID is the default Fastly Service ID.
+	ServiceID string `toml:"service_id,omitempty"`
+
+	path string
+}
+
+// Path yields the location of the project file (empty if there isn't one).
+func (f *File) Path() string {
+	return f.path
+}
+
+// Exists yields whether a project file was found.
+func (f *File) Exists() bool {
+	return f.path != ""
+}
+
+// Find walks up from the directory until it finds a project file, returning
+// its path (empty if there isn't one).
+//
+// NOTE: Directories named `.fastly` (e.g. ~/.fastly which may contain the CLI
+// config file) are skipped.
+func Find(dir string) (string, error) {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return "", err
+	}
+	for {
+		path := filepath.Join(dir, Filename)
+		fi, err := os.Stat(path)
+		if err == nil && fi.Mode().IsRegular() {
+			return path, nil
+		}
+		if err != nil && !errors.Is(err, os.ErrNotExist) {
+			return "", err
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return "", nil
+		}
+		dir = parent
+	}
+}
+
+// Read finds and decodes the project file for the directory.
+// It's not an error if there isn't one.
+func (f *File) Read(dir string) error {
+	path, err := Find(dir)
+	if err != nil || path == "" {
+		return err
+	}
+
+	// gosec flagged this:
+	// G304 (CWE-22): Potential file inclusion via variable
+	// Disabling as we need to load the project file from the user's file system.
+	// This file is decoded into a predefined struct, any unrecognised fields are dropped.
+	// #nosec
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("error reading project file '%s': %w", path, err)
+	}
+	if err := toml.Unmarshal(data, f); err != nil {
+		return fsterr.RemediationError{
+			Inner:       fmt.Errorf("invalid project file '%s': %w", path, err),
+			Remediation: "Fix the syntax of the project file (it's a TOML file) or remove it.",
+		}
+	}
+	for _, flag := range f.Flags {
+		if !strings.HasPrefix(flag, "--") {
+			return fsterr.RemediationError{
+				Inner:       fmt.Errorf("invalid flag '%s' in project file '%s'", flag, path),
+				Remediation: "Flags in the project file must use their long form (e.g. --verbose, --max-retries=5).",
+			}
+		}
+		if deniedFlags[FlagName(flag)] {
+			return fsterr.RemediationError{
+				Inner:       fmt.Errorf("the flag '%s' can't be set in project file '%s'", flag, path),
+				Remediation: "Flags that suppress prompts, or set the API token or endpoints, must be provided on the command line.",
+			}
+		}
+	}
+	f.path = path
+	return nil
+}
+
+// FlagName returns the name of a flag in the `--name` or `--name=value` form.
+func FlagName(flag string) string {
+	name, _, _ := strings.Cut(strings.TrimPrefix(flag, "--"), "=")
+	return name
+}
+
+// TrustedAPIEndpoint reports whether the api_endpoint of a project file can be
+// used, which is when it's the endpoint set in the CLI config or a Fastly
+// domain (over HTTPS).
+//
+// NOTE: A project file may come from a cloned repository, and the API token is
+// sent to the endpoint.
+func TrustedAPIEndpoint(endpoint, configEndpoint string) bool {
+	if endpoint == configEndpoint {
+		return true
+	}
+	u, err := url.Parse(endpoint)
+	if err != nil || u.Scheme != "https" || u.User != nil {
+		return false
+	}
+	host := u.Hostname()
+	return host == "fastly.com" || strings.HasSuffix(host, ".fastly.com")
+}
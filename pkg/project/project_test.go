@@ -0,0 +1,92 @@
+package project_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/fastly/cli/pkg/project"
+	"github.com/fastly/cli/pkg/testutil"
+)
+
+func TestRead(t *testing.T) {
+	root := t.TempDir()
+	nested := filepath.Join(root, "a", "b")
+	if err := os.MkdirAll(nested, 0o750); err != nil {
+		t.Fatal(err)
+	}
+
+	// A directory named .fastly (e.g. ~/.fastly) isn't a project file.
+	if err := os.MkdirAll(filepath.Join(root, "a", project.Filename), 0o750); err != nil {
+		t.Fatal(err)
+	}
+
+	var f project.File
+	if err := f.Read(nested); err != nil {
+		t.Fatal(err)
+	}
+	if f.Exists() {
+		t.Fatalf("unexpected project file: %s", f.Path())
+	}
+
+	path := filepath.Join(root, project.Filename)
+	content := "profile = \"staging\"\nservice_id = \"123\"\napi_endpoint = \"https://api.example.com\"\nflags = [\"--verbose\", \"--max-retries=5\"]\n"
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	f = project.File{}
+	if err := f.Read(nested); err != nil {
+		t.Fatal(err)
+	}
+	if f.Path() != path {
+		t.Fatalf("want path %s, got %s", path, f.Path())
+	}
+	if f.Profile != "staging" || f.ServiceID != "123" || f.APIEndpoint != "https://api.example.com" {
+		t.Fatalf("unexpected project file content: %+v", f)
+	}
+	if len(f.Flags) != 2 || project.FlagName(f.Flags[1]) != "max-retries" {
+		t.Fatalf("unexpected flags: %v", f.Flags)
+	}
+
+	if err := os.WriteFile(path, []byte("flags = [\"-v\"]\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	f = project.File{}
+	testutil.AssertErrorContains(t, f.Read(nested), "invalid flag '-v'")
+
+	for _, flag := range []string{"--auto-yes", "--non-interactive", "--accept-defaults", "--token=123", "--api=https://api.example.com"} {
+		if err := os.WriteFile(path, []byte("flags = [\""+flag+"\"]\n"), 0o600); err != nil {
+			t.Fatal(err)
+		}
+		f = project.File{}
+		testutil.AssertErrorContains(t, f.Read(nested), "the flag '"+flag+"' can't be set in project file")
+	}
+
+	if err := os.WriteFile(path, []byte("profile = \n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	f = project.File{}
+	testutil.AssertErrorContains(t, f.Read(nested), "invalid project file")
+}
+
+func TestTrustedAPIEndpoint(t *testing.T) {
+	for _, tc := range []struct {
+		endpoint       string
+		configEndpoint string
+		expected       bool
+	}{
+		{endpoint: "https://api.fastly.com", expected: true},
+		{endpoint: "https://api.staging.fastly.com", expected: true},
+		{endpoint: "https://api.example.com", configEndpoint: "https://api.example.com", expected: true},
+		{endpoint: "https://api.example.com", configEndpoint: "https://api.fastly.com"},
+		{endpoint: "http://api.fastly.com"},
+		{endpoint: "https://api.fastly.com.example.com"},
+		{endpoint: "https://notfastly.com"},
+		{endpoint: "https://user@api.fastly.com"},
+	} {
+		if got := project.TrustedAPIEndpoint(tc.endpoint, tc.configEndpoint); got != tc.expected {
+			t.Errorf("TrustedAPIEndpoint(%q, %q): want %t, have %t", tc.endpoint, tc.configEndpoint, tc.expected, got)
+		}
+	}
+}
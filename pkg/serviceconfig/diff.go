@@ -0,0 +1,221 @@
+package serviceconfig
+
+import (
+	"reflect"
+	"sort"
+)
+
+// Action is the type of change required to reconcile a resource.
+type Action string
+
+const (
+	// ActionCreate indicates the resource needs to be created.
+	ActionCreate Action = "create"
+	// ActionUpdate indicates the resource needs to be updated.
+	ActionUpdate Action = "update"
+	// ActionDelete indicates the resource needs to be deleted.
+	ActionDelete Action = "delete"
+)
+
+// FieldChange is a single field that differs between two resources.
+type FieldChange struct {
+	Field  string `json:"field"`
+	Before any    `json:"before,omitempty"`
+	After  any    `json:"after,omitempty"`
+}
+
+// Change describes the difference for a single resource.
+type Change struct {
+	Family string        `json:"family"`
+	Name   string        `json:"name"`
+	Action Action        `json:"action"`
+	Before Resource      `json:"before,omitempty"`
+	After  Resource      `json:"after,omitempty"`
+	Fields []FieldChange `json:"fields"`
+}
+
+// Diff returns the changes required to turn the from configuration into the
+// to configuration.
+//
+// Changes are ordered by family (as defined by Families) and then by name.
+// Resources are matched by name, so a renamed resource results in a delete
+// and a create.
+func Diff(from, to *Config) []Change {
+	var changes []Change
+	for _, family := range familyOrder(from, to) {
+		before := index(from.Resources[family])
+		after := index(to.Resources[family])
+
+		names := make([]string, 0, len(before)+len(after))
+		for name := range before {
+			names = append(names, name)
+		}
+		for name := range after {
+			if _, ok := before[name]; !ok {
+				names = append(names, name)
+			}
+		}
+		sort.Strings(names)
+
+		for _, name := range names {
+			b, inBefore := before[name]
+			a, inAfter := after[name]
+			ch := Change{
+				Family: family,
+				Name:   name,
+				Before: b,
+				After:  a,
+				Fields: diffFields(b, a),
+			}
+			switch {
+			case !inBefore:
+				ch.Action = ActionCreate
+			case !inAfter:
+				ch.Action = ActionDelete
+			case len(ch.Fields) > 0:
+				ch.Action = ActionUpdate
+			default:
+				continue
+			}
+			changes = append(changes, ch)
+		}
+	}
+	return changes
+}
+
+// Payload returns the fields to send to the API in order to apply the change.
+//
+// For an update, only the modified fields are included. Fields which have been
+// removed are set to the zero value of their previous type so they're reset.
+func (ch Change) Payload() Resource {
+	switch ch.Action {
+	case ActionCreate:
+		return ch.After
+	case ActionUpdate:
+		r := Resource{}
+		for _, f := range ch.Fields {
+			if f.After != nil {
+				r[f.Field] = f.After
+			} else {
+				r[f.Field] = zero(f.Before)
+			}
+		}
+		return r
+	case ActionDelete:
+	}
+	return nil
+}
+
+// Reverse returns the change that undoes ch.
+func (ch Change) Reverse() Change {
+	r := Change{
+		Family: ch.Family,
+		Name:   ch.Name,
+		Before: ch.After,
+		After:  ch.Before,
+		Fields: make([]FieldChange, 0, len(ch.Fields)),
+	}
+	for _, f := range ch.Fields {
+		r.Fields = append(r.Fields, FieldChange{Field: f.Field, Before: f.After, After: f.Before})
+	}
+	switch ch.Action {
+	case ActionCreate:
+		r.Action = ActionDelete
+	case ActionUpdate:
+		r.Action = ActionUpdate
+	case ActionDelete:
+		r.Action = ActionCreate
+	}
+	return r
+}
+
+// Summary returns the number of creates, updates and deletes in changes.
+func Summary(changes []Change) (create, update, remove int) {
+	for _, ch := range changes {
+		switch ch.Action {
+		case ActionCreate:
+			create++
+		case ActionUpdate:
+			update++
+		case ActionDelete:
+			remove++
+		}
+	}
+	return create, update, remove
+}
+
+// familyOrder returns the union of families in both configurations, in the
+// order defined by Families. Unknown families are appended alphabetically.
+func familyOrder(a, b *Config) []string {
+	seen := make(map[string]bool)
+	for _, c := range []*Config{a, b} {
+		for name := range c.Resources {
+			seen[name] = true
+		}
+	}
+	var order []string
+	for _, f := range Families {
+		if seen[f.Name] {
+			order = append(order, f.Name)
+			delete(seen, f.Name)
+		}
+	}
+	var unknown []string
+	for name := range seen {
+		unknown = append(unknown, name)
+	}
+	sort.Strings(unknown)
+	return append(order, unknown...)
+}
+
+func index(rs []Resource) map[string]Resource {
+	m := make(map[string]Resource, len(rs))
+	for _, r := range rs {
+		m[r.Name()] = r
+	}
+	return m
+}
+
+// diffFields compares every field of two resources.
+// A nil resource is treated as having no fields.
+func diffFields(before, after Resource) []FieldChange {
+	keys := make(map[string]bool)
+	for k := range before {
+		keys[k] = true
+	}
+	for k := range after {
+		keys[k] = true
+	}
+	sorted := make([]string, 0, len(keys))
+	for k := range keys {
+		sorted = append(sorted, k)
+	}
+	sort.Strings(sorted)
+
+	var fields []FieldChange
+	for _, k := range sorted {
+		b, a := before[k], after[k]
+		if reflect.DeepEqual(b, a) {
+			continue
+		}
+		fields = append(fields, FieldChange{Field: k, Before: b, After: a})
+	}
+	return fields
+}
+
+// zero returns the zero value for the type of v.
+func zero(v any) any {
+	switch v.(type) {
+	case string:
+		return ""
+	case bool:
+		return false
+	case int64:
+		return int64(0)
+	case float64:
+		return float64(0)
+	case []any:
+		return []any{}
+	}
+	return nil
+}
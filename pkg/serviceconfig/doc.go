@@ -0,0 +1,3 @@
+// Package serviceconfig provides a declarative representation of the
+// configuration of a Fastly service version.
+package serviceconfig
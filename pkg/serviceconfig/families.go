@@ -0,0 +1,800 @@
+package serviceconfig
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"sort"
+
+	"github.com/fastly/go-fastly/v8/fastly"
+	"github.com/mitchellh/mapstructure"
+
+	"github.com/fastly/cli/pkg/api"
+)
+
+// Family describes a type of versioned service resource (e.g. backends).
+type Family struct {
+	// Name is the key the resources are grouped under in the document.
+	Name string
+	// List returns a slice of the go-fastly resource type.
+	List func(c api.Interface, serviceID string, version int) (any, error)
+	// Create creates a new resource.
+	Create func(c api.Interface, serviceID string, version int, r Resource) error
+	// Update updates the named resource with the fields set in r.
+	Update func(c api.Interface, serviceID string, version int, name string, r Resource) error
+	// Delete deletes the named resource.
+	Delete func(c api.Interface, serviceID string, version int, name string) error
+}
+
+// Fetch lists all resources of the family for the given service version.
+func (f Family) Fetch(c api.Interface, serviceID string, version int) ([]Resource, error) {
+	v, err := f.List(c, serviceID, version)
+	if err != nil {
+		return nil, err
+	}
+	return newResources(v), nil
+}
+
+// Lookup returns the Family with the given name.
+func Lookup(name string) (Family, bool) {
+	for _, f := range Families {
+		if f.Name == name {
+			return f, true
+		}
+	}
+	return Family{}, false
+}
+
+// Families is the list of all supported resource families.
+//
+// NOTE: The order matters when applying a configuration, as resources can
+// reference other resources (e.g. a backend references a healthcheck and a
+// condition). Referenced families must appear before those referencing them.
+var Families = []Family{
+	{
+		Name:   "condition",
+		List:   list(api.Interface.ListConditions),
+		Create: create(api.Interface.CreateCondition),
+		Update: update(api.Interface.UpdateCondition),
+		Delete: remove(api.Interface.DeleteCondition),
+	},
+	{
+		Name:   "healthcheck",
+		List:   list(api.Interface.ListHealthChecks),
+		Create: create(api.Interface.CreateHealthCheck),
+		Update: update(api.Interface.UpdateHealthCheck),
+		Delete: remove(api.Interface.DeleteHealthCheck),
+	},
+	{
+		Name:   "domain",
+		List:   list(api.Interface.ListDomains),
+		Create: create(api.Interface.CreateDomain),
+		Update: update(api.Interface.UpdateDomain),
+		Delete: remove(api.Interface.DeleteDomain),
+	},
+	{
+		Name:   "backend",
+		List:   list(api.Interface.ListBackends),
+		Create: create(api.Interface.CreateBackend),
+		Update: update(api.Interface.UpdateBackend),
+		Delete: remove(api.Interface.DeleteBackend),
+	},
+	{
+		Name:   "director",
+		List:   listDirectors,
+		Create: createDirector,
+		Update: updateDirector,
+		Delete: remove(api.Interface.DeleteDirector),
+	},
+	{
+		Name:   "pool",
+		List:   listPools,
+		Create: createPool,
+		Update: updatePool,
+		Delete: remove(api.Interface.DeletePool),
+	},
+	{
+		Name:   "acl",
+		List:   list(api.Interface.ListACLs),
+		Create: create(api.Interface.CreateACL),
+		Update: update(api.Interface.UpdateACL),
+		Delete: remove(api.Interface.DeleteACL),
+	},
+	{
+		Name:   "dictionary",
+		List:   list(api.Interface.ListDictionaries),
+		Create: create(api.Interface.CreateDictionary),
+		Update: update(api.Interface.UpdateDictionary),
+		Delete: remove(api.Interface.DeleteDictionary),
+	},
+	{
+		Name:   "snippet",
+		List:   list(api.Interface.ListSnippets),
+		Create: create(api.Interface.CreateSnippet),
+		Update: update(api.Interface.UpdateSnippet),
+		Delete: remove(api.Interface.DeleteSnippet),
+	},
+	{
+		Name:   "vcl",
+		List:   list(api.Interface.ListVCLs),
+		Create: create(api.Interface.CreateVCL),
+		Update: update(api.Interface.UpdateVCL),
+		Delete: remove(api.Interface.DeleteVCL),
+	},
+	{
+		Name:   "cache_setting",
+		List:   list(api.Interface.ListCacheSettings),
+		Create: create(api.Interface.CreateCacheSetting),
+		Update: update(api.Interface.UpdateCacheSetting),
+		Delete: remove(api.Interface.DeleteCacheSetting),
+	},
+	{
+		Name:   "gzip",
+		List:   list(api.Interface.ListGzips),
+		Create: create(api.Interface.CreateGzip),
+		Update: update(api.Interface.UpdateGzip),
+		Delete: remove(api.Interface.DeleteGzip),
+	},
+	{
+		Name:   "header",
+		List:   list(api.Interface.ListHeaders),
+		Create: create(api.Interface.CreateHeader),
+		Update: update(api.Interface.UpdateHeader),
+		Delete: remove(api.Interface.DeleteHeader),
+	},
+	{
+		Name:   "request_setting",
+		List:   list(api.Interface.ListRequestSettings),
+		Create: create(api.Interface.CreateRequestSetting),
+		Update: update(api.Interface.UpdateRequestSetting),
+		Delete: remove(api.Interface.DeleteRequestSetting),
+	},
+	{
+		Name:   "response_object",
+		List:   list(api.Interface.ListResponseObjects),
+		Create: create(api.Interface.CreateResponseObject),
+		Update: update(api.Interface.UpdateResponseObject),
+		Delete: remove(api.Interface.DeleteResponseObject),
+	},
+	{
+		Name:   "rate_limit",
+		List:   list(api.Interface.ListERLs),
+		Create: create(api.Interface.CreateERL),
+		Update: updateERL,
+		Delete: deleteERL,
+	},
+	{
+		Name:   "resource_link",
+		List:   list(api.Interface.ListResources),
+		Create: create(api.Interface.CreateResource),
+		Update: updateResourceLink,
+		Delete: deleteResourceLink,
+	},
+	{
+		Name:   "logging_azureblob",
+		List:   list(api.Interface.ListBlobStorages),
+		Create: create(api.Interface.CreateBlobStorage),
+		Update: update(api.Interface.UpdateBlobStorage),
+		Delete: remove(api.Interface.DeleteBlobStorage),
+	},
+	{
+		Name:   "logging_bigquery",
+		List:   list(api.Interface.ListBigQueries),
+		Create: create(api.Interface.CreateBigQuery),
+		Update: update(api.Interface.UpdateBigQuery),
+		Delete: remove(api.Interface.DeleteBigQuery),
+	},
+	{
+		Name:   "logging_cloudfiles",
+		List:   list(api.Interface.ListCloudfiles),
+		Create: create(api.Interface.CreateCloudfiles),
+		Update: update(api.Interface.UpdateCloudfiles),
+		Delete: remove(api.Interface.DeleteCloudfiles),
+	},
+	{
+		Name:   "logging_datadog",
+		List:   list(api.Interface.ListDatadog),
+		Create: create(api.Interface.CreateDatadog),
+		Update: update(api.Interface.UpdateDatadog),
+		Delete: remove(api.Interface.DeleteDatadog),
+	},
+	{
+		Name:   "logging_digitalocean",
+		List:   list(api.Interface.ListDigitalOceans),
+		Create: create(api.Interface.CreateDigitalOcean),
+		Update: update(api.Interface.UpdateDigitalOcean),
+		Delete: remove(api.Interface.DeleteDigitalOcean),
+	},
+	{
+		Name:   "logging_elasticsearch",
+		List:   list(api.Interface.ListElasticsearch),
+		Create: create(api.Interface.CreateElasticsearch),
+		Update: update(api.Interface.UpdateElasticsearch),
+		Delete: remove(api.Interface.DeleteElasticsearch),
+	},
+	{
+		Name:   "logging_ftp",
+		List:   list(api.Interface.ListFTPs),
+		Create: create(api.Interface.CreateFTP),
+		Update: update(api.Interface.UpdateFTP),
+		Delete: remove(api.Interface.DeleteFTP),
+	},
+	{
+		Name:   "logging_gcs",
+		List:   list(api.Interface.ListGCSs),
+		Create: create(api.Interface.CreateGCS),
+		Update: update(api.Interface.UpdateGCS),
+		Delete: remove(api.Interface.DeleteGCS),
+	},
+	{
+		Name:   "logging_googlepubsub",
+		List:   list(api.Interface.ListPubsubs),
+		Create: create(api.Interface.CreatePubsub),
+		Update: update(api.Interface.UpdatePubsub),
+		Delete: remove(api.Interface.DeletePubsub),
+	},
+	{
+		Name:   "logging_heroku",
+		List:   list(api.Interface.ListHerokus),
+		Create: create(api.Interface.CreateHeroku),
+		Update: update(api.Interface.UpdateHeroku),
+		Delete: remove(api.Interface.DeleteHeroku),
+	},
+	{
+		Name:   "logging_honeycomb",
+		List:   list(api.Interface.ListHoneycombs),
+		Create: create(api.Interface.CreateHoneycomb),
+		Update: update(api.Interface.UpdateHoneycomb),
+		Delete: remove(api.Interface.DeleteHoneycomb),
+	},
+	{
+		Name:   "logging_https",
+		List:   list(api.Interface.ListHTTPS),
+		Create: create(api.Interface.CreateHTTPS),
+		Update: update(api.Interface.UpdateHTTPS),
+		Delete: remove(api.Interface.DeleteHTTPS),
+	},
+	{
+		Name:   "logging_kafka",
+		List:   list(api.Interface.ListKafkas),
+		Create: create(api.Interface.CreateKafka),
+		Update: update(api.Interface.UpdateKafka),
+		Delete: remove(api.Interface.DeleteKafka),
+	},
+	{
+		Name:   "logging_kinesis",
+		List:   list(api.Interface.ListKinesis),
+		Create: create(api.Interface.CreateKinesis),
+		Update: update(api.Interface.UpdateKinesis),
+		Delete: remove(api.Interface.DeleteKinesis),
+	},
+	{
+		Name:   "logging_logentries",
+		List:   list(api.Interface.ListLogentries),
+		Create: create(api.Interface.CreateLogentries),
+		Update: update(api.Interface.UpdateLogentries),
+		Delete: remove(api.Interface.DeleteLogentries),
+	},
+	{
+		Name:   "logging_loggly",
+		List:   list(api.Interface.ListLoggly),
+		Create: create(api.Interface.CreateLoggly),
+		Update: update(api.Interface.UpdateLoggly),
+		Delete: remove(api.Interface.DeleteLoggly),
+	},
+	{
+		Name:   "logging_logshuttle",
+		List:   list(api.Interface.ListLogshuttles),
+		Create: create(api.Interface.CreateLogshuttle),
+		Update: update(api.Interface.UpdateLogshuttle),
+		Delete: remove(api.Interface.DeleteLogshuttle),
+	},
+	{
+		Name:   "logging_newrelic",
+		List:   list(api.Interface.ListNewRelic),
+		Create: create(api.Interface.CreateNewRelic),
+		Update: update(api.Interface.UpdateNewRelic),
+		Delete: remove(api.Interface.DeleteNewRelic),
+	},
+	{
+		Name:   "logging_newrelicotlp",
+		List:   list(api.Interface.ListNewRelicOTLP),
+		Create: create(api.Interface.CreateNewRelicOTLP),
+		Update: update(api.Interface.UpdateNewRelicOTLP),
+		Delete: remove(api.Interface.DeleteNewRelicOTLP),
+	},
+	{
+		Name:   "logging_openstack",
+		List:   list(api.Interface.ListOpenstack),
+		Create: create(api.Interface.CreateOpenstack),
+		Update: update(api.Interface.UpdateOpenstack),
+		Delete: remove(api.Interface.DeleteOpenstack),
+	},
+	{
+		Name:   "logging_papertrail",
+		List:   list(api.Interface.ListPapertrails),
+		Create: create(api.Interface.CreatePapertrail),
+		Update: update(api.Interface.UpdatePapertrail),
+		Delete: remove(api.Interface.DeletePapertrail),
+	},
+	{
+		Name:   "logging_s3",
+		List:   list(api.Interface.ListS3s),
+		Create: create(api.Interface.CreateS3),
+		Update: update(api.Interface.UpdateS3),
+		Delete: remove(api.Interface.DeleteS3),
+	},
+	{
+		Name:   "logging_scalyr",
+		List:   list(api.Interface.ListScalyrs),
+		Create: create(api.Interface.CreateScalyr),
+		Update: update(api.Interface.UpdateScalyr),
+		Delete: remove(api.Interface.DeleteScalyr),
+	},
+	{
+		Name:   "logging_sftp",
+		List:   list(api.Interface.ListSFTPs),
+		Create: create(api.Interface.CreateSFTP),
+		Update: update(api.Interface.UpdateSFTP),
+		Delete: remove(api.Interface.DeleteSFTP),
+	},
+	{
+		Name:   "logging_splunk",
+		List:   list(api.Interface.ListSplunks),
+		Create: create(api.Interface.CreateSplunk),
+		Update: update(api.Interface.UpdateSplunk),
+		Delete: remove(api.Interface.DeleteSplunk),
+	},
+	{
+		Name:   "logging_sumologic",
+		List:   list(api.Interface.ListSumologics),
+		Create: create(api.Interface.CreateSumologic),
+		Update: update(api.Interface.UpdateSumologic),
+		Delete: remove(api.Interface.DeleteSumologic),
+	},
+	{
+		Name:   "logging_syslog",
+		List:   list(api.Interface.ListSyslogs),
+		Create: create(api.Interface.CreateSyslog),
+		Update: update(api.Interface.UpdateSyslog),
+		Delete: remove(api.Interface.DeleteSyslog),
+	},
+}
+
+// list returns a Family.List implementation for the given API method.
+func list[I, O any](fn func(api.Interface, *I) (O, error)) func(api.Interface, string, int) (any, error) {
+	return func(c api.Interface, serviceID string, version int) (any, error) {
+		var i I
+		setIdentifiers(&i, serviceID, version, "")
+		return fn(c, &i)
+	}
+}
+
+// create returns a Family.Create implementation for the given API method.
+func create[I, O any](fn func(api.Interface, *I) (O, error)) func(api.Interface, string, int, Resource) error {
+	return func(c api.Interface, serviceID string, version int, r Resource) error {
+		var i I
+		if err := decodeInput(r, &i); err != nil {
+			return err
+		}
+		setIdentifiers(&i, serviceID, version, "")
+		_, err := fn(c, &i)
+		return err
+	}
+}
+
+// update returns a Family.Update implementation for the given API method.
+func update[I, O any](fn func(api.Interface, *I) (O, error)) func(api.Interface, string, int, string, Resource) error {
+	return func(c api.Interface, serviceID string, version int, name string, r Resource) error {
+		var i I
+		if err := decodeInput(r, &i); err != nil {
+			return err
+		}
+		setIdentifiers(&i, serviceID, version, name)
+		_, err := fn(c, &i)
+		return err
+	}
+}
+
+// remove returns a Family.Delete implementation for the given API method.
+func remove[I any](fn func(api.Interface, *I) error) func(api.Interface, string, int, string) error {
+	return func(c api.Interface, serviceID string, version int, name string) error {
+		var i I
+		setIdentifiers(&i, serviceID, version, name)
+		return fn(c, &i)
+	}
+}
+
+// decodeInput populates a go-fastly input struct from a resource.
+//
+// The input structs are tagged with the API field names via their `url` tags.
+func decodeInput(r Resource, input any) error {
+	d, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+		Result:           input,
+		TagName:          "url",
+		WeaklyTypedInput: true,
+	})
+	if err != nil {
+		return err
+	}
+	if err := d.Decode(map[string]any(r)); err != nil {
+		return fmt.Errorf("error decoding resource %q: %w", r.Name(), err)
+	}
+	return nil
+}
+
+// setIdentifiers sets the ServiceID, ServiceVersion and Name fields of a
+// go-fastly input struct (where they exist).
+//
+// NOTE: Update inputs use a string Name field to identify the resource, and a
+// NewName pointer field to rename it, while Create inputs use a pointer Name
+// field. Only the former is set here as the latter is populated from the
+// resource itself.
+func setIdentifiers(input any, serviceID string, version int, name string) {
+	v := reflect.ValueOf(input).Elem()
+	if f := v.FieldByName("ServiceID"); f.IsValid() && f.Kind() == reflect.String {
+		f.SetString(serviceID)
+	}
+	if f := v.FieldByName("ServiceVersion"); f.IsValid() && f.Kind() == reflect.Int {
+		f.SetInt(int64(version))
+	}
+	if f := v.FieldByName("Name"); name != "" && f.IsValid() && f.Kind() == reflect.String {
+		f.SetString(name)
+	}
+}
+
+// NOTE: Rate limiters and resource links are identified by ID rather than by
+// name, and so the ID must be looked up before they can be modified.
+
+func erlID(c api.Interface, serviceID string, version int, name string) (string, error) {
+	erls, err := c.ListERLs(&fastly.ListERLsInput{ServiceID: serviceID, ServiceVersion: version})
+	if err != nil {
+		return "", err
+	}
+	for _, e := range erls {
+		if e.Name == name {
+			return e.ID, nil
+		}
+	}
+	return "", fmt.Errorf("rate limiter %q not found", name)
+}
+
+func updateERL(c api.Interface, serviceID string, version int, name string, r Resource) error {
+	id, err := erlID(c, serviceID, version, name)
+	if err != nil {
+		return err
+	}
+	var i fastly.UpdateERLInput
+	if err := decodeInput(r, &i); err != nil {
+		return err
+	}
+	i.ERLID = id
+	_, err = c.UpdateERL(&i)
+	return err
+}
+
+func deleteERL(c api.Interface, serviceID string, version int, name string) error {
+	id, err := erlID(c, serviceID, version, name)
+	if err != nil {
+		return err
+	}
+	return c.DeleteERL(&fastly.DeleteERLInput{ERLID: id})
+}
+
+func resourceLinkID(c api.Interface, serviceID string, version int, name string) (string, error) {
+	links, err := c.ListResources(&fastly.ListResourcesInput{ServiceID: serviceID, ServiceVersion: version})
+	if err != nil {
+		return "", err
+	}
+	for _, l := range links {
+		if l.Name == name {
+			return l.ID, nil
+		}
+	}
+	return "", fmt.Errorf("resource link %q not found", name)
+}
+
+func updateResourceLink(c api.Interface, serviceID string, version int, name string, r Resource) error {
+	id, err := resourceLinkID(c, serviceID, version, name)
+	if err != nil {
+		return err
+	}
+	var i fastly.UpdateResourceInput
+	if err := decodeInput(r, &i); err != nil {
+		return err
+	}
+	i.ID = id
+	i.ServiceID = serviceID
+	i.ServiceVersion = version
+	_, err = c.UpdateResource(&i)
+	return err
+}
+
+func deleteResourceLink(c api.Interface, serviceID string, version int, name string) error {
+	id, err := resourceLinkID(c, serviceID, version, name)
+	if err != nil {
+		return err
+	}
+	return c.DeleteResource(&fastly.DeleteResourceInput{
+		ID:             id,
+		ServiceID:      serviceID,
+		ServiceVersion: version,
+	})
+}
+
+// NOTE: The backends of a director are managed via separate director backend
+// resources. They're represented by the `backends` field of the director (a
+// list of backend names), which the API already includes when listing them.
+
+// KeyBackends is the director field listing the names of its backends.
+const KeyBackends = "backends"
+
+func listDirectors(c api.Interface, serviceID string, version int) (any, error) {
+	ds, err := c.ListDirectors(&fastly.ListDirectorsInput{ServiceID: serviceID, ServiceVersion: version})
+	if err != nil {
+		return nil, err
+	}
+	for _, d := range ds {
+		sort.Strings(d.Backends)
+	}
+	return ds, nil
+}
+
+func createDirector(c api.Interface, serviceID string, version int, r Resource) error {
+	if err := create(api.Interface.CreateDirector)(c, serviceID, version, r); err != nil {
+		return err
+	}
+	return syncDirectorBackends(c, serviceID, version, r.Name(), nil, stringList(r[KeyBackends]))
+}
+
+func updateDirector(c api.Interface, serviceID string, version int, name string, r Resource) error {
+	backends, ok := r[KeyBackends]
+	if fields := without(r, KeyBackends); len(fields) > 0 {
+		if err := update(api.Interface.UpdateDirector)(c, serviceID, version, name, fields); err != nil {
+			return err
+		}
+	}
+	if !ok {
+		return nil
+	}
+	if n := r.Name(); n != "" {
+		name = n // the director was renamed
+	}
+	d, err := c.GetDirector(&fastly.GetDirectorInput{Name: name, ServiceID: serviceID, ServiceVersion: version})
+	if err != nil {
+		return err
+	}
+	return syncDirectorBackends(c, serviceID, version, name, d.Backends, stringList(backends))
+}
+
+// syncDirectorBackends adds and removes director backends so the director
+// references exactly the wanted backends.
+func syncDirectorBackends(c api.Interface, serviceID string, version int, director string, current, wanted []string) error {
+	for _, b := range wanted {
+		if slices.Contains(current, b) {
+			continue
+		}
+		_, err := c.CreateDirectorBackend(&fastly.CreateDirectorBackendInput{
+			Backend:        b,
+			Director:       director,
+			ServiceID:      serviceID,
+			ServiceVersion: version,
+		})
+		if err != nil {
+			return fmt.Errorf("error adding backend %q to director %q: %w", b, director, err)
+		}
+	}
+	for _, b := range current {
+		if slices.Contains(wanted, b) {
+			continue
+		}
+		err := c.DeleteDirectorBackend(&fastly.DeleteDirectorBackendInput{
+			Backend:        b,
+			Director:       director,
+			ServiceID:      serviceID,
+			ServiceVersion: version,
+		})
+		if err != nil {
+			return fmt.Errorf("error removing backend %q from director %q: %w", b, director, err)
+		}
+	}
+	return nil
+}
+
+// without returns a copy of the resource without the given field.
+func without(r Resource, field string) Resource {
+	c := make(Resource, len(r))
+	for k, v := range r {
+		if k != field {
+			c[k] = v
+		}
+	}
+	return c
+}
+
+// stringList converts a decoded list of strings.
+func stringList(v any) []string {
+	var s []string
+	switch t := v.(type) {
+	case []string:
+		s = append(s, t...)
+	case []any:
+		for _, e := range t {
+			if str, ok := e.(string); ok {
+				s = append(s, str)
+			}
+		}
+	}
+	return s
+}
+
+// NOTE: The servers of a pool aren't versioned and are identified by the ID
+// of the pool. They're represented by the `servers` field of the pool (a list
+// of tables keyed by address), which is populated when listing pools.
+
+// KeyServers is the pool field listing its servers.
+const KeyServers = "servers"
+
+// KeyAddress is the field used to uniquely identify a server within a pool.
+const KeyAddress = "address"
+
+func listPools(c api.Interface, serviceID string, version int) (any, error) {
+	pools, err := c.ListPools(&fastly.ListPoolsInput{ServiceID: serviceID, ServiceVersion: version})
+	if err != nil {
+		return nil, err
+	}
+	rs := make([]Resource, 0, len(pools))
+	for _, p := range pools {
+		r := NewResource(p)
+		servers, _, err := listServers(c, serviceID, p.ID)
+		if err != nil {
+			return nil, fmt.Errorf("error listing servers of pool %q: %w", p.Name, err)
+		}
+		if len(servers) > 0 {
+			list := make([]any, 0, len(servers))
+			for _, s := range servers {
+				list = append(list, map[string]any(s))
+			}
+			r[KeyServers] = list
+		}
+		rs = append(rs, r)
+	}
+	return rs, nil
+}
+
+// listServers returns the servers of a pool sorted by address, along with
+// their IDs keyed by address.
+func listServers(c api.Interface, serviceID, poolID string) ([]Resource, map[string]string, error) {
+	servers, err := c.ListServers(&fastly.ListServersInput{PoolID: poolID, ServiceID: serviceID})
+	if err != nil {
+		return nil, nil, err
+	}
+	rs := make([]Resource, 0, len(servers))
+	ids := make(map[string]string, len(servers))
+	for _, s := range servers {
+		r := NewResource(s)
+		delete(r, "pool_id")
+		rs = append(rs, r)
+		ids[s.Address] = s.ID
+	}
+	sort.Slice(rs, func(i, j int) bool {
+		a, _ := rs[i][KeyAddress].(string)
+		b, _ := rs[j][KeyAddress].(string)
+		return a < b
+	})
+	return rs, ids, nil
+}
+
+func createPool(c api.Interface, serviceID string, version int, r Resource) error {
+	var i fastly.CreatePoolInput
+	if err := decodeInput(r, &i); err != nil {
+		return err
+	}
+	setIdentifiers(&i, serviceID, version, "")
+	p, err := c.CreatePool(&i)
+	if err != nil {
+		return err
+	}
+	return syncServers(c, serviceID, p.ID, r.Name(), nil, nil, serverList(r[KeyServers]))
+}
+
+func updatePool(c api.Interface, serviceID string, version int, name string, r Resource) error {
+	servers, ok := r[KeyServers]
+	if fields := without(r, KeyServers); len(fields) > 0 {
+		if err := update(api.Interface.UpdatePool)(c, serviceID, version, name, fields); err != nil {
+			return err
+		}
+	}
+	if !ok {
+		return nil
+	}
+	if n := r.Name(); n != "" {
+		name = n // the pool was renamed
+	}
+	p, err := c.GetPool(&fastly.GetPoolInput{Name: name, ServiceID: serviceID, ServiceVersion: version})
+	if err != nil {
+		return err
+	}
+	current, ids, err := listServers(c, serviceID, p.ID)
+	if err != nil {
+		return fmt.Errorf("error listing servers of pool %q: %w", name, err)
+	}
+	return syncServers(c, serviceID, p.ID, name, current, ids, serverList(servers))
+}
+
+// syncServers creates, updates and deletes the servers of a pool (matched by
+// address) so they match the wanted servers.
+func syncServers(c api.Interface, serviceID, poolID, pool string, current []Resource, ids map[string]string, wanted []Resource) error {
+	existing := make(map[string]Resource, len(current))
+	for _, s := range current {
+		a, _ := s[KeyAddress].(string)
+		existing[a] = s
+	}
+
+	for _, s := range wanted {
+		a, _ := s[KeyAddress].(string)
+		if a == "" {
+			return fmt.Errorf("missing %s for a server of pool %q", KeyAddress, pool)
+		}
+		cur, ok := existing[a]
+		delete(existing, a)
+
+		if !ok {
+			var i fastly.CreateServerInput
+			if err := decodeInput(s, &i); err != nil {
+				return err
+			}
+			i.PoolID = poolID
+			i.ServiceID = serviceID
+			if _, err := c.CreateServer(&i); err != nil {
+				return fmt.Errorf("error adding server %q to pool %q: %w", a, pool, err)
+			}
+			continue
+		}
+
+		if len(diffFields(cur, s)) == 0 {
+			continue
+		}
+		var i fastly.UpdateServerInput
+		if err := decodeInput(s, &i); err != nil {
+			return err
+		}
+		i.PoolID = poolID
+		i.Server = ids[a]
+		i.ServiceID = serviceID
+		if _, err := c.UpdateServer(&i); err != nil {
+			return fmt.Errorf("error updating server %q of pool %q: %w", a, pool, err)
+		}
+	}
+
+	for a := range existing {
+		err := c.DeleteServer(&fastly.DeleteServerInput{
+			PoolID:    poolID,
+			Server:    ids[a],
+			ServiceID: serviceID,
+		})
+		if err != nil {
+			return fmt.Errorf("error removing server %q from pool %q: %w", a, pool, err)
+		}
+	}
+	return nil
+}
+
+// serverList converts a decoded list of server tables.
+func serverList(v any) []Resource {
+	var rs []Resource
+	switch t := v.(type) {
+	case []Resource:
+		rs = append(rs, t...)
+	case []any:
+		for _, e := range t {
+			switch m := e.(type) {
+			case map[string]any:
+				rs = append(rs, Resource(m))
+			case Resource:
+				rs = append(rs, m)
+			}
+		}
+	}
+	return rs
+}
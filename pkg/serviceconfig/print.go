@@ -0,0 +1,47 @@
+package serviceconfig
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/fastly/cli/pkg/text"
+)
+
+// PrintChanges displays the changes in a format similar to a Terraform plan.
+func PrintChanges(w io.Writer, changes []Change) {
+	for _, ch := range changes {
+		var symbol string
+		switch ch.Action {
+		case ActionCreate:
+			symbol = text.BoldGreen("+")
+		case ActionUpdate:
+			symbol = text.BoldYellow("~")
+		case ActionDelete:
+			symbol = text.BoldRed("-")
+		}
+		fmt.Fprintf(w, "%s %s %q\n", symbol, ch.Family, ch.Name)
+		for _, f := range ch.Fields {
+			switch ch.Action {
+			case ActionCreate:
+				fmt.Fprintf(w, "    %s %s = %s\n", symbol, f.Field, formatValue(f.After))
+			case ActionUpdate:
+				fmt.Fprintf(w, "    %s %s = %s -> %s\n", symbol, f.Field, formatValue(f.Before), formatValue(f.After))
+			case ActionDelete:
+				fmt.Fprintf(w, "    %s %s = %s\n", symbol, f.Field, formatValue(f.Before))
+			}
+		}
+		fmt.Fprintln(w)
+	}
+}
+
+// formatValue renders a resource field value for display.
+func formatValue(v any) string {
+	switch t := v.(type) {
+	case nil:
+		return "(unset)"
+	case string:
+		return strconv.Quote(t)
+	}
+	return fmt.Sprintf("%v", v)
+}
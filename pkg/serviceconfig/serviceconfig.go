@@ -0,0 +1,321 @@
+package serviceconfig
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	toml "github.com/pelletier/go-toml"
+
+	"github.com/fastly/cli/pkg/api"
+)
+
+const (
+	// FormatJSON renders the document as JSON.
+	FormatJSON = "json"
+	// FormatTOML renders the document as TOML.
+	FormatTOML = "toml"
+)
+
+// Formats is the list of supported document formats.
+var Formats = []string{FormatTOML, FormatJSON}
+
+// KeyName is the field used to uniquely identify a resource within a family.
+const KeyName = "name"
+
+// volatileFields are fields which differ between service versions (or
+// services) without representing a change in configuration, and so they're
+// excluded from the declarative document.
+var volatileFields = []string{"href", "id", "service_id", "version"}
+
+// Resource is a single resource within a service version (e.g. a backend),
+// keyed by the API field names. Fields with a zero value are omitted.
+type Resource map[string]any
+
+// Name returns the value used to uniquely identify the resource.
+func (r Resource) Name() string {
+	n, _ := r[KeyName].(string)
+	return n
+}
+
+// Config is a declarative representation of a service version.
+type Config struct {
+	// ServiceID is the ID of the service the configuration was exported from.
+	ServiceID string
+	// Version is the service version the configuration was exported from.
+	Version int
+	// Resources is every resource in the service version grouped by family.
+	Resources map[string][]Resource
+}
+
+// Fetch retrieves the configuration of a service version by listing every
+// resource family defined in Families.
+func Fetch(client api.Interface, serviceID string, version int) (*Config, error) {
+	cfg := &Config{
+		ServiceID: serviceID,
+		Version:   version,
+		Resources: make(map[string][]Resource),
+	}
+	for _, f := range Families {
+		rs, err := f.Fetch(client, serviceID, version)
+		if err != nil {
+			return nil, fmt.Errorf("error listing %s resources: %w", f.Name, err)
+		}
+		if len(rs) > 0 {
+			cfg.Resources[f.Name] = rs
+		}
+	}
+	return cfg, nil
+}
+
+// Encode writes the configuration to w in the specified format.
+func (c *Config) Encode(w io.Writer, format string) error {
+	m := map[string]any{
+		"service_id": c.ServiceID,
+		"version":    int64(c.Version),
+	}
+	for family, rs := range c.Resources {
+		if len(rs) == 0 {
+			continue
+		}
+		tables := make([]map[string]any, 0, len(rs))
+		for _, r := range rs {
+			tables = append(tables, r)
+		}
+		m[family] = tables
+	}
+
+	switch format {
+	case FormatJSON:
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(m)
+	case FormatTOML:
+		tree, err := toml.TreeFromMap(m)
+		if err != nil {
+			return fmt.Errorf("error encoding TOML: %w", err)
+		}
+		_, err = tree.WriteTo(w)
+		return err
+	}
+	return fmt.Errorf("unsupported format: %s", format)
+}
+
+// Decode reads a configuration document in the specified format.
+func Decode(r io.Reader, format string) (*Config, error) {
+	var m map[string]any
+	switch format {
+	case FormatJSON:
+		if err := json.NewDecoder(r).Decode(&m); err != nil {
+			return nil, fmt.Errorf("error decoding JSON: %w", err)
+		}
+	case FormatTOML:
+		tree, err := toml.LoadReader(r)
+		if err != nil {
+			return nil, fmt.Errorf("error decoding TOML: %w", err)
+		}
+		m = tree.ToMap()
+	default:
+		return nil, fmt.Errorf("unsupported format: %s", format)
+	}
+
+	cfg := &Config{Resources: make(map[string][]Resource)}
+	for key, value := range m {
+		switch key {
+		case "service_id":
+			cfg.ServiceID, _ = value.(string)
+			continue
+		case "version":
+			if v, ok := canonical(value).(int64); ok {
+				cfg.Version = int(v)
+			}
+			continue
+		}
+		if _, ok := Lookup(key); !ok {
+			return nil, fmt.Errorf("unrecognised resource family: %s", key)
+		}
+		items, ok := value.([]any)
+		if !ok {
+			return nil, fmt.Errorf("expected a list of resources for %s", key)
+		}
+		for _, item := range items {
+			fields, ok := canonical(item).(map[string]any)
+			if !ok {
+				return nil, fmt.Errorf("expected a table of fields for %s", key)
+			}
+			r := Resource(fields)
+			if r.Name() == "" {
+				return nil, fmt.Errorf("missing %s for a %s resource", KeyName, key)
+			}
+			cfg.Resources[key] = append(cfg.Resources[key], r)
+		}
+	}
+	return cfg, nil
+}
+
+// FormatFromPath returns the document format implied by a file extension.
+func FormatFromPath(path string) string {
+	if strings.EqualFold(filepath.Ext(path), ".json") {
+		return FormatJSON
+	}
+	return FormatTOML
+}
+
+// NewResource converts a go-fastly resource type into a Resource.
+//
+// The field names are taken from the `mapstructure` tags (which reflect the
+// API field names), timestamps and volatile fields are dropped, and all
+// values are reduced to basic types so the result can be encoded and compared.
+func NewResource(v any) Resource {
+	n, ok := normalize(reflect.ValueOf(v))
+	if !ok {
+		return Resource{}
+	}
+	m, ok := n.(map[string]any)
+	if !ok {
+		return Resource{}
+	}
+	for _, f := range volatileFields {
+		delete(m, f)
+	}
+	return Resource(m)
+}
+
+// newResources converts a slice of go-fastly resource types into Resources.
+func newResources(v any) []Resource {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Slice {
+		return nil
+	}
+	rs := make([]Resource, 0, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		rs = append(rs, NewResource(rv.Index(i).Interface()))
+	}
+	sort.Slice(rs, func(i, j int) bool {
+		return rs[i].Name() < rs[j].Name()
+	})
+	return rs
+}
+
+// canonical reduces a decoded document value to the same types produced by
+// normalize, so that documents can be compared with fetched configurations.
+// Zero values are dropped.
+func canonical(v any) any {
+	switch t := v.(type) {
+	case map[string]any:
+		m := make(map[string]any, len(t))
+		for k, v := range t {
+			if c := canonical(v); c != nil {
+				m[k] = c
+			}
+		}
+		return m
+	case []any:
+		if len(t) == 0 {
+			return nil
+		}
+		s := make([]any, 0, len(t))
+		for _, v := range t {
+			if c := canonical(v); c != nil {
+				s = append(s, c)
+			}
+		}
+		return s
+	case float64:
+		if t == float64(int64(t)) {
+			return canonical(int64(t))
+		}
+		if t == 0 {
+			return nil
+		}
+		return t
+	case int64:
+		if t == 0 {
+			return nil
+		}
+		return t
+	case int:
+		return canonical(int64(t))
+	case string:
+		if t == "" {
+			return nil
+		}
+		return t
+	case bool:
+		if !t {
+			return nil
+		}
+		return t
+	}
+	return v
+}
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// normalize reduces v to a string, bool, int64, float64, []any or
+// map[string]any. The boolean return value is false for zero values, which
+// are omitted from the document.
+func normalize(v reflect.Value) (any, bool) {
+	switch v.Kind() {
+	case reflect.Invalid:
+		return nil, false
+	case reflect.Ptr, reflect.Interface:
+		if v.IsNil() {
+			return nil, false
+		}
+		return normalize(v.Elem())
+	case reflect.Struct:
+		if v.Type() == timeType {
+			return nil, false
+		}
+		m := make(map[string]any)
+		t := v.Type()
+		for i := 0; i < t.NumField(); i++ {
+			f := t.Field(i)
+			if !f.IsExported() {
+				continue
+			}
+			name := strings.Split(f.Tag.Get("mapstructure"), ",")[0]
+			if name == "" || name == "-" {
+				name = strings.ToLower(f.Name)
+			}
+			if n, ok := normalize(v.Field(i)); ok {
+				m[name] = n
+			}
+		}
+		return m, len(m) > 0
+	case reflect.Map:
+		m := make(map[string]any)
+		iter := v.MapRange()
+		for iter.Next() {
+			if n, ok := normalize(iter.Value()); ok {
+				m[fmt.Sprint(iter.Key().Interface())] = n
+			}
+		}
+		return m, len(m) > 0
+	case reflect.Slice, reflect.Array:
+		s := make([]any, 0, v.Len())
+		for i := 0; i < v.Len(); i++ {
+			if n, ok := normalize(v.Index(i)); ok {
+				s = append(s, n)
+			}
+		}
+		return s, len(s) > 0
+	case reflect.String:
+		return v.String(), v.String() != ""
+	case reflect.Bool:
+		return v.Bool(), v.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int(), v.Int() != 0
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return int64(v.Uint()), v.Uint() != 0 // #nosec G115
+	case reflect.Float32, reflect.Float64:
+		return v.Float(), v.Float() != 0
+	}
+	return nil, false
+}
@@ -5,10 +5,13 @@ import (
 	"errors"
 	"net/http"
 	"net/http/httptest"
+	"reflect"
+	"strings"
 
 	"github.com/fastly/go-fastly/v8/fastly"
 
 	"github.com/fastly/cli/pkg/commands/whoami"
+	"github.com/fastly/cli/pkg/mock"
 )
 
 // Err represents a generic error.
@@ -67,6 +70,31 @@ func CloneVersionError(_ *fastly.CloneVersionInput) (*fastly.Version, error) {
 	return nil, Err
 }
 
+// EmptyLists returns a copy of the given mock.API where every unset List*Fn
+// field is replaced with a function returning zero values (i.e. no resources).
+//
+// This is useful for commands that walk every resource type of a service
+// version (e.g. `service export`), where a test only cares about a handful.
+func EmptyLists(a mock.API) mock.API {
+	v := reflect.ValueOf(&a).Elem()
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if !strings.HasPrefix(f.Name, "List") || !strings.HasSuffix(f.Name, "Fn") || !v.Field(i).IsNil() {
+			continue
+		}
+		fnType := f.Type
+		v.Field(i).Set(reflect.MakeFunc(fnType, func([]reflect.Value) []reflect.Value {
+			results := make([]reflect.Value, fnType.NumOut())
+			for j := range results {
+				results[j] = reflect.Zero(fnType.Out(j))
+			}
+			return results
+		}))
+	}
+	return a
+}
+
 // WhoamiVerifyClient is used by `whoami` and `sso` tests.
 type WhoamiVerifyClient whoami.VerifyResponse
 
@@ -58,7 +58,20 @@ func Args(args string) []string {
 type MockAuthServer struct {
 	auth.Runner
 
+	// DeviceCode is returned by DeviceAuthorization.
+	DeviceCode auth.DeviceCode
+	// DeviceErr is returned by DeviceAuthorization.
+	DeviceErr error
+	// Email is returned by ValidateAndRetrieveAPIToken.
+	Email string
+	// JWT is returned by PollDeviceToken.
+	JWT auth.JWT
+	// PollErr is returned by PollDeviceToken.
+	PollErr error
+	// Result is the results channel.
 	Result chan auth.AuthorizationResult
+	// SessionToken is returned by ValidateAndRetrieveAPIToken.
+	SessionToken string
 }
 
 // AuthURL returns a fully qualified authorization_endpoint.
@@ -67,11 +80,21 @@ func (s MockAuthServer) AuthURL() (string, error) {
 	return "", nil // no-op
 }
 
+// DeviceAuthorization starts the device authorization flow.
+func (s MockAuthServer) DeviceAuthorization() (auth.DeviceCode, error) {
+	return s.DeviceCode, s.DeviceErr
+}
+
 // GetResult returns the results channel.
 func (s MockAuthServer) GetResult() chan auth.AuthorizationResult {
 	return s.Result
 }
 
+// PollDeviceToken polls the token_endpoint until the device code is authorized.
+func (s MockAuthServer) PollDeviceToken(_ auth.DeviceCode) (auth.JWT, error) {
+	return s.JWT, s.PollErr
+}
+
 // SetAPIEndpoint sets the API endpoint.
 func (s MockAuthServer) SetAPIEndpoint(_ string) {
 	// no-op
@@ -82,6 +105,11 @@ func (s MockAuthServer) Start() error {
 	return nil // no-op
 }
 
+// ValidateAndRetrieveAPIToken exchanges the access token for an API token.
+func (s MockAuthServer) ValidateAndRetrieveAPIToken(_ string) (string, *auth.APIToken, error) {
+	return s.Email, &auth.APIToken{AccessToken: s.SessionToken}, nil
+}
+
 // MockGlobalData returns a struct that can be used to populate a call to app.Exec()
 // while the majority of fields will be pre-populated and only those fields
 // commonly changed for testing purposes will need to be provided.
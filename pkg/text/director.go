@@ -0,0 +1,44 @@
+package text
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/fastly/go-fastly/v8/fastly"
+	"github.com/segmentio/textio"
+)
+
+// DirectorTypes maps the director type names accepted by the CLI to the
+// values used by the Fastly API.
+var DirectorTypes = map[string]fastly.DirectorType{
+	"random":      fastly.DirectorTypeRandom,
+	"round-robin": fastly.DirectorTypeRoundRobin,
+	"hash":        fastly.DirectorTypeHash,
+	"client":      fastly.DirectorTypeClient,
+}
+
+// DirectorTypeName returns the CLI name of a director type.
+func DirectorTypeName(t fastly.DirectorType) string {
+	for name, v := range DirectorTypes {
+		if v == t {
+			return name
+		}
+	}
+	return fmt.Sprintf("%d", t)
+}
+
+// PrintDirector pretty prints a fastly.Director structure in verbose format
+// to a given io.Writer. Consumers can provide a prefix string which will
+// be used as a prefix to each line, useful for indentation.
+func PrintDirector(out io.Writer, prefix string, d *fastly.Director) {
+	out = textio.NewPrefixWriter(out, prefix)
+
+	fmt.Fprintf(out, "Name: %s\n", d.Name)
+	fmt.Fprintf(out, "Comment: %s\n", d.Comment)
+	fmt.Fprintf(out, "Type: %s\n", DirectorTypeName(d.Type))
+	fmt.Fprintf(out, "Quorum: %d\n", d.Quorum)
+	fmt.Fprintf(out, "Retries: %d\n", d.Retries)
+	fmt.Fprintf(out, "Shield: %s\n", d.Shield)
+	fmt.Fprintf(out, "Backends: %s\n", strings.Join(d.Backends, ", "))
+}